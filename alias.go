@@ -0,0 +1,129 @@
+package model
+
+import "errors"
+
+// Sentinel errors returned by WithAlias when a proposed alias name is unusable.
+var (
+	errAliasEmptyName      = errors.New("model: alias name must not be empty")
+	errAliasInvalidChars   = errors.New("model: alias name must not contain '(', ')', ',' or '|'")
+	errAliasReservedName   = errors.New("model: alias name collides with a reserved tag token or conditional rule name")
+	errAliasBuiltinShadows = errors.New("model: alias name collides with a built-in rule name; use WithAliasOverride/RegisterTagAliasOverride to shadow it explicitly")
+	errAliasCycle          = errors.New("model: alias expansion would create a cycle")
+	errAliasAfterBinding   = errors.New("model: RegisterTagAlias must be called before the binding is built (before SetDefaults/Validate)")
+)
+
+// defaultAliases ships a small set of ready-to-use aliases, merged beneath any
+// caller-registered aliases (WithAlias/RegisterTagAlias) so a model-specific
+// alias of the same name always wins. iscolor demonstrates an OR-chain
+// expansion: a field tagged `validate:"iscolor"` passes if any one of the
+// listed color-format rules passes.
+var defaultAliases = map[string]string{
+	"iscolor": "hexcolor|rgb|rgba|hsl|hsla",
+}
+
+// maxAliasExpansionDepth bounds recursive alias expansion to guard against
+// aliases that (accidentally or maliciously) reference each other in a cycle.
+const maxAliasExpansionDepth = 8
+
+// expandAliases replaces every ruleNameParams in rules whose name is a key of
+// aliases with the parsed rule list its expansion string represents, applying
+// expansion recursively up to maxAliasExpansionDepth. Rules with no matching
+// alias are passed through unchanged.
+func expandAliases(rules []ruleNameParams, aliases map[string]string) []ruleNameParams {
+	if len(aliases) == 0 {
+		return rules
+	}
+	return expandAliasesDepth(rules, aliases, 0)
+}
+
+func expandAliasesDepth(rules []ruleNameParams, aliases map[string]string, depth int) []ruleNameParams {
+	if depth >= maxAliasExpansionDepth {
+		return rules
+	}
+	var out []ruleNameParams
+	for _, r := range rules {
+		expansion, ok := aliases[r.name]
+		if !ok {
+			out = append(out, r)
+			continue
+		}
+		out = append(out, expandAliasesDepth(parseTag(expansion), aliases, depth+1)...)
+	}
+	return out
+}
+
+// restrictedAliasNames cannot be registered as alias names since they are
+// reserved for built-in tag grammar or conditional rules.
+var restrictedAliasNames = map[string]bool{
+	tagDive:    true,
+	tagKeys:    true,
+	tagEndKeys: true,
+}
+
+// validateAliasName reports whether name is usable as an alias: non-empty,
+// free of rule-grammar punctuation, and not colliding with a reserved token
+// or a conditional rule name.
+func validateAliasName(name string) error {
+	if name == "" {
+		return errAliasEmptyName
+	}
+	for _, r := range name {
+		switch r {
+		case '(', ')', ',', '|':
+			return errAliasInvalidChars
+		}
+	}
+	if restrictedAliasNames[name] || isConditionalRule(name) {
+		return errAliasReservedName
+	}
+	return nil
+}
+
+// checkAliasBuiltinCollision reports errAliasBuiltinShadows when name already
+// denotes a built-in rule: a built-in always wins over an alias of the same
+// name unless the caller goes through WithAliasOverride/RegisterTagAliasOverride.
+func checkAliasBuiltinCollision(name string) error {
+	if isBuiltinRuleName(name) {
+		return errAliasBuiltinShadows
+	}
+	return nil
+}
+
+// detectAliasCycle reports whether registering alias -> expansion into the
+// existing alias set would let alias's expansion reach alias again, directly
+// or through other aliases. It is a true reachability check (unbounded in the
+// number of distinct aliases visited), distinct from expandAliasesDepth's
+// fixed depth bound which merely stops runaway expansion at use time.
+func detectAliasCycle(alias, expansion string, existing map[string]string) error {
+	candidate := make(map[string]string, len(existing)+1)
+	for k, v := range existing {
+		candidate[k] = v
+	}
+	candidate[alias] = expansion
+
+	visited := make(map[string]bool)
+	var walk func(name string) bool
+	walk = func(name string) bool {
+		exp, ok := candidate[name]
+		if !ok {
+			return false
+		}
+		for _, r := range parseTag(exp) {
+			if r.name == alias {
+				return true
+			}
+			if visited[r.name] {
+				continue
+			}
+			visited[r.name] = true
+			if walk(r.name) {
+				return true
+			}
+		}
+		return false
+	}
+	if walk(alias) {
+		return errAliasCycle
+	}
+	return nil
+}
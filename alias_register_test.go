@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegisterTagAlias(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&aliasedDoc{Color: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.RegisterTagAlias("iscolor", "nonempty"); err != nil {
+		t.Fatalf("RegisterTagAlias: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error for empty aliased field")
+	}
+}
+
+func TestRegisterTagAlias_rejectsCycle(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&aliasedDoc{})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.RegisterTagAlias("a", "b"); err != nil {
+		t.Fatalf("RegisterTagAlias a->b: %v", err)
+	}
+	if err := m.RegisterTagAlias("b", "a"); err == nil {
+		t.Fatalf("expected cycle error registering b->a")
+	}
+}
+
+func TestRegisterTagAlias_afterBindingBuilt(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&aliasedDoc{Color: "#ff0000"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	if err := m.RegisterTagAlias("iscolor", "nonempty"); err == nil {
+		t.Fatalf("expected error registering alias after binding is built")
+	}
+}
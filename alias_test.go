@@ -0,0 +1,161 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type aliasedDoc struct {
+	Color string `validate:"iscolor"`
+}
+
+type customTagDoc struct {
+	Name string `check:"nonempty"`
+}
+
+func TestWithAlias(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&aliasedDoc{Color: ""}, WithAlias[aliasedDoc]("iscolor", "nonempty"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error for empty aliased field")
+	}
+
+	m2, err := New(&aliasedDoc{Color: "red"}, WithAlias[aliasedDoc]("iscolor", "nonempty"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+}
+
+func TestWithTagName(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&customTagDoc{}, WithTagName[customTagDoc]("check"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error using the custom tag name")
+	}
+}
+
+func TestWithAlias_rejectsReservedNames(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&aliasedDoc{}, WithAlias[aliasedDoc]("dive", "nonempty"))
+	if err == nil {
+		t.Fatalf("expected error registering alias named 'dive'")
+	}
+}
+
+func TestWithAlias_rejectsBuiltinCollision(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&aliasedDoc{}, WithAlias[aliasedDoc]("hexcolor", "nonempty"))
+	if !errors.Is(err, errAliasBuiltinShadows) {
+		t.Fatalf("expected errAliasBuiltinShadows, got %v", err)
+	}
+}
+
+func TestWithAliasOverride_allowsBuiltinCollision(t *testing.T) {
+	t.Parallel()
+
+	type doc struct {
+		Color string `validate:"hexcolor"`
+	}
+
+	m, err := New(&doc{Color: ""}, WithAliasOverride[doc]("hexcolor", "nonempty"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error for empty field under overridden alias")
+	}
+
+	m2, err := New(&doc{Color: "not-a-hex-color"}, WithAliasOverride[doc]("hexcolor", "nonempty"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected validation error: %v (overridden alias should accept any non-empty string)", err)
+	}
+}
+
+func TestDefaultAlias_iscolor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		color   string
+		wantErr bool
+	}{
+		{"hex", "#1a2b3c", false},
+		{"rgb", "rgb(0, 128, 255)", false},
+		{"rgba", "rgba(0, 128, 255, 0.5)", false},
+		{"hsl", "hsl(270, 60%, 70%)", false},
+		{"hsla", "hsla(270, 60%, 70%, 0.5)", false},
+		{"not a color", "mauve", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&aliasedDoc{Color: tt.color})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Color=%q: got err=%v, wantErr=%v", tt.color, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithAlias_rejectsCycle(t *testing.T) {
+	t.Parallel()
+
+	type doc struct {
+		V string `validate:"a"`
+	}
+
+	m, err := New(&doc{}, WithAlias[doc]("a", "b"), WithAlias[doc]("b", "a"))
+	if !errors.Is(err, errAliasCycle) {
+		t.Fatalf("expected errAliasCycle, got %v (model %v)", err, m)
+	}
+}
+
+func TestWithAlias_nestedExpansionWithOrChain(t *testing.T) {
+	t.Parallel()
+
+	type doc struct {
+		Swatch string `validate:"swatch"`
+	}
+
+	m, err := New(
+		&doc{Swatch: "not-a-color"},
+		WithAlias[doc]("swatch", "iscolor"), // alias-of-alias, "iscolor" itself expands to an OR-chain
+	)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error for non-color swatch")
+	}
+
+	m2, err := New(&doc{Swatch: "#ffffff"}, WithAlias[doc]("swatch", "iscolor"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+}
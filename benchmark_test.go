@@ -2,6 +2,8 @@ package model
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ygrebnov/model/validation"
@@ -106,3 +108,133 @@ func BenchmarkMediumValidate(b *testing.B) {
 		}
 	}
 }
+
+// nestedBenchLeaf and nestedBenchStruct exercise the struct-plan cache's
+// pointer/embedded-struct recursion decisions on a representative nested
+// shape, as opposed to BenchmarkMediumValidate's flat field list.
+type nestedBenchLeaf struct {
+	A string `validate:"min(1)"`
+	B int    `validate:"positive"`
+}
+
+type nestedBenchStruct struct {
+	Name     string `validate:"min(1)"`
+	Leaf     nestedBenchLeaf
+	LeafPtr  *nestedBenchLeaf
+	Leaves   []nestedBenchLeaf `validateElem:"dive"`
+	Count    int               `validate:"nonzero"`
+	Internal string            // no tags: exercises the plain pass-through path
+}
+
+// BenchmarkNestedValidate measures CPU and memory usage of repeatedly
+// validating the same nested struct value, which is where the per-type
+// structPlan cache (recursion flags resolved once per reflect.Type rather
+// than recomputed from reflect.StructField on every call) pays off most.
+func BenchmarkNestedValidate(b *testing.B) {
+	obj := nestedBenchStruct{
+		Name:    "benchmark",
+		Leaf:    nestedBenchLeaf{A: "x", B: 1},
+		LeafPtr: &nestedBenchLeaf{A: "y", B: 2},
+		Leaves: []nestedBenchLeaf{
+			{A: "p", B: 1},
+			{A: "q", B: 2},
+			{A: "r", B: 3},
+		},
+		Count: 7,
+	}
+
+	m, err := New(&obj)
+	if err != nil {
+		b.Fatalf("failed to create model: %v", err)
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := m.Validate(context.Background()); err != nil {
+			b.Fatalf("validation failed: %v", err)
+		}
+	}
+}
+
+// BenchmarkPipelineValidate_Reused measures repeatedly validating distinct
+// objects through the same, already-built Pipeline, to demonstrate that a
+// Pipeline carries no per-validation state and so costs nothing extra to
+// reuse across calls (in contrast to building a fresh Pipeline every time).
+func BenchmarkPipelineValidate_Reused(b *testing.B) {
+	nonempty, err := NewRule[string]("nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatalf("NewRule: %v", err)
+	}
+
+	p := NewPipeline[pipelineUser](
+		For[pipelineUser]("Name", func(u *pipelineUser) string { return u.Name }).Rules(nonempty),
+		For[pipelineUser]("Email", func(u *pipelineUser) string { return u.Email }).Rules(nonempty),
+	)
+	obj := pipelineUser{Name: "Ada", Email: "ada@example.com"}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := p.Validate(context.Background(), &obj); err != nil {
+			b.Fatalf("validation failed: %v", err)
+		}
+	}
+}
+
+// BenchmarkRuleResolution_NaiveVsWarm compares resolving a field's rule chain
+// from scratch on every call (re-parsing the raw tag and re-querying
+// rulesRegistry.get by name and type, as validateStructOpts would have to do
+// without caching) against going through typeBinding's warm caches:
+// rulesMapping (parsed []ruleNameParams per field, see validateStructOpts) and
+// tb.resolvedRule (memoized rulesRegistry.get, see core.go). Both variants
+// apply the same rule to the same value; the difference is purely how many
+// times tag-parsing and registry lookup redo work that doesn't change between
+// calls for a given field.
+func BenchmarkRuleResolution_NaiveVsWarm(b *testing.B) {
+	const rawTag = "min(1)"
+	fv := reflect.ValueOf("benchmark value")
+
+	m, err := New(&benchStruct{S: "x", I: 1, D: 1})
+	if err != nil {
+		b.Fatalf("New: %v", err)
+	}
+	if err := m.ensureBinding(); err != nil {
+		b.Fatalf("ensureBinding: %v", err)
+	}
+	tb := m.binding
+
+	// Warm the caches once up front, mirroring steady-state repeated Validate
+	// calls on an already-built Model.
+	rules := parseTag(rawTag)
+	if _, err := tb.resolvedRule(rules[0].name, fv); err != nil {
+		b.Fatalf("resolvedRule: %v", err)
+	}
+
+	b.Run("Naive", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, r := range parseTag(rawTag) {
+				if _, err := tb.rulesRegistry.get(r.name, fv); err != nil {
+					b.Fatalf("get: %v", err)
+				}
+			}
+		}
+	})
+
+	b.Run("Warm", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, r := range rules {
+				if _, err := tb.resolvedRule(r.name, fv); err != nil {
+					b.Fatalf("resolvedRule: %v", err)
+				}
+			}
+		}
+	})
+}
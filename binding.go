@@ -2,10 +2,14 @@ package model
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"reflect"
+	"time"
 
 	"github.com/ygrebnov/model/errors"
 	"github.com/ygrebnov/model/internal/core"
+	"github.com/ygrebnov/model/schema"
 	"github.com/ygrebnov/model/validation"
 )
 
@@ -19,6 +23,45 @@ type service interface {
 	SetDefaultsStruct(v reflect.Value) error
 	AddRule(r validation.Rule) error
 	ValidateStruct(ctx context.Context, v reflect.Value, fieldPath string, ve *validation.Error) error
+	AddStructRule(typ reflect.Type, fn func(ctx context.Context, v reflect.Value, path string) error)
+	AddAlias(alias, expansion string) error
+	SetNameTag(tag string)
+	RegisterDefaultResolver(prefix string, fn func(key string) (string, bool, error))
+	RegisterTypeFunc(fn func(reflect.Value) reflect.Value, types ...reflect.Type)
+	SetDefaultsFromOverlay(v reflect.Value, overlay io.Reader, format string) error
+	Type() reflect.Type
+	Registry() validation.RulesRegistry
+}
+
+// roleScopedService is implemented by the concrete *core.Service that
+// newService constructs. It is kept out of the service interface above
+// because WithActiveRoles returns *core.Service concretely (it shares
+// mutex-guarded state with the Service it scopes, so it cannot be expressed
+// through an interface without an import cycle back to this package), and a
+// concrete struct return type can't be usefully produced by a lightweight
+// test double. Binding.WithActiveRoles type-asserts against it instead.
+type roleScopedService interface {
+	WithActiveRoles(roles ...string) *core.Service
+}
+
+// modeScopedService is roleScopedService's counterpart for WithValidationMode,
+// kept separate for the same reason: WithValidationMode returns *core.Service
+// concretely, so Binding.WithValidationMode type-asserts against it instead.
+type modeScopedService interface {
+	WithValidationMode(mode core.ValidationMode) *core.Service
+}
+
+// timeoutScopedService and parallelScopedService are modeScopedService's
+// counterparts for WithValidationTimeout and WithValidationParallelism, kept
+// as separate one-method interfaces for the same reason: each returns
+// *core.Service concretely, so the matching Binding method type-asserts
+// against it instead of widening the shared service interface.
+type timeoutScopedService interface {
+	WithValidationTimeout(d time.Duration) *core.Service
+}
+
+type parallelScopedService interface {
+	WithValidationParallelism(n int) *core.Service
 }
 
 func newService(typ reflect.Type, rr validation.RulesRegistry, rm validation.RulesMapping) (service, error) {
@@ -50,6 +93,9 @@ func NewBinding[T any]() (*Binding[T], error) {
 // its `default` / `defaultElem` tags. It is safe to call multiple times.
 // ApplyDefaults applies defaults each time it is called.
 // It is idempotent, but not once-guarded; callers control how often to invoke it.
+// If one or more tags are misconfigured, the returned error is a
+// *validation.DefaultsError collecting every failure (with its full
+// traversal path) rather than just the first one encountered.
 func (b *Binding[T]) ApplyDefaults(obj *T) error {
 	if obj == nil {
 		return errors.ErrNilObject
@@ -116,3 +162,193 @@ func (b *Binding[T]) RegisterRules(rules ...validation.Rule) error {
 	}
 	return nil
 }
+
+// RegisterStructCheck registers a struct-level invariant for T, run after all
+// of T's tagged fields have been validated. A non-nil error is folded into
+// the resulting *validation.Error as a single FieldError with Rule "struct"
+// and Path set to T's own dotted path within the document being validated
+// (e.g. "Booking.Range", or "" at the root); the hook fires for every
+// occurrence of T found during traversal, including nested/embedded structs.
+func (b *Binding[T]) RegisterStructCheck(fn func(ctx context.Context, s *T) error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	b.service.AddStructRule(typ, func(ctx context.Context, v reflect.Value, path string) error {
+		s, ok := v.Interface().(T)
+		if !ok {
+			return nil
+		}
+		return fn(ctx, &s)
+	})
+}
+
+// RegisterAlias registers alias as shorthand for expansion, so that a
+// validate tag like `validate:"iso3166_alpha2"` behaves as if it had been
+// written out as `validate:"required,len(2),uppercase,alpha"`. Aliases are
+// expanded when a field's validate tag is first parsed and may themselves
+// reference other aliases (up to a bounded depth). It returns an error if
+// alias is empty, contains rule-grammar punctuation, collides with a
+// reserved tag token, or its expansion would create a cycle with an
+// already-registered alias.
+func (b *Binding[T]) RegisterAlias(alias, expansion string) error {
+	return b.service.AddAlias(alias, expansion)
+}
+
+// SetNameTag configures the struct tag Validate reads alternate field names
+// from when composing FieldError.NamePath (e.g. "json", "yaml"), in place of
+// the default "json". A field missing the tag, or carrying "-", falls back
+// to its Go field name.
+func (b *Binding[T]) SetNameTag(tag string) {
+	b.service.SetNameTag(tag)
+}
+
+// ApplyDefaultsFromOverlay first decodes an overlay document from overlay
+// (JSON; "yaml"/"yml" report a clear unsupported-format error) into obj,
+// respecting json tags, then applies `default`/`defaultElem` tag defaults to
+// whatever fields are still zero. This gives callers a layered-config story —
+// code defaults ← file overlay ← runtime tag defaults — without writing
+// ad-hoc merge logic themselves.
+func (b *Binding[T]) ApplyDefaultsFromOverlay(obj *T, overlay io.Reader, format string) error {
+	if obj == nil {
+		return errors.ErrNilObject
+	}
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.ErrNotStructPtr
+	}
+	elem := v.Elem()
+	if elem.Kind() != reflect.Struct {
+		return errors.ErrNotStructPtr
+	}
+	return b.service.SetDefaultsFromOverlay(elem, overlay, format)
+}
+
+// RegisterDefaultResolver registers fn as the resolver for default-tag
+// alternatives beginning with prefix, so a tag like
+// `default:"lookup:region|us-east-1"` calls fn("region") for its first
+// alternative, falling through to "us-east-1" when fn reports no value.
+// Built-in resolvers are pre-registered for "$ENV:" (os.Getenv) and "file:"
+// (file contents); registering either prefix again overrides it.
+func (b *Binding[T]) RegisterDefaultResolver(prefix string, fn func(key string) (string, bool, error)) {
+	b.service.RegisterDefaultResolver(prefix, fn)
+}
+
+// RegisterTypeFunc registers fn as the extractor validate rules use to
+// unwrap a field of one of types into the primitive reflect.Value their
+// overload resolution and invocation should actually run against — e.g.
+// reducing a sql.NullString to its String field, or a uuid.UUID to its
+// string form — so a single `validate:"min=3"` rule works uniformly on a
+// plain string field and on a registered wrapper type. If fn returns an
+// invalid reflect.Value for a given field, that field's original value is
+// used instead.
+func (b *Binding[T]) RegisterTypeFunc(fn func(reflect.Value) reflect.Value, types ...reflect.Type) {
+	b.service.RegisterTypeFunc(fn, types...)
+}
+
+// RegisterCrossFieldRules registers the built-in cross-field and
+// cross-struct comparison rules (eqfield, nefield, gtfield, gtefield,
+// ltfield, ltefield, eqcsfield, nefcsfield) so they can be referenced from a
+// validate tag like `validate:"eqfield=StartDate"`. They are opt-in, like
+// RegisterRules for a custom rule, rather than always-on.
+func (b *Binding[T]) RegisterCrossFieldRules() error {
+	return validation.RegisterCrossFieldRules(b.service.Registry())
+}
+
+// RegisterConditionalRules registers the built-in conditional-requiredness
+// rules (required_if, required_unless, required_with/_all/_any,
+// required_without/_all/_any) so they can be referenced from a validate tag
+// like `validate:"required_if=Type admin"`. Opt-in, like
+// RegisterCrossFieldRules.
+func (b *Binding[T]) RegisterConditionalRules() error {
+	return validation.RegisterConditionalRules(b.service.Registry())
+}
+
+// WithActiveRoles returns a Binding scoped to the given active role set: its
+// ApplyDefaults/Validate (and their variants) apply a field's default/
+// validate tag only when the field's defaultRole/validateRole predicate is
+// satisfied by roles — OR across "|"-separated groups, AND within a
+// comma-separated group — or when the field carries no such predicate at
+// all. The returned Binding shares this Binding's registry, rule mapping,
+// and every other registered extension point (aliases, resolvers, struct
+// checks); only the active role set differs, so the same T can be validated
+// or defaulted more strictly (or more leniently) per call site without
+// duplicating the struct.
+func (b *Binding[T]) WithActiveRoles(roles ...string) *Binding[T] {
+	rs, ok := b.service.(roleScopedService)
+	if !ok {
+		return b
+	}
+	return &Binding[T]{service: rs.WithActiveRoles(roles...)}
+}
+
+// WithValidationMode returns a Binding scoped to mode: ModeFailFast makes
+// Validate stop at the first failing rule, returning a *validation.Error
+// holding that single FieldError, instead of the default ModeCollectAll,
+// which keeps walking and aggregates every failure. The returned Binding
+// shares this Binding's registry, rule mapping, and every other registered
+// extension point; only the validation mode differs.
+func (b *Binding[T]) WithValidationMode(mode ValidationMode) *Binding[T] {
+	ms, ok := b.service.(modeScopedService)
+	if !ok {
+		return b
+	}
+	coreMode := core.ModeCollectAll
+	if mode == ModeFailFast {
+		coreMode = core.ModeFailFast
+	}
+	return &Binding[T]{service: ms.WithValidationMode(coreMode)}
+}
+
+// WithValidationTimeout returns a Binding scoped to a per-call validation
+// deadline: Validate and ValidateWithDefaults wrap the context they are
+// given in context.WithTimeout(ctx, d) before walking obj, so a rule that
+// never returns (e.g. a custom rule that hits a database to check
+// uniqueness) cannot hang a validation call forever. Every built-in walk
+// already checks ctx.Err() between fields, elements, and rules, so the
+// resulting context.DeadlineExceeded propagates out like any
+// caller-supplied cancellation would. d <= 0 disables the timeout, the
+// default. The returned Binding shares this Binding's registry, rule
+// mapping, and every other registered extension point; only the timeout
+// differs.
+func (b *Binding[T]) WithValidationTimeout(d time.Duration) *Binding[T] {
+	ts, ok := b.service.(timeoutScopedService)
+	if !ok {
+		return b
+	}
+	return &Binding[T]{service: ts.WithValidationTimeout(d)}
+}
+
+// WithValidationParallelism returns a Binding scoped to validate the direct
+// fields of each struct level concurrently, on a worker pool bounded to n
+// goroutines at a time, instead of Validate's default sequential field
+// loop. This only helps when at least one registered rule genuinely blocks;
+// for the built-in in-memory rules it mostly adds goroutine overhead. Under
+// ModeFailFast, the first field to fail at a given struct level cancels its
+// siblings still in flight there; under the default ModeCollectAll every
+// field still runs to completion. n <= 1 disables parallelism, the default.
+// The returned Binding shares this Binding's registry, rule mapping, and
+// every other registered extension point; only the parallelism differs.
+func (b *Binding[T]) WithValidationParallelism(n int) *Binding[T] {
+	ps, ok := b.service.(parallelScopedService)
+	if !ok {
+		return b
+	}
+	return &Binding[T]{service: ps.WithValidationParallelism(n)}
+}
+
+// JSONSchema returns a Draft 2020-12 JSON Schema object describing T, derived
+// from its `validate` / `default` tags: `required` populates the parent's
+// `required` array, `min`/`max` become `minimum`/`maximum` (or
+// `minLength`/`maxLength` on strings), `pattern` and `oneof` become `pattern`
+// and `enum`, and custom rules may contribute further keywords by
+// implementing schema.SchemaContributor. The result is cached per type and
+// its keys are sorted, so repeated calls are byte-for-byte identical.
+func (b *Binding[T]) JSONSchema() ([]byte, error) {
+	node := schema.Build(b.service.Type(), b.service.Registry())
+	return json.Marshal(node)
+}
+
+// OpenAPISchema returns T's schema in the OpenAPI Schema Object format.
+// OpenAPI 3.1 adopted JSON Schema Draft 2020-12 verbatim for its Schema
+// Object, so this is equivalent to JSONSchema.
+func (b *Binding[T]) OpenAPISchema() ([]byte, error) {
+	return b.JSONSchema()
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/ygrebnov/model/cueschema"
+	"github.com/ygrebnov/model/errors"
+)
+
+// cueScopedService wraps a service, running spec's defaults before the
+// wrapped service's own tag-driven SetDefaultsStruct. Since ApplyDefaults
+// only ever writes to a field that is still zero, applying the cueschema
+// defaults first gives them priority over a `default` struct tag on the same
+// field without suppressing the tag for every other field.
+type cueScopedService struct {
+	service
+	spec *cueschema.Schema
+}
+
+func (c *cueScopedService) SetDefaultsStruct(v reflect.Value) error {
+	if err := c.spec.ApplyDefaults(v); err != nil {
+		return err
+	}
+	return c.service.SetDefaultsStruct(v)
+}
+
+// NewBindingFromCUE constructs a Binding for T whose field defaults and
+// numeric range constraints come from a cueschema document (see package
+// cueschema for the supported grammar, a deliberately small subset of CUE)
+// rather than `default`/`validate` struct tags. It is built on top of
+// NewBinding, so every other Binding capability — RegisterRules,
+// RegisterDefaultResolver, ApplyDefaultsFromOverlay, WithActiveRoles, and so
+// on — still works, and struct tags on T still apply to any field the
+// schema doesn't mention.
+//
+// Where a field carries both a cueschema default and a `default` tag, the
+// cueschema default wins. Where a field carries both a cueschema range
+// constraint and tag-based validate rules, both are enforced — the
+// constraint is registered as an additional struct-level check (see
+// RegisterStructCheck) rather than suppressing the tag's rule, since the
+// field plan a `validate` tag compiles into is cached process-wide for T and
+// is not safe for one Binding to mutate.
+func NewBindingFromCUE[T any](schema []byte) (*Binding[T], error) {
+	var zero *T
+	t := reflect.TypeOf(zero).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, errors.ErrNotStructPtr
+	}
+
+	spec, err := cueschema.Parse(schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.BindTo(t); err != nil {
+		return nil, err
+	}
+
+	b, err := NewBinding[T]()
+	if err != nil {
+		return nil, err
+	}
+	b.service = &cueScopedService{service: b.service, spec: spec}
+
+	if spec.HasConstraints() {
+		b.RegisterStructCheck(func(_ context.Context, obj *T) error {
+			return spec.ValidateConstraints(reflect.ValueOf(obj).Elem())
+		})
+	}
+
+	return b, nil
+}
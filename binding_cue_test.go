@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+func TestNewBindingFromCUE_appliesDefaultsAndConstraints(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Name string
+		Port int
+	}
+
+	b, err := NewBindingFromCUE[server]([]byte(
+		"Name: *\"svc\" | string\nPort: *8080 | int, >=1024 & <=65535\n",
+	))
+	if err != nil {
+		t.Fatalf("NewBindingFromCUE: %v", err)
+	}
+
+	obj := &server{}
+	if err := b.ApplyDefaults(obj); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if obj.Name != "svc" || obj.Port != 8080 {
+		t.Fatalf("unexpected defaults: %+v", obj)
+	}
+	if err := b.Validate(context.Background(), obj); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	obj2 := &server{Name: "svc", Port: 1}
+	if err := b.Validate(context.Background(), obj2); err == nil {
+		t.Fatalf("expected a range violation for Port=1")
+	}
+}
+
+func TestNewBindingFromCUE_defaultOverridesTag(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Env string `default:"dev"`
+	}
+
+	b, err := NewBindingFromCUE[config]([]byte(`Env: *"prod" | string`))
+	if err != nil {
+		t.Fatalf("NewBindingFromCUE: %v", err)
+	}
+
+	obj := &config{}
+	if err := b.ApplyDefaults(obj); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if obj.Env != "prod" {
+		t.Fatalf("Env = %q, want %q (cueschema default overrides the tag)", obj.Env, "prod")
+	}
+}
+
+func TestNewBindingFromCUE_unknownFieldReportsError(t *testing.T) {
+	t.Parallel()
+
+	type config struct{ Name string }
+	_, err := NewBindingFromCUE[config]([]byte(`Missing: *"x" | string`))
+	if err == nil {
+		t.Fatalf("expected an error for a schema field not present on config")
+	}
+	if !errors.Is(err, modelerrors.ErrInvalidCUESchema) {
+		t.Fatalf("expected ErrInvalidCUESchema, got %v", err)
+	}
+}
+
+func TestNewBindingFromCUE_notStruct(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewBindingFromCUE[int]([]byte(``))
+	if !errors.Is(err, modelerrors.ErrNotStructPtr) {
+		t.Fatalf("expected ErrNotStructPtr, got %v", err)
+	}
+}
@@ -2,11 +2,15 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	modelErrors "github.com/ygrebnov/model/errors"
 	"github.com/ygrebnov/model/validation"
@@ -17,10 +21,19 @@ type fakeService struct {
 	setDefaultsErr error
 	validateErr    error
 	rulesErr       error
-
-	lastDefaultsValue reflect.Value
-	lastValidateValue reflect.Value
-	validateCtx       context.Context
+	aliasErr       error
+
+	typ      reflect.Type
+	registry validation.RulesRegistry
+
+	lastDefaultsValue  reflect.Value
+	lastValidateValue  reflect.Value
+	validateCtx        context.Context
+	lastNameTag        string
+	lastResolverPrefix string
+	lastOverlayFormat  string
+	overlayErr         error
+	lastTypeFuncTypes  []reflect.Type
 }
 
 func (f *fakeService) SetDefaultsStruct(v reflect.Value) error {
@@ -38,6 +51,39 @@ func (f *fakeService) ValidateStruct(ctx context.Context, v reflect.Value, _ str
 	return f.validateErr
 }
 
+func (f *fakeService) AddStructRule(reflect.Type, func(ctx context.Context, v reflect.Value, path string) error) {
+}
+
+func (f *fakeService) AddAlias(alias, expansion string) error {
+	return f.aliasErr
+}
+
+func (f *fakeService) SetNameTag(tag string) {
+	f.lastNameTag = tag
+}
+
+func (f *fakeService) RegisterDefaultResolver(prefix string, fn func(key string) (string, bool, error)) {
+	f.lastResolverPrefix = prefix
+}
+
+func (f *fakeService) RegisterTypeFunc(fn func(reflect.Value) reflect.Value, types ...reflect.Type) {
+	f.lastTypeFuncTypes = types
+}
+
+func (f *fakeService) SetDefaultsFromOverlay(v reflect.Value, overlay io.Reader, format string) error {
+	f.lastOverlayFormat = format
+	f.lastDefaultsValue = v
+	return f.overlayErr
+}
+
+func (f *fakeService) Type() reflect.Type {
+	return f.typ
+}
+
+func (f *fakeService) Registry() validation.RulesRegistry {
+	return f.registry
+}
+
 // TestNewBinding covers constructor behavior for valid and invalid type parameters.
 func TestNewBinding(t *testing.T) {
 	t.Run("struct type", func(t *testing.T) {
@@ -209,6 +255,421 @@ func TestBinding_RegisterRules(t *testing.T) {
 	})
 }
 
+func TestBinding_RegisterStructCheck(t *testing.T) {
+	type dateRange struct {
+		Start int
+		End   int
+	}
+
+	b, err := NewBinding[dateRange]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	b.RegisterStructCheck(func(_ context.Context, s *dateRange) error {
+		if s.Start > s.End {
+			return fmt.Errorf("Start must not be after End")
+		}
+		return nil
+	})
+
+	if err := b.Validate(context.Background(), &dateRange{Start: 5, End: 1}); err == nil {
+		t.Fatalf("expected struct check to fail")
+	}
+	if err := b.Validate(context.Background(), &dateRange{Start: 1, End: 5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBinding_RegisterAlias(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	base.aliasErr = errors.New("alias")
+	if err := b.RegisterAlias("dummy", "required"); !errors.Is(err, base.aliasErr) {
+		t.Fatalf("expected alias error %v, got %v", base.aliasErr, err)
+	}
+
+	base.aliasErr = nil
+	if err := b.RegisterAlias("dummy", "required"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestBinding_SetNameTag(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	b.SetNameTag("yaml")
+	if base.lastNameTag != "yaml" {
+		t.Fatalf("expected SetNameTag to forward to the service, got %q", base.lastNameTag)
+	}
+}
+
+func TestBinding_ApplyDefaultsFromOverlay(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	zero := &sample{}
+	nilPtr := (*sample)(nil)
+
+	t.Run("nil object", func(t *testing.T) {
+		if err := b.ApplyDefaultsFromOverlay(nilPtr, strings.NewReader("{}"), "json"); !errors.Is(err, modelErrors.ErrNilObject) {
+			t.Fatalf("expected ErrNilObject, got %v", err)
+		}
+	})
+
+	t.Run("forwards overlay and format", func(t *testing.T) {
+		base.lastDefaultsValue = reflect.Value{}
+		base.overlayErr = nil
+		if err := b.ApplyDefaultsFromOverlay(zero, strings.NewReader(`{"A":1}`), "json"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if base.lastOverlayFormat != "json" {
+			t.Fatalf("expected format to be forwarded, got %q", base.lastOverlayFormat)
+		}
+		if !base.lastDefaultsValue.IsValid() {
+			t.Fatalf("expected SetDefaultsFromOverlay to be called")
+		}
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		base.overlayErr = errors.New("overlay")
+		if err := b.ApplyDefaultsFromOverlay(zero, strings.NewReader("{}"), "json"); !errors.Is(err, base.overlayErr) {
+			t.Fatalf("expected overlay error %v, got %v", base.overlayErr, err)
+		}
+	})
+}
+
+func TestBinding_WithActiveRoles_fallsBackOnNonScopableService(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	scoped := b.WithActiveRoles("admin")
+	if scoped != b {
+		t.Fatalf("expected WithActiveRoles to return the same Binding when the underlying service cannot be scoped")
+	}
+}
+
+func TestBinding_WithActiveRoles_gatesDefaultsAndValidation(t *testing.T) {
+	type config struct {
+		Port  string `default:"8080" defaultRole:"admin"`
+		Debug string `validate:"eq(prod)" validateRole:"admin,ops"`
+	}
+
+	b, err := NewBinding[config]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	eq, err := validation.NewRule[string]("eq", func(v string, params ...string) error {
+		if len(params) == 1 && v == params[0] {
+			return nil
+		}
+		return fmt.Errorf("eq: %q != %q", v, params)
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := b.RegisterRules(eq); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	unscoped := &config{Debug: "dev"}
+	if err := b.ApplyDefaults(unscoped); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if unscoped.Port != "" {
+		t.Fatalf("expected the admin-only default to stay unset without active roles, got %q", unscoped.Port)
+	}
+	if err := b.Validate(context.Background(), unscoped); err != nil {
+		t.Fatalf("expected the admin/ops-only rule to be skipped without active roles, got %v", err)
+	}
+
+	adminOnly := b.WithActiveRoles("admin")
+	scoped := &config{Debug: "dev"}
+	if err := adminOnly.ApplyDefaults(scoped); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if scoped.Port != "8080" {
+		t.Fatalf("expected the admin-only default to apply once scoped to admin, got %q", scoped.Port)
+	}
+	if err := adminOnly.Validate(context.Background(), scoped); err == nil {
+		t.Fatalf("expected the admin,ops-gated rule to apply and fail once scoped to admin")
+	}
+
+	adminAndOps := b.WithActiveRoles("admin", "ops")
+	prod := &config{Debug: "prod"}
+	if err := adminAndOps.Validate(context.Background(), prod); err != nil {
+		t.Fatalf("expected the rule to pass once Debug matches, got %v", err)
+	}
+}
+
+func TestBinding_WithValidationMode_fallsBackOnNonScopableService(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	scoped := b.WithValidationMode(ModeFailFast)
+	if scoped != b {
+		t.Fatalf("expected WithValidationMode to return the same Binding when the underlying service cannot be scoped")
+	}
+}
+
+func TestBinding_WithValidationMode_stopsAtFirstFailure(t *testing.T) {
+	type config struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+	}
+
+	b, err := NewBinding[config]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	required, err := validation.NewRule[string]("required", func(v string, _ ...string) error {
+		if v == "" {
+			return fmt.Errorf("required: empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := b.RegisterRules(required); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	obj := &config{}
+
+	err = b.Validate(context.Background(), obj)
+	var collected *validation.Error
+	if !errors.As(err, &collected) || collected.Len() != 2 {
+		t.Fatalf("expected ModeCollectAll (the default) to gather both failures, got %v", err)
+	}
+
+	failFast := b.WithValidationMode(ModeFailFast)
+	err = failFast.Validate(context.Background(), obj)
+	var single *validation.Error
+	if !errors.As(err, &single) || single.Len() != 1 {
+		t.Fatalf("expected ModeFailFast to stop after the first failure, got %v", err)
+	}
+}
+
+func TestBinding_WithValidationTimeout_fallsBackOnNonScopableService(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	scoped := b.WithValidationTimeout(time.Millisecond)
+	if scoped != b {
+		t.Fatalf("expected WithValidationTimeout to return the same Binding when the underlying service cannot be scoped")
+	}
+}
+
+func TestBinding_WithValidationTimeout_abortsSlowRule(t *testing.T) {
+	type config struct {
+		A string `validate:"slow"`
+		B string `validate:"slow"`
+	}
+
+	b, err := NewBinding[config]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	slow, err := validation.NewRule[string]("slow", func(v string, _ ...string) error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := b.RegisterRules(slow); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	obj := &config{A: "x", B: "y"}
+
+	timed := b.WithValidationTimeout(5 * time.Millisecond)
+	err = timed.Validate(context.Background(), obj)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded once the timeout elapses mid-validation, got %v", err)
+	}
+}
+
+func TestBinding_WithValidationParallelism_fallsBackOnNonScopableService(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	scoped := b.WithValidationParallelism(4)
+	if scoped != b {
+		t.Fatalf("expected WithValidationParallelism to return the same Binding when the underlying service cannot be scoped")
+	}
+}
+
+func TestBinding_WithValidationParallelism_stillCollectsEveryFailure(t *testing.T) {
+	type config struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+		C string `validate:"required"`
+	}
+
+	b, err := NewBinding[config]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	required, err := validation.NewRule[string]("required", func(v string, _ ...string) error {
+		if v == "" {
+			return fmt.Errorf("required: empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := b.RegisterRules(required); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	obj := &config{}
+
+	parallel := b.WithValidationParallelism(3)
+	err = parallel.Validate(context.Background(), obj)
+	var collected *validation.Error
+	if !errors.As(err, &collected) || collected.Len() != 3 {
+		t.Fatalf("expected every field's failure to be collected under parallelism, got %v", err)
+	}
+}
+
+func TestBinding_RegisterDefaultResolver(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	b.RegisterDefaultResolver("lookup:", func(key string) (string, bool, error) {
+		return "", false, nil
+	})
+	if base.lastResolverPrefix != "lookup:" {
+		t.Fatalf("expected RegisterDefaultResolver to forward the prefix, got %q", base.lastResolverPrefix)
+	}
+}
+
+func TestBinding_RegisterTypeFunc(t *testing.T) {
+	type sample struct{ A int }
+
+	base := &fakeService{}
+	b := &Binding[sample]{service: base}
+
+	wantType := reflect.TypeOf(sample{})
+	b.RegisterTypeFunc(func(v reflect.Value) reflect.Value { return v }, wantType)
+	if len(base.lastTypeFuncTypes) != 1 || base.lastTypeFuncTypes[0] != wantType {
+		t.Fatalf("expected RegisterTypeFunc to forward the type list, got %v", base.lastTypeFuncTypes)
+	}
+}
+
+func TestBinding_RegisterAlias_expansion(t *testing.T) {
+	type codeThing struct {
+		Code string `validate:"iso3166_alpha2"`
+	}
+
+	b, err := NewBinding[codeThing]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+	if err := b.RegisterAlias("iso3166_alpha2", "required,len(2)"); err != nil {
+		t.Fatalf("RegisterAlias: %v", err)
+	}
+
+	required, err := validation.NewRule[string]("required", func(v string, _ ...string) error {
+		if v == "" {
+			return fmt.Errorf("required")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule(required): %v", err)
+	}
+	length, err := validation.NewRule[string]("len", func(v string, params ...string) error {
+		if len(params) == 1 && strconv.Itoa(len(v)) != params[0] {
+			return fmt.Errorf("wrong length")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule(len): %v", err)
+	}
+	if err := b.RegisterRules(required, length); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	if err := b.Validate(context.Background(), &codeThing{Code: "US"}); err != nil {
+		t.Fatalf("expected \"US\" to satisfy the iso3166_alpha2 alias, got %v", err)
+	}
+	if err := b.Validate(context.Background(), &codeThing{Code: "USA"}); err == nil {
+		t.Fatalf("expected \"USA\" to fail len(2)")
+	}
+}
+
+func TestBinding_JSONSchema(t *testing.T) {
+	type person struct {
+		Name string `json:"name" validate:"required,min(1)"`
+		Age  int    `json:"age" validate:"min(0),max(150)" default:"18"`
+	}
+
+	b, err := NewBinding[person]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	data, err := b.JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal schema: %v; raw=%s", err, data)
+	}
+
+	if got["type"] != "object" {
+		t.Fatalf("type = %v, want object", got["type"])
+	}
+	required, _ := got["required"].([]any)
+	if len(required) != 1 || required[0] != "name" {
+		t.Fatalf("required = %v, want [name]", got["required"])
+	}
+	props, _ := got["properties"].(map[string]any)
+	name, _ := props["name"].(map[string]any)
+	if name["type"] != "string" || name["minLength"] != float64(1) {
+		t.Fatalf("name schema wrong: %+v", name)
+	}
+	age, _ := props["age"].(map[string]any)
+	if age["type"] != "integer" || age["minimum"] != float64(0) || age["maximum"] != float64(150) {
+		t.Fatalf("age schema wrong: %+v", age)
+	}
+	if age["default"] != float64(18) {
+		t.Fatalf("age default = %v, want 18", age["default"])
+	}
+
+	openAPIData, err := b.OpenAPISchema()
+	if err != nil {
+		t.Fatalf("OpenAPISchema: %v", err)
+	}
+	if string(openAPIData) != string(data) {
+		t.Fatalf("OpenAPISchema() differs from JSONSchema(): %s vs %s", openAPIData, data)
+	}
+}
+
 func TestBindingAndModel_Consistency(t *testing.T) {
 	type Sample struct {
 		// Default/validation tags mirror existing examples in the repo.
@@ -2,7 +2,10 @@ package model
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -21,6 +24,108 @@ var (
 	float64Type = reflect.TypeOf(float64(0))
 )
 
+// Patterns backing the hexcolor/rgb/rgba/hsl/hsla built-in string rules,
+// deliberately permissive (they check shape, not numeric range).
+var (
+	hexcolorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	rgbPattern      = regexp.MustCompile(`^rgb\(\s*\d{1,3}\s*,\s*\d{1,3}\s*,\s*\d{1,3}\s*\)$`)
+	rgbaPattern     = regexp.MustCompile(`^rgba\(\s*\d{1,3}\s*,\s*\d{1,3}\s*,\s*\d{1,3}\s*,\s*(?:0|1|0?\.\d+)\s*\)$`)
+	hslPattern      = regexp.MustCompile(`^hsl\(\s*\d{1,3}\s*,\s*\d{1,3}%\s*,\s*\d{1,3}%\s*\)$`)
+	hslaPattern     = regexp.MustCompile(`^hsla\(\s*\d{1,3}\s*,\s*\d{1,3}%\s*,\s*\d{1,3}%\s*,\s*(?:0|1|0?\.\d+)\s*\)$`)
+
+	// Patterns backing the email/uuid/hostname built-in string rules. These
+	// are deliberately permissive shape checks, not full RFC validation; ip,
+	// ipv4, and ipv6 instead use net.ParseIP, and url uses net/url.
+	emailPattern    = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+	// Patterns backing the alpha/alphanum/numeric built-in string rules.
+	alphaPattern    = regexp.MustCompile(`^[a-zA-Z]+$`)
+	alphanumPattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	numericPattern  = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
+)
+
+// regexRuleCache memoizes compiled patterns for the "regex" built-in rule, so
+// validating many values against the same validate:"regex(...)" tag compiles
+// the pattern once rather than on every call.
+var regexRuleCache sync.Map // pattern string -> *regexp.Regexp
+
+// compiledPattern returns the cached *regexp.Regexp for pattern, compiling
+// and caching it on first use.
+func compiledPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexRuleCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := regexRuleCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
+
+// parseRangeParams parses the two parameters of a "range(lo,hi)" tag with
+// parse, shared by the int/int64/float64 "range" overloads.
+func parseRangeParams[T any](params []string, parse func(string) (T, error)) (lo, hi T, err error) {
+	if len(params) != 2 {
+		err = fmt.Errorf(`range requires exactly two parameters, e.g. validate:"range(1,10)"`)
+		return
+	}
+	lo, err = parse(strings.TrimSpace(params[0]))
+	if err != nil {
+		err = fmt.Errorf("invalid range lower bound %q: %v", params[0], err)
+		return
+	}
+	hi, err = parse(strings.TrimSpace(params[1]))
+	if err != nil {
+		err = fmt.Errorf("invalid range upper bound %q: %v", params[1], err)
+		return
+	}
+	return lo, hi, nil
+}
+
+// parseIntRangeParams is parseRangeParams specialized for "int", the most
+// common numeric field type.
+func parseIntRangeParams(params []string) (lo, hi int, err error) {
+	return parseRangeParams(params, func(s string) (int, error) {
+		v, err := strconv.ParseInt(s, 10, 0)
+		return int(v), err
+	})
+}
+
+// parseCompareParam parses the single parameter of a "gt(N)"/"gte(N)"/
+// "lt(N)"/"lte(N)"/"eq(N)"/"ne(N)" tag with parse, shared by the
+// int/int64/float64 overloads of those rules.
+func parseCompareParam[T any](rule string, params []string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	if len(params) != 1 {
+		return zero, fmt.Errorf(`%s requires exactly one parameter, e.g. validate:"%s(1)"`, rule, rule)
+	}
+	v, err := parse(strings.TrimSpace(params[0]))
+	if err != nil {
+		return zero, fmt.Errorf("invalid %s parameter %q: %v", rule, params[0], err)
+	}
+	return v, nil
+}
+
+// parsedOneof parses each of params with parse and reports whether n equals
+// any of the resulting values, letting the int/int64/float64 "oneof"
+// overloads share one parsing/comparison implementation instead of each
+// duplicating the parse-loop.
+func parsedOneof[T comparable](n T, params []string, parse func(string) (T, error)) (bool, error) {
+	for _, p := range params {
+		v, err := parse(strings.TrimSpace(p))
+		if err != nil {
+			return false, fmt.Errorf("invalid oneof parameter %q: %v", p, err)
+		}
+		if v == n {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Lazy built-in rule storage.
 var (
 	builtInsOnce        sync.Once
@@ -54,7 +159,273 @@ func ensureBuiltIns() {
 			}
 			return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
 		})
-		builtinStringRules = []Rule{nonemptyStr, oneofStr}
+		hexcolorStr, _ := NewRule[string]("hexcolor", func(s string, _ ...string) error {
+			if !hexcolorPattern.MatchString(s) {
+				return fmt.Errorf("must be a hex color, e.g. #1a2b3c")
+			}
+			return nil
+		})
+		rgbStr, _ := NewRule[string]("rgb", func(s string, _ ...string) error {
+			if !rgbPattern.MatchString(s) {
+				return fmt.Errorf("must be an rgb() color, e.g. rgb(0,128,255)")
+			}
+			return nil
+		})
+		rgbaStr, _ := NewRule[string]("rgba", func(s string, _ ...string) error {
+			if !rgbaPattern.MatchString(s) {
+				return fmt.Errorf("must be an rgba() color, e.g. rgba(0,128,255,0.5)")
+			}
+			return nil
+		})
+		hslStr, _ := NewRule[string]("hsl", func(s string, _ ...string) error {
+			if !hslPattern.MatchString(s) {
+				return fmt.Errorf("must be an hsl() color, e.g. hsl(270,60%%,70%%)")
+			}
+			return nil
+		})
+		hslaStr, _ := NewRule[string]("hsla", func(s string, _ ...string) error {
+			if !hslaPattern.MatchString(s) {
+				return fmt.Errorf("must be an hsla() color, e.g. hsla(270,60%%,70%%,0.5)")
+			}
+			return nil
+		})
+		minStr, _ := NewRule[string]("min", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`min requires exactly one parameter, e.g. validate:"min(3)"`)
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+			if err != nil {
+				return fmt.Errorf("invalid min parameter %q for string: %v", params[0], err)
+			}
+			if len(s) < n {
+				return fmt.Errorf("length must be >= %d", n)
+			}
+			return nil
+		})
+		maxStr, _ := NewRule[string]("max", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`max requires exactly one parameter, e.g. validate:"max(10)"`)
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+			if err != nil {
+				return fmt.Errorf("invalid max parameter %q for string: %v", params[0], err)
+			}
+			if len(s) > n {
+				return fmt.Errorf("length must be <= %d", n)
+			}
+			return nil
+		})
+		lenStr, _ := NewRule[string]("len", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`len requires exactly one parameter, e.g. validate:"len(5)"`)
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+			if err != nil {
+				return fmt.Errorf("invalid len parameter %q for string: %v", params[0], err)
+			}
+			if len(s) != n {
+				return fmt.Errorf("length must be exactly %d", n)
+			}
+			return nil
+		})
+		rangeStr, _ := NewRule[string]("range", func(s string, params ...string) error {
+			lo, hi, err := parseIntRangeParams(params)
+			if err != nil {
+				return err
+			}
+			if len(s) < lo || len(s) > hi {
+				return fmt.Errorf("length must be between %d and %d", lo, hi)
+			}
+			return nil
+		})
+		regexStr, _ := NewRule[string]("regex", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`regex requires exactly one parameter, e.g. validate:"regex(^[a-z]+$)"`)
+			}
+			re, err := compiledPattern(params[0])
+			if err != nil {
+				return fmt.Errorf("invalid regex parameter %q: %v", params[0], err)
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("must match pattern %s", params[0])
+			}
+			return nil
+		})
+		containsStr, _ := NewRule[string]("contains", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`contains requires exactly one parameter, e.g. validate:"contains(@)"`)
+			}
+			if !strings.Contains(s, params[0]) {
+				return fmt.Errorf("must contain %q", params[0])
+			}
+			return nil
+		})
+		startswithStr, _ := NewRule[string]("startswith", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`startswith requires exactly one parameter, e.g. validate:"startswith(https://)"`)
+			}
+			if !strings.HasPrefix(s, params[0]) {
+				return fmt.Errorf("must start with %q", params[0])
+			}
+			return nil
+		})
+		endswithStr, _ := NewRule[string]("endswith", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`endswith requires exactly one parameter, e.g. validate:"endswith(.com)"`)
+			}
+			if !strings.HasSuffix(s, params[0]) {
+				return fmt.Errorf("must end with %q", params[0])
+			}
+			return nil
+		})
+		emailStr, _ := NewRule[string]("email", func(s string, _ ...string) error {
+			if !emailPattern.MatchString(s) {
+				return fmt.Errorf("must be a valid email address")
+			}
+			return nil
+		})
+		urlStr, _ := NewRule[string]("url", func(s string, _ ...string) error {
+			u, err := url.ParseRequestURI(s)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("must be a valid URL")
+			}
+			return nil
+		})
+		uuidStr, _ := NewRule[string]("uuid", func(s string, _ ...string) error {
+			if !uuidPattern.MatchString(s) {
+				return fmt.Errorf("must be a valid UUID")
+			}
+			return nil
+		})
+		ipStr, _ := NewRule[string]("ip", func(s string, _ ...string) error {
+			if net.ParseIP(s) == nil {
+				return fmt.Errorf("must be a valid IP address")
+			}
+			return nil
+		})
+		ipv4Str, _ := NewRule[string]("ipv4", func(s string, _ ...string) error {
+			if ip := net.ParseIP(s); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("must be a valid IPv4 address")
+			}
+			return nil
+		})
+		ipv6Str, _ := NewRule[string]("ipv6", func(s string, _ ...string) error {
+			if ip := net.ParseIP(s); ip == nil || ip.To4() != nil {
+				return fmt.Errorf("must be a valid IPv6 address")
+			}
+			return nil
+		})
+		hostnameStr, _ := NewRule[string]("hostname", func(s string, _ ...string) error {
+			if s == "" || !hostnamePattern.MatchString(s) {
+				return fmt.Errorf("must be a valid hostname")
+			}
+			return nil
+		})
+		cidrStr, _ := NewRule[string]("cidr", func(s string, _ ...string) error {
+			if _, _, err := net.ParseCIDR(s); err != nil {
+				return fmt.Errorf("must be a valid CIDR notation address")
+			}
+			return nil
+		})
+		alphaStr, _ := NewRule[string]("alpha", func(s string, _ ...string) error {
+			if !alphaPattern.MatchString(s) {
+				return fmt.Errorf("must contain only letters")
+			}
+			return nil
+		})
+		alphanumStr, _ := NewRule[string]("alphanum", func(s string, _ ...string) error {
+			if !alphanumPattern.MatchString(s) {
+				return fmt.Errorf("must contain only letters and digits")
+			}
+			return nil
+		})
+		numericStr, _ := NewRule[string]("numeric", func(s string, _ ...string) error {
+			if !numericPattern.MatchString(s) {
+				return fmt.Errorf("must be a numeric string")
+			}
+			return nil
+		})
+		regexpStr, _ := NewRule[string]("regexp", func(s string, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`regexp requires exactly one parameter, e.g. validate:"regexp(^[a-z]+$)"`)
+			}
+			re, err := compiledPattern(params[0])
+			if err != nil {
+				return fmt.Errorf("invalid regexp parameter %q: %v", params[0], err)
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("must match pattern %s", params[0])
+			}
+			return nil
+		})
+		eqStr, _ := NewRule[string]("eq", func(s string, params ...string) error {
+			v, err := parseCompareParam("eq", params, func(s string) (string, error) { return s, nil })
+			if err != nil {
+				return err
+			}
+			if s != v {
+				return fmt.Errorf("must be equal to %q", v)
+			}
+			return nil
+		})
+		neStr, _ := NewRule[string]("ne", func(s string, params ...string) error {
+			v, err := parseCompareParam("ne", params, func(s string) (string, error) { return s, nil })
+			if err != nil {
+				return err
+			}
+			if s == v {
+				return fmt.Errorf("must be different from %q", v)
+			}
+			return nil
+		})
+		gtStr, _ := NewRule[string]("gt", func(s string, params ...string) error {
+			n, err := parseCompareParam("gt", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if len(s) <= n {
+				return fmt.Errorf("length must be > %d", n)
+			}
+			return nil
+		})
+		gteStr, _ := NewRule[string]("gte", func(s string, params ...string) error {
+			n, err := parseCompareParam("gte", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if len(s) < n {
+				return fmt.Errorf("length must be >= %d", n)
+			}
+			return nil
+		})
+		ltStr, _ := NewRule[string]("lt", func(s string, params ...string) error {
+			n, err := parseCompareParam("lt", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if len(s) >= n {
+				return fmt.Errorf("length must be < %d", n)
+			}
+			return nil
+		})
+		lteStr, _ := NewRule[string]("lte", func(s string, params ...string) error {
+			n, err := parseCompareParam("lte", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if len(s) > n {
+				return fmt.Errorf("length must be <= %d", n)
+			}
+			return nil
+		})
+		builtinStringRules = []Rule{
+			nonemptyStr, oneofStr, hexcolorStr, rgbStr, rgbaStr, hslStr, hslaStr,
+			minStr, maxStr, lenStr, rangeStr, regexStr, regexpStr,
+			containsStr, startswithStr, endswithStr,
+			emailStr, urlStr, uuidStr, ipStr, ipv4Str, ipv6Str, hostnameStr, cidrStr,
+			alphaStr, alphanumStr, numericStr,
+			eqStr, neStr, gtStr, gteStr, ltStr, lteStr,
+		}
 
 		// int rules
 		positiveInt, _ := NewRule[int]("positive", func(n int, _ ...string) error {
@@ -73,18 +444,118 @@ func ensureBuiltIns() {
 			if len(params) == 0 {
 				return fmt.Errorf(`oneof requires at least one parameter, e.g. validate:"oneof(1,2,3)"`)
 			}
-			for _, p := range params {
-				v, err := strconv.ParseInt(strings.TrimSpace(p), 10, 0)
-				if err != nil {
-					return fmt.Errorf("invalid oneof parameter %q for int: %v", p, err)
-				}
-				if int(v) == n {
-					return nil
-				}
+			match, err := parsedOneof(n, params, func(s string) (int, error) {
+				v, err := strconv.ParseInt(s, 10, 0)
+				return int(v), err
+			})
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			if !match {
+				return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			}
+			return nil
+		})
+		rangeInt, _ := NewRule[int]("range", func(n int, params ...string) error {
+			lo, hi, err := parseIntRangeParams(params)
+			if err != nil {
+				return err
+			}
+			if n < lo || n > hi {
+				return fmt.Errorf("must be between %d and %d", lo, hi)
+			}
+			return nil
+		})
+		minInt, _ := NewRule[int]("min", func(n int, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`min requires exactly one parameter, e.g. validate:"min(1)"`)
+			}
+			v, err := strconv.ParseInt(strings.TrimSpace(params[0]), 10, 0)
+			if err != nil {
+				return fmt.Errorf("invalid min parameter %q for int: %v", params[0], err)
+			}
+			if int64(n) < v {
+				return fmt.Errorf("must be >= %d", v)
+			}
+			return nil
 		})
-		builtinIntRules = []Rule{positiveInt, nonzeroInt, oneofInt}
+		maxInt, _ := NewRule[int]("max", func(n int, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`max requires exactly one parameter, e.g. validate:"max(10)"`)
+			}
+			v, err := strconv.ParseInt(strings.TrimSpace(params[0]), 10, 0)
+			if err != nil {
+				return fmt.Errorf("invalid max parameter %q for int: %v", params[0], err)
+			}
+			if int64(n) > v {
+				return fmt.Errorf("must be <= %d", v)
+			}
+			return nil
+		})
+		eqInt, _ := NewRule[int]("eq", func(n int, params ...string) error {
+			v, err := parseCompareParam("eq", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n != v {
+				return fmt.Errorf("must be equal to %d", v)
+			}
+			return nil
+		})
+		neInt, _ := NewRule[int]("ne", func(n int, params ...string) error {
+			v, err := parseCompareParam("ne", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n == v {
+				return fmt.Errorf("must be different from %d", v)
+			}
+			return nil
+		})
+		gtInt, _ := NewRule[int]("gt", func(n int, params ...string) error {
+			v, err := parseCompareParam("gt", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n <= v {
+				return fmt.Errorf("must be > %d", v)
+			}
+			return nil
+		})
+		gteInt, _ := NewRule[int]("gte", func(n int, params ...string) error {
+			v, err := parseCompareParam("gte", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n < v {
+				return fmt.Errorf("must be >= %d", v)
+			}
+			return nil
+		})
+		ltInt, _ := NewRule[int]("lt", func(n int, params ...string) error {
+			v, err := parseCompareParam("lt", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n >= v {
+				return fmt.Errorf("must be < %d", v)
+			}
+			return nil
+		})
+		lteInt, _ := NewRule[int]("lte", func(n int, params ...string) error {
+			v, err := parseCompareParam("lte", params, strconv.Atoi)
+			if err != nil {
+				return err
+			}
+			if n > v {
+				return fmt.Errorf("must be <= %d", v)
+			}
+			return nil
+		})
+		builtinIntRules = []Rule{
+			positiveInt, nonzeroInt, oneofInt, minInt, maxInt, rangeInt,
+			eqInt, neInt, gtInt, gteInt, ltInt, lteInt,
+		}
 
 		// int64 rules
 		positiveInt64, _ := NewRule[int64]("positive", func(n int64, _ ...string) error {
@@ -103,18 +574,119 @@ func ensureBuiltIns() {
 			if len(params) == 0 {
 				return fmt.Errorf(`oneof requires at least one parameter, e.g. validate:"oneof(10,20,30)"`)
 			}
-			for _, p := range params {
-				v, err := strconv.ParseInt(strings.TrimSpace(p), 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid oneof parameter %q for int64: %v", p, err)
-				}
-				if v == n {
-					return nil
-				}
+			match, err := parsedOneof(n, params, func(s string) (int64, error) {
+				return strconv.ParseInt(s, 10, 64)
+			})
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			if !match {
+				return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			}
+			return nil
+		})
+		rangeInt64, _ := NewRule[int64]("range", func(n int64, params ...string) error {
+			lo, hi, err := parseRangeParams(params, func(s string) (int64, error) {
+				return strconv.ParseInt(s, 10, 64)
+			})
+			if err != nil {
+				return err
+			}
+			if n < lo || n > hi {
+				return fmt.Errorf("must be between %d and %d", lo, hi)
+			}
+			return nil
 		})
-		builtinInt64Rules = []Rule{positiveInt64, nonzeroInt64, oneofInt64}
+		minInt64, _ := NewRule[int64]("min", func(n int64, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`min requires exactly one parameter, e.g. validate:"min(1)"`)
+			}
+			v, err := strconv.ParseInt(strings.TrimSpace(params[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid min parameter %q for int64: %v", params[0], err)
+			}
+			if n < v {
+				return fmt.Errorf("must be >= %d", v)
+			}
+			return nil
+		})
+		maxInt64, _ := NewRule[int64]("max", func(n int64, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`max requires exactly one parameter, e.g. validate:"max(10)"`)
+			}
+			v, err := strconv.ParseInt(strings.TrimSpace(params[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max parameter %q for int64: %v", params[0], err)
+			}
+			if n > v {
+				return fmt.Errorf("must be <= %d", v)
+			}
+			return nil
+		})
+		eqInt64, _ := NewRule[int64]("eq", func(n int64, params ...string) error {
+			v, err := parseCompareParam("eq", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n != v {
+				return fmt.Errorf("must be equal to %d", v)
+			}
+			return nil
+		})
+		neInt64, _ := NewRule[int64]("ne", func(n int64, params ...string) error {
+			v, err := parseCompareParam("ne", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n == v {
+				return fmt.Errorf("must be different from %d", v)
+			}
+			return nil
+		})
+		gtInt64, _ := NewRule[int64]("gt", func(n int64, params ...string) error {
+			v, err := parseCompareParam("gt", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n <= v {
+				return fmt.Errorf("must be > %d", v)
+			}
+			return nil
+		})
+		gteInt64, _ := NewRule[int64]("gte", func(n int64, params ...string) error {
+			v, err := parseCompareParam("gte", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n < v {
+				return fmt.Errorf("must be >= %d", v)
+			}
+			return nil
+		})
+		ltInt64, _ := NewRule[int64]("lt", func(n int64, params ...string) error {
+			v, err := parseCompareParam("lt", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n >= v {
+				return fmt.Errorf("must be < %d", v)
+			}
+			return nil
+		})
+		lteInt64, _ := NewRule[int64]("lte", func(n int64, params ...string) error {
+			v, err := parseCompareParam("lte", params, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			if n > v {
+				return fmt.Errorf("must be <= %d", v)
+			}
+			return nil
+		})
+		builtinInt64Rules = []Rule{
+			positiveInt64, nonzeroInt64, oneofInt64, minInt64, maxInt64, rangeInt64,
+			eqInt64, neInt64, gtInt64, gteInt64, ltInt64, lteInt64,
+		}
 
 		// float64 rules
 		positiveFloat64, _ := NewRule[float64]("positive", func(n float64, _ ...string) error {
@@ -133,18 +705,119 @@ func ensureBuiltIns() {
 			if len(params) == 0 {
 				return fmt.Errorf(`oneof requires at least one parameter, e.g. validate:"oneof(1.5,2.0)"`)
 			}
-			for _, p := range params {
-				v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
-				if err != nil {
-					return fmt.Errorf("invalid oneof parameter %q for float64: %v", p, err)
-				}
-				if v == n {
-					return nil
-				}
+			match, err := parsedOneof(n, params, func(s string) (float64, error) {
+				return strconv.ParseFloat(s, 64)
+			})
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			if !match {
+				return fmt.Errorf("must be one of: %s", strings.Join(params, ", "))
+			}
+			return nil
+		})
+		rangeFloat64, _ := NewRule[float64]("range", func(n float64, params ...string) error {
+			lo, hi, err := parseRangeParams(params, func(s string) (float64, error) {
+				return strconv.ParseFloat(s, 64)
+			})
+			if err != nil {
+				return err
+			}
+			if n < lo || n > hi {
+				return fmt.Errorf("must be between %v and %v", lo, hi)
+			}
+			return nil
+		})
+		minFloat64, _ := NewRule[float64]("min", func(n float64, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`min requires exactly one parameter, e.g. validate:"min(0.5)"`)
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(params[0]), 64)
+			if err != nil {
+				return fmt.Errorf("invalid min parameter %q for float64: %v", params[0], err)
+			}
+			if n < v {
+				return fmt.Errorf("must be >= %v", v)
+			}
+			return nil
 		})
-		builtinFloat64Rules = []Rule{positiveFloat64, nonzeroFloat64, oneofFloat64}
+		maxFloat64, _ := NewRule[float64]("max", func(n float64, params ...string) error {
+			if len(params) != 1 {
+				return fmt.Errorf(`max requires exactly one parameter, e.g. validate:"max(2.5)"`)
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(params[0]), 64)
+			if err != nil {
+				return fmt.Errorf("invalid max parameter %q for float64: %v", params[0], err)
+			}
+			if n > v {
+				return fmt.Errorf("must be <= %v", v)
+			}
+			return nil
+		})
+		eqFloat64, _ := NewRule[float64]("eq", func(n float64, params ...string) error {
+			v, err := parseCompareParam("eq", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n != v {
+				return fmt.Errorf("must be equal to %v", v)
+			}
+			return nil
+		})
+		neFloat64, _ := NewRule[float64]("ne", func(n float64, params ...string) error {
+			v, err := parseCompareParam("ne", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n == v {
+				return fmt.Errorf("must be different from %v", v)
+			}
+			return nil
+		})
+		gtFloat64, _ := NewRule[float64]("gt", func(n float64, params ...string) error {
+			v, err := parseCompareParam("gt", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n <= v {
+				return fmt.Errorf("must be > %v", v)
+			}
+			return nil
+		})
+		gteFloat64, _ := NewRule[float64]("gte", func(n float64, params ...string) error {
+			v, err := parseCompareParam("gte", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n < v {
+				return fmt.Errorf("must be >= %v", v)
+			}
+			return nil
+		})
+		ltFloat64, _ := NewRule[float64]("lt", func(n float64, params ...string) error {
+			v, err := parseCompareParam("lt", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n >= v {
+				return fmt.Errorf("must be < %v", v)
+			}
+			return nil
+		})
+		lteFloat64, _ := NewRule[float64]("lte", func(n float64, params ...string) error {
+			v, err := parseCompareParam("lte", params, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+			if err != nil {
+				return err
+			}
+			if n > v {
+				return fmt.Errorf("must be <= %v", v)
+			}
+			return nil
+		})
+		builtinFloat64Rules = []Rule{
+			positiveFloat64, nonzeroFloat64, oneofFloat64, minFloat64, maxFloat64, rangeFloat64,
+			eqFloat64, neFloat64, gtFloat64, gteFloat64, ltFloat64, lteFloat64,
+		}
 
 		// fill map
 		register := func(rs []Rule) {
@@ -166,4 +839,18 @@ func lookupBuiltin(name string, t reflect.Type) (Rule, bool) {
 	return r, ok
 }
 
+// isBuiltinRuleName reports whether name is registered as a built-in rule for
+// any field type, regardless of which overload would actually be selected for
+// a given value. Used to keep a built-in rule name from being silently
+// shadowed by a user-registered alias (see checkAliasBuiltinCollision/WithAliasOverride).
+func isBuiltinRuleName(name string) bool {
+	ensureBuiltIns()
+	for k := range builtInMap {
+		if k.name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // (Intentionally no exported Builtin*Rules constructors anymore. Built-ins are always implicitly available.)
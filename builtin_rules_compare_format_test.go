@@ -0,0 +1,207 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinRules_CompareAndFormat(t *testing.T) {
+	type gtIntDoc struct {
+		N int `validate:"gt(5)"`
+	}
+	type gteIntDoc struct {
+		N int `validate:"gte(5)"`
+	}
+	type ltIntDoc struct {
+		N int `validate:"lt(5)"`
+	}
+	type lteIntDoc struct {
+		N int `validate:"lte(5)"`
+	}
+	type eqIntDoc struct {
+		N int `validate:"eq(5)"`
+	}
+	type neIntDoc struct {
+		N int `validate:"ne(5)"`
+	}
+	type eqStrDoc struct {
+		S string `validate:"eq(abc)"`
+	}
+	type neStrDoc struct {
+		S string `validate:"ne(abc)"`
+	}
+	type gtStrDoc struct {
+		S string `validate:"gt(2)"`
+	}
+	type alphaDoc struct {
+		S string `validate:"alpha"`
+	}
+	type alphanumDoc struct {
+		S string `validate:"alphanum"`
+	}
+	type numericDoc struct {
+		S string `validate:"numeric"`
+	}
+	type cidrDoc struct {
+		S string `validate:"cidr"`
+	}
+	type regexpDoc struct {
+		S string `validate:"regexp(^[a-z]+$)"`
+	}
+	type eqFloatDoc struct {
+		F float64 `validate:"eq(1.5)"`
+	}
+	type gtInt64Doc struct {
+		N int64 `validate:"gt(10)"`
+	}
+
+	tests := []struct {
+		name      string
+		build     func() error
+		wantError bool
+		substr    string
+	}{
+		{"gt int passes", func() error {
+			_, err := New(&gtIntDoc{N: 6}, WithValidation[gtIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"gt int fails at boundary", func() error {
+			_, err := New(&gtIntDoc{N: 5}, WithValidation[gtIntDoc](context.Background()))
+			return err
+		}, true, "must be > 5"},
+		{"gte int passes at boundary", func() error {
+			_, err := New(&gteIntDoc{N: 5}, WithValidation[gteIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"gte int fails below", func() error {
+			_, err := New(&gteIntDoc{N: 4}, WithValidation[gteIntDoc](context.Background()))
+			return err
+		}, true, "must be >= 5"},
+		{"lt int passes", func() error {
+			_, err := New(&ltIntDoc{N: 4}, WithValidation[ltIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"lt int fails at boundary", func() error {
+			_, err := New(&ltIntDoc{N: 5}, WithValidation[ltIntDoc](context.Background()))
+			return err
+		}, true, "must be < 5"},
+		{"lte int passes at boundary", func() error {
+			_, err := New(&lteIntDoc{N: 5}, WithValidation[lteIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"lte int fails above", func() error {
+			_, err := New(&lteIntDoc{N: 6}, WithValidation[lteIntDoc](context.Background()))
+			return err
+		}, true, "must be <= 5"},
+		{"eq int passes", func() error {
+			_, err := New(&eqIntDoc{N: 5}, WithValidation[eqIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"eq int fails", func() error {
+			_, err := New(&eqIntDoc{N: 6}, WithValidation[eqIntDoc](context.Background()))
+			return err
+		}, true, "must be equal to 5"},
+		{"ne int passes", func() error {
+			_, err := New(&neIntDoc{N: 6}, WithValidation[neIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"ne int fails", func() error {
+			_, err := New(&neIntDoc{N: 5}, WithValidation[neIntDoc](context.Background()))
+			return err
+		}, true, "must be different from 5"},
+		{"eq string passes", func() error {
+			_, err := New(&eqStrDoc{S: "abc"}, WithValidation[eqStrDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"eq string fails", func() error {
+			_, err := New(&eqStrDoc{S: "xyz"}, WithValidation[eqStrDoc](context.Background()))
+			return err
+		}, true, `must be equal to "abc"`},
+		{"ne string passes", func() error {
+			_, err := New(&neStrDoc{S: "xyz"}, WithValidation[neStrDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"ne string fails", func() error {
+			_, err := New(&neStrDoc{S: "abc"}, WithValidation[neStrDoc](context.Background()))
+			return err
+		}, true, `must be different from "abc"`},
+		{"gt string (length) passes", func() error {
+			_, err := New(&gtStrDoc{S: "abc"}, WithValidation[gtStrDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"gt string (length) fails", func() error {
+			_, err := New(&gtStrDoc{S: "ab"}, WithValidation[gtStrDoc](context.Background()))
+			return err
+		}, true, "length must be > 2"},
+		{"alpha passes", func() error {
+			_, err := New(&alphaDoc{S: "abc"}, WithValidation[alphaDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"alpha fails", func() error {
+			_, err := New(&alphaDoc{S: "abc1"}, WithValidation[alphaDoc](context.Background()))
+			return err
+		}, true, "must contain only letters"},
+		{"alphanum passes", func() error {
+			_, err := New(&alphanumDoc{S: "abc123"}, WithValidation[alphanumDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"alphanum fails", func() error {
+			_, err := New(&alphanumDoc{S: "abc-123"}, WithValidation[alphanumDoc](context.Background()))
+			return err
+		}, true, "must contain only letters and digits"},
+		{"numeric passes", func() error {
+			_, err := New(&numericDoc{S: "-12.5"}, WithValidation[numericDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"numeric fails", func() error {
+			_, err := New(&numericDoc{S: "12a"}, WithValidation[numericDoc](context.Background()))
+			return err
+		}, true, "must be a numeric string"},
+		{"cidr passes", func() error {
+			_, err := New(&cidrDoc{S: "192.0.2.0/24"}, WithValidation[cidrDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"cidr fails", func() error {
+			_, err := New(&cidrDoc{S: "not-a-cidr"}, WithValidation[cidrDoc](context.Background()))
+			return err
+		}, true, "must be a valid CIDR notation address"},
+		{"regexp passes", func() error {
+			_, err := New(&regexpDoc{S: "abc"}, WithValidation[regexpDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"regexp fails", func() error {
+			_, err := New(&regexpDoc{S: "ABC"}, WithValidation[regexpDoc](context.Background()))
+			return err
+		}, true, "must match pattern"},
+		{"eq float64 passes", func() error {
+			_, err := New(&eqFloatDoc{F: 1.5}, WithValidation[eqFloatDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"eq float64 fails", func() error {
+			_, err := New(&eqFloatDoc{F: 2.5}, WithValidation[eqFloatDoc](context.Background()))
+			return err
+		}, true, "must be equal to 1.5"},
+		{"gt int64 passes", func() error {
+			_, err := New(&gtInt64Doc{N: 11}, WithValidation[gtInt64Doc](context.Background()))
+			return err
+		}, false, ""},
+		{"gt int64 fails", func() error {
+			_, err := New(&gtInt64Doc{N: 10}, WithValidation[gtInt64Doc](context.Background()))
+			return err
+		}, true, "must be > 10"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			if (err != nil) != tt.wantError {
+				t.Fatalf("got err=%v, wantError=%v", err, tt.wantError)
+			}
+			if tt.substr != "" && (err == nil || !strings.Contains(err.Error(), tt.substr)) {
+				t.Fatalf("expected error containing %q, got: %v", tt.substr, err)
+			}
+		})
+	}
+}
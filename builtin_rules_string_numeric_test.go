@@ -0,0 +1,244 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinRules_StringAndNumericExtras(t *testing.T) {
+	type lenDoc struct {
+		S string `validate:"len(3)"`
+	}
+	type rangeStrDoc struct {
+		S string `validate:"range(2,4)"`
+	}
+	type regexDoc struct {
+		S string `validate:"regex(^[a-z]+$)"`
+	}
+	type containsDoc struct {
+		S string `validate:"contains(@)"`
+	}
+	type startswithDoc struct {
+		S string `validate:"startswith(https://)"`
+	}
+	type endswithDoc struct {
+		S string `validate:"endswith(.com)"`
+	}
+	type emailDoc struct {
+		S string `validate:"email"`
+	}
+	type urlDoc struct {
+		S string `validate:"url"`
+	}
+	type uuidDoc struct {
+		S string `validate:"uuid"`
+	}
+	type ipDoc struct {
+		S string `validate:"ip"`
+	}
+	type ipv4Doc struct {
+		S string `validate:"ipv4"`
+	}
+	type ipv6Doc struct {
+		S string `validate:"ipv6"`
+	}
+	type hostnameDoc struct {
+		S string `validate:"hostname"`
+	}
+	type rangeIntDoc struct {
+		N int `validate:"range(1,10)"`
+	}
+	type rangeInt64Doc struct {
+		N int64 `validate:"range(1,10)"`
+	}
+	type rangeFloat64Doc struct {
+		N float64 `validate:"range(0.5,1.5)"`
+	}
+
+	tests := []struct {
+		name      string
+		build     func() (err error)
+		wantError bool
+		substr    string
+	}{
+		{"len passes", func() error {
+			_, err := New(&lenDoc{S: "abc"}, WithValidation[lenDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"len fails", func() error {
+			_, err := New(&lenDoc{S: "ab"}, WithValidation[lenDoc](context.Background()))
+			return err
+		}, true, "length must be exactly 3"},
+		{"range string passes", func() error {
+			_, err := New(&rangeStrDoc{S: "abc"}, WithValidation[rangeStrDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"range string fails", func() error {
+			_, err := New(&rangeStrDoc{S: "a"}, WithValidation[rangeStrDoc](context.Background()))
+			return err
+		}, true, "length must be between 2 and 4"},
+		{"regex passes", func() error {
+			_, err := New(&regexDoc{S: "abc"}, WithValidation[regexDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"regex fails", func() error {
+			_, err := New(&regexDoc{S: "ABC"}, WithValidation[regexDoc](context.Background()))
+			return err
+		}, true, "must match pattern"},
+		{"contains passes", func() error {
+			_, err := New(&containsDoc{S: "a@b"}, WithValidation[containsDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"contains fails", func() error {
+			_, err := New(&containsDoc{S: "ab"}, WithValidation[containsDoc](context.Background()))
+			return err
+		}, true, `must contain "@"`},
+		{"startswith passes", func() error {
+			_, err := New(&startswithDoc{S: "https://x"}, WithValidation[startswithDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"startswith fails", func() error {
+			_, err := New(&startswithDoc{S: "http://x"}, WithValidation[startswithDoc](context.Background()))
+			return err
+		}, true, "must start with"},
+		{"endswith passes", func() error {
+			_, err := New(&endswithDoc{S: "site.com"}, WithValidation[endswithDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"endswith fails", func() error {
+			_, err := New(&endswithDoc{S: "site.org"}, WithValidation[endswithDoc](context.Background()))
+			return err
+		}, true, "must end with"},
+		{"email passes", func() error {
+			_, err := New(&emailDoc{S: "a@b.com"}, WithValidation[emailDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"email fails", func() error {
+			_, err := New(&emailDoc{S: "not-an-email"}, WithValidation[emailDoc](context.Background()))
+			return err
+		}, true, "must be a valid email address"},
+		{"url passes", func() error {
+			_, err := New(&urlDoc{S: "https://example.com"}, WithValidation[urlDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"url fails", func() error {
+			_, err := New(&urlDoc{S: "not a url"}, WithValidation[urlDoc](context.Background()))
+			return err
+		}, true, "must be a valid URL"},
+		{"uuid passes", func() error {
+			_, err := New(&uuidDoc{S: "123e4567-e89b-12d3-a456-426614174000"}, WithValidation[uuidDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"uuid fails", func() error {
+			_, err := New(&uuidDoc{S: "not-a-uuid"}, WithValidation[uuidDoc](context.Background()))
+			return err
+		}, true, "must be a valid UUID"},
+		{"ip passes", func() error {
+			_, err := New(&ipDoc{S: "127.0.0.1"}, WithValidation[ipDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"ip fails", func() error {
+			_, err := New(&ipDoc{S: "not-an-ip"}, WithValidation[ipDoc](context.Background()))
+			return err
+		}, true, "must be a valid IP address"},
+		{"ipv4 passes", func() error {
+			_, err := New(&ipv4Doc{S: "127.0.0.1"}, WithValidation[ipv4Doc](context.Background()))
+			return err
+		}, false, ""},
+		{"ipv4 fails on ipv6", func() error {
+			_, err := New(&ipv4Doc{S: "::1"}, WithValidation[ipv4Doc](context.Background()))
+			return err
+		}, true, "must be a valid IPv4 address"},
+		{"ipv6 passes", func() error {
+			_, err := New(&ipv6Doc{S: "::1"}, WithValidation[ipv6Doc](context.Background()))
+			return err
+		}, false, ""},
+		{"ipv6 fails on ipv4", func() error {
+			_, err := New(&ipv6Doc{S: "127.0.0.1"}, WithValidation[ipv6Doc](context.Background()))
+			return err
+		}, true, "must be a valid IPv6 address"},
+		{"hostname passes", func() error {
+			_, err := New(&hostnameDoc{S: "example.com"}, WithValidation[hostnameDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"hostname fails", func() error {
+			_, err := New(&hostnameDoc{S: "not a host"}, WithValidation[hostnameDoc](context.Background()))
+			return err
+		}, true, "must be a valid hostname"},
+		{"range int passes", func() error {
+			_, err := New(&rangeIntDoc{N: 5}, WithValidation[rangeIntDoc](context.Background()))
+			return err
+		}, false, ""},
+		{"range int fails", func() error {
+			_, err := New(&rangeIntDoc{N: 20}, WithValidation[rangeIntDoc](context.Background()))
+			return err
+		}, true, "must be between 1 and 10"},
+		{"range int64 passes", func() error {
+			_, err := New(&rangeInt64Doc{N: 5}, WithValidation[rangeInt64Doc](context.Background()))
+			return err
+		}, false, ""},
+		{"range int64 fails", func() error {
+			_, err := New(&rangeInt64Doc{N: 0}, WithValidation[rangeInt64Doc](context.Background()))
+			return err
+		}, true, "must be between 1 and 10"},
+		{"range float64 passes", func() error {
+			_, err := New(&rangeFloat64Doc{N: 1.0}, WithValidation[rangeFloat64Doc](context.Background()))
+			return err
+		}, false, ""},
+		{"range float64 fails", func() error {
+			_, err := New(&rangeFloat64Doc{N: 2.0}, WithValidation[rangeFloat64Doc](context.Background()))
+			return err
+		}, true, "must be between 0.5 and 1.5"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			if (err != nil) != tt.wantError {
+				t.Fatalf("got err=%v, wantError=%v", err, tt.wantError)
+			}
+			if tt.substr != "" && (err == nil || !strings.Contains(err.Error(), tt.substr)) {
+				t.Fatalf("expected error containing %q, got: %v", tt.substr, err)
+			}
+		})
+	}
+}
+
+// diveContainerLenRangeDoc exercises the container-length dispatch for "len"
+// and "range" placed before "dive", complementing diveContainerDoc's coverage
+// of "min"/"max".
+type diveContainerLenRangeDoc struct {
+	Items []string `validateElem:"range(1,2),dive,nonempty"`
+}
+
+func TestModel_Validate_dive_containerRangeRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		items   []string
+		wantErr bool
+	}{
+		{"within range", []string{"a"}, false},
+		{"below range", nil, true},
+		{"above range", []string{"a", "b", "c"}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&diveContainerLenRangeDoc{Items: tt.items})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// combinedRule is a Rule built by Or/And/Not/When, composing other Rules'
+// validation functions rather than implementing one directly.
+type combinedRule struct {
+	name      string
+	fieldType reflect.Type
+	fn        func(v reflect.Value, params ...string) error
+}
+
+func (r *combinedRule) getName() string              { return r.name }
+func (r *combinedRule) getFieldTypeName() string     { return r.fieldType.String() }
+func (r *combinedRule) getFieldType() reflect.Type   { return r.fieldType }
+func (r *combinedRule) isOfType(t reflect.Type) bool { return t == r.fieldType }
+func (r *combinedRule) isAssignableTo(t reflect.Type) bool {
+	return r.fieldType != nil && r.fieldType.AssignableTo(t)
+}
+func (r *combinedRule) getValidationFn() func(v reflect.Value, params ...string) error {
+	return r.fn
+}
+
+// combine builds a combinedRule named name around fn, inheriting its field
+// type from the first of rs (Or/And/Not/When all require at least one rule
+// of a single, consistent field type, matching how NewRule overloads work).
+func combine(name string, rs []Rule, fn func(v reflect.Value, params ...string) error) Rule {
+	var fieldType reflect.Type
+	if len(rs) > 0 {
+		fieldType = rs[0].getFieldType()
+	}
+	return &combinedRule{name: name, fieldType: fieldType, fn: fn}
+}
+
+// Or builds a Rule named name that passes as soon as any of rs passes. If
+// every alternative fails, the returned error lists each alternative's
+// failure, mirroring the "a|b|c" OR-chain tag syntax but for rules composed
+// in code.
+func Or(name string, rs ...Rule) Rule {
+	return combine(name, rs, func(v reflect.Value, params ...string) error {
+		var failures []string
+		for _, r := range rs {
+			if err := r.getValidationFn()(v, params...); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", r.getName(), err))
+				continue
+			}
+			return nil
+		}
+		return fmt.Errorf("none of %s passed (%s)", name, strings.Join(failures, "; "))
+	})
+}
+
+// And builds a Rule named name that passes only when every rule in rs
+// passes, stopping at (and reporting) the first failure.
+func And(name string, rs ...Rule) Rule {
+	return combine(name, rs, func(v reflect.Value, params ...string) error {
+		for _, r := range rs {
+			if err := r.getValidationFn()(v, params...); err != nil {
+				return fmt.Errorf("%s: %s", r.getName(), err)
+			}
+		}
+		return nil
+	})
+}
+
+// Not builds a Rule named name that passes when r fails, and fails when r
+// passes.
+func Not(name string, r Rule) Rule {
+	return combine(name, []Rule{r}, func(v reflect.Value, params ...string) error {
+		if err := r.getValidationFn()(v, params...); err == nil {
+			return fmt.Errorf("%s: must not satisfy %s", name, r.getName())
+		}
+		return nil
+	})
+}
+
+// When builds a Rule that only runs r when pred(field value) is true,
+// passing unconditionally otherwise. TField must match the field type r was
+// built for (via NewRule[TField]); a mismatched value is treated as not
+// applicable and passes.
+func When[TField any](pred func(TField) bool, r Rule) Rule {
+	return combine(r.getName(), []Rule{r}, func(v reflect.Value, params ...string) error {
+		tv, ok := v.Interface().(TField)
+		if !ok || !pred(tv) {
+			return nil
+		}
+		return r.getValidationFn()(v, params...)
+	})
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func mustStringRule(t *testing.T, name string, fn func(string, ...string) error) Rule {
+	t.Helper()
+	r, err := NewRule[string](name, fn)
+	if err != nil {
+		t.Fatalf("NewRule(%q): %v", name, err)
+	}
+	return r
+}
+
+type combinatorDoc struct {
+	Code string `validate:"codeRule"`
+}
+
+func runCombinatorRule(t *testing.T, r Rule, value string) error {
+	t.Helper()
+	obj := combinatorDoc{Code: value}
+	m, err := New(&obj, WithRules[combinatorDoc](r))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return m.Validate(context.Background())
+}
+
+func TestOr(t *testing.T) {
+	t.Parallel()
+
+	short, err := NewRule[string]("short", func(s string, _ ...string) error {
+		if len(s) > 3 {
+			return fmt.Errorf("too long")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	allCaps, err := NewRule[string]("allCaps", func(s string, _ ...string) error {
+		if s != stringsToUpper(s) {
+			return fmt.Errorf("not all caps")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	combined := Or("codeRule", short, allCaps)
+	if err := runCombinatorRule(t, combined, "ab"); err != nil {
+		t.Fatalf("expected short alt to pass, got %v", err)
+	}
+	if err := runCombinatorRule(t, combined, "VERYLONGBUTALLCAPS"); err != nil {
+		t.Fatalf("expected allCaps alt to pass, got %v", err)
+	}
+	if err := runCombinatorRule(t, combined, "toolongandlowercase"); err == nil {
+		t.Fatalf("expected both alternatives to fail")
+	}
+}
+
+func TestAnd(t *testing.T) {
+	t.Parallel()
+
+	nonempty := mustStringRule(t, "nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("empty")
+		}
+		return nil
+	})
+	short := mustStringRule(t, "short", func(s string, _ ...string) error {
+		if len(s) > 3 {
+			return fmt.Errorf("too long")
+		}
+		return nil
+	})
+
+	combined := And("codeRule", nonempty, short)
+	if err := runCombinatorRule(t, combined, "abc"); err != nil {
+		t.Fatalf("expected both to pass, got %v", err)
+	}
+	if err := runCombinatorRule(t, combined, ""); err == nil {
+		t.Fatalf("expected nonempty to fail")
+	}
+	if err := runCombinatorRule(t, combined, "toolong"); err == nil {
+		t.Fatalf("expected short to fail")
+	}
+}
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+
+	isEmpty := mustStringRule(t, "isEmpty", func(s string, _ ...string) error {
+		if s != "" {
+			return fmt.Errorf("not empty")
+		}
+		return nil
+	})
+
+	combined := Not("codeRule", isEmpty)
+	if err := runCombinatorRule(t, combined, "abc"); err != nil {
+		t.Fatalf("expected non-empty to pass Not(isEmpty), got %v", err)
+	}
+	if err := runCombinatorRule(t, combined, ""); err == nil {
+		t.Fatalf("expected empty to fail Not(isEmpty)")
+	}
+}
+
+func TestWhen(t *testing.T) {
+	t.Parallel()
+
+	short := mustStringRule(t, "codeRule", func(s string, _ ...string) error {
+		if len(s) > 3 {
+			return fmt.Errorf("too long")
+		}
+		return nil
+	})
+
+	conditional := When(func(s string) bool { return s != "" }, short)
+	if err := runCombinatorRule(t, conditional, ""); err != nil {
+		t.Fatalf("expected empty value to skip the rule, got %v", err)
+	}
+	if err := runCombinatorRule(t, conditional, "ab"); err != nil {
+		t.Fatalf("expected short value to pass, got %v", err)
+	}
+	if err := runCombinatorRule(t, conditional, "toolong"); err == nil {
+		t.Fatalf("expected long value to fail")
+	}
+}
+
+func stringsToUpper(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'a' && c <= 'z' {
+			b[i] = c - ('a' - 'A')
+		}
+	}
+	return string(b)
+}
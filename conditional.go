@@ -0,0 +1,358 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ygrebnov/errorc"
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// conditionalRuleNames lists validate tag rule names that are evaluated against
+// sibling fields rather than dispatched through rulesRegistry. Unlike ordinary
+// rules, they need access to more than the tagged field's own value.
+var conditionalRuleNames = map[string]bool{
+	"required_if":          true,
+	"required_unless":      true,
+	"required_with":        true,
+	"required_without":     true,
+	"required_with_all":    true,
+	"required_without_all": true,
+	"excluded_if":          true,
+	"excluded_unless":      true,
+	"eqfield":              true,
+	"nefield":              true,
+	"gtfield":              true,
+	"gtefield":             true,
+	"ltfield":              true,
+	"ltefield":             true,
+}
+
+// isConditionalRule reports whether name denotes a cross-field conditional rule.
+func isConditionalRule(name string) bool {
+	return conditionalRuleNames[name]
+}
+
+// evalConditionalRule evaluates a cross-field conditional rule for field value fv.
+// other fields referenced by r.params are resolved as dotted paths relative to
+// parent (the struct enclosing fv); if not found there, resolution climbs to root.
+// It returns a non-nil error describing the failure when the condition is
+// triggered and fv does not satisfy the resulting zero/non-zero requirement.
+// A required_if/required_unless failure wraps ErrRuleConditionallyRequired,
+// with ErrorFieldOtherFieldPath and ErrorFieldOtherFieldValue naming the
+// sibling field and the value that triggered it; a required_with(_all)/
+// required_without(_all) failure wraps it with ErrorFieldOtherFieldPath only,
+// holding the comma-joined sibling paths.
+// An eqfield/nefield/gtfield/gtefield/ltfield/ltefield failure wraps
+// ErrRuleCrossFieldMismatch instead. Every field path here may be prefixed
+// with "." (e.g. ".StartDate") to resolve against root explicitly instead of
+// climbing from parent; see resolveSiblingField.
+func evalConditionalRule(r ruleNameParams, fv, parent, root reflect.Value) error {
+	switch r.name {
+	case "required_if", "required_unless":
+		other, value, err := requireTwoParams(r)
+		if err != nil {
+			return err
+		}
+		ov, ok := resolveSiblingField(other, parent, root)
+		if !ok {
+			return errorc.With(
+				modelerrors.ErrRuleFieldRefNotFound,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+			)
+		}
+		matches := formatFieldValue(ov) == value
+		triggered := matches == (r.name == "required_if")
+		if triggered && isZeroValue(fv) {
+			return errorc.With(
+				modelerrors.ErrRuleConditionallyRequired,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+				errorc.String(modelerrors.ErrorFieldOtherFieldValue, value),
+			)
+		}
+		return nil
+
+	case "excluded_if", "excluded_unless":
+		other, value, err := requireTwoParams(r)
+		if err != nil {
+			return err
+		}
+		ov, ok := resolveSiblingField(other, parent, root)
+		if !ok {
+			return errorc.With(
+				modelerrors.ErrRuleFieldRefNotFound,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+			)
+		}
+		matches := formatFieldValue(ov) == value
+		triggered := matches == (r.name == "excluded_if")
+		if triggered && !isZeroValue(fv) {
+			return errorc.With(
+				modelerrors.ErrRuleConditionallyRequired,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+				errorc.String(modelerrors.ErrorFieldOtherFieldValue, value),
+			)
+		}
+		return nil
+
+	case "required_with", "required_without", "required_with_all", "required_without_all":
+		if len(r.params) == 0 {
+			return fmt.Errorf("%s requires at least one field parameter", r.name)
+		}
+		present := 0
+		for _, other := range r.params {
+			if ov, ok := resolveSiblingField(other, parent, root); ok && !isZeroValue(ov) {
+				present++
+			}
+		}
+		var triggered bool
+		switch r.name {
+		case "required_with":
+			triggered = present > 0
+		case "required_with_all":
+			triggered = present == len(r.params)
+		case "required_without":
+			triggered = present < len(r.params)
+		case "required_without_all":
+			triggered = present == 0
+		}
+		if triggered && isZeroValue(fv) {
+			return errorc.With(
+				modelerrors.ErrRuleConditionallyRequired,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, strings.Join(r.params, ", ")),
+			)
+		}
+		return nil
+
+	case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+		if len(r.params) < 1 {
+			return fmt.Errorf("%s requires a field parameter", r.name)
+		}
+		other := r.params[0]
+		ov, ok := resolveSiblingField(other, parent, root)
+		if !ok {
+			return errorc.With(
+				modelerrors.ErrRuleFieldRefNotFound,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+			)
+		}
+		cmp, comparable := compareFieldValues(fv, ov)
+		if !comparable {
+			return fmt.Errorf("%s: %s is not comparable to %s", r.name, fv.Type(), other)
+		}
+		var ok2 bool
+		switch r.name {
+		case "eqfield":
+			ok2 = cmp == 0
+		case "nefield":
+			ok2 = cmp != 0
+		case "gtfield":
+			ok2 = cmp > 0
+		case "gtefield":
+			ok2 = cmp >= 0
+		case "ltfield":
+			ok2 = cmp < 0
+		case "ltefield":
+			ok2 = cmp <= 0
+		}
+		if !ok2 {
+			return errorc.With(
+				modelerrors.ErrRuleCrossFieldMismatch,
+				errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, other),
+				errorc.String(modelerrors.ErrorFieldOtherFieldValue, formatFieldValue(ov)),
+			)
+		}
+		return nil
+	}
+	return nil
+}
+
+// fieldCompareVerb renders the English comparison verb used in a failed
+// eqfield/nefield/gtfield/... error message.
+func fieldCompareVerb(rule string) string {
+	switch rule {
+	case "eqfield":
+		return "equal to"
+	case "nefield":
+		return "different from"
+	case "gtfield":
+		return "greater than"
+	case "gtefield":
+		return "greater than or equal to"
+	case "ltfield":
+		return "less than"
+	default: // ltefield
+		return "less than or equal to"
+	}
+}
+
+// compareFieldValues compares a and b, unwrapping pointers first. It supports
+// strings, all signed/unsigned integer and float kinds, and time.Time.
+// comparable is false when the two values cannot be meaningfully ordered.
+func compareFieldValues(a, b reflect.Value) (cmp int, comparable bool) {
+	a, b = derefValue(a), derefValue(b)
+	if t1, ok := a.Interface().(time.Time); ok {
+		if t2, ok2 := b.Interface().(time.Time); ok2 {
+			switch {
+			case t1.Before(t2):
+				return -1, true
+			case t1.After(t2):
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+		return 0, false
+	}
+	switch a.Kind() {
+	case reflect.String:
+		if b.Kind() != reflect.String {
+			return 0, false
+		}
+		return strings.Compare(a.String(), b.String()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bv, ok := asInt64(b)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(a.Int(), bv), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bv, ok := asUint64(b)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(a.Uint(), bv), true
+	case reflect.Float32, reflect.Float64:
+		bv, ok := asFloat64(b)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(a.Float(), bv), true
+	default:
+		return 0, false
+	}
+}
+
+func derefValue(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		return v.Elem()
+	}
+	return v
+}
+
+func asInt64(v reflect.Value) (int64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	default:
+		return 0, false
+	}
+}
+
+func asUint64(v reflect.Value) (uint64, bool) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), true
+	default:
+		return 0, false
+	}
+}
+
+func asFloat64(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func requireTwoParams(r ruleNameParams) (field, value string, err error) {
+	if len(r.params) < 2 {
+		return "", "", fmt.Errorf("%s requires (field,value) parameters", r.name)
+	}
+	return r.params[0], r.params[1], nil
+}
+
+// resolveSiblingField resolves a dotted field path against parent, climbing to
+// root when the path cannot be resolved directly on parent. A path prefixed
+// with "." (e.g. ".StartDate") is resolved against root explicitly, which
+// disambiguates the rare case where parent and root both have a field with
+// the same name.
+func resolveSiblingField(path string, parent, root reflect.Value) (reflect.Value, bool) {
+	if strings.HasPrefix(path, ".") {
+		if !root.IsValid() {
+			return reflect.Value{}, false
+		}
+		return lookupDottedField(root, strings.TrimPrefix(path, "."))
+	}
+	if v, ok := lookupDottedField(parent, path); ok {
+		return v, true
+	}
+	if root.IsValid() {
+		return lookupDottedField(root, path)
+	}
+	return reflect.Value{}, false
+}
+
+// lookupDottedField walks v.Field-by-field along the dot-separated segments of
+// path. A segment naming an unexported field is treated as not found, rather
+// than returning a Value that would panic on Interface() later.
+func lookupDottedField(v reflect.Value, path string) (reflect.Value, bool) {
+	cur := v
+	for _, seg := range strings.Split(path, ".") {
+		if cur.Kind() == reflect.Ptr {
+			if cur.IsNil() {
+				return reflect.Value{}, false
+			}
+			cur = cur.Elem()
+		}
+		if cur.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		cur = cur.FieldByName(seg)
+		if !cur.IsValid() || !cur.CanInterface() {
+			return reflect.Value{}, false
+		}
+	}
+	return cur, true
+}
+
+// formatFieldValue renders v as a string for comparison against a validate tag literal.
+func formatFieldValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+// isZeroValue reports whether v holds its type's zero value.
+func isZeroValue(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	return v.IsZero()
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type requiredWithAllForm struct {
+	A        string
+	B        string
+	Combined string `validate:"required_with_all(A,B)"`
+}
+
+func TestModel_Validate_requiredWithAll(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&requiredWithAllForm{A: "x", B: "y", Combined: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected Combined to be required when both A and B are set")
+	}
+
+	m2, err := New(&requiredWithAllForm{A: "x", Combined: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error when only A is set: %v", err)
+	}
+}
+
+type requiredWithoutAllForm struct {
+	A        string
+	B        string
+	Fallback string `validate:"required_without_all(A,B)"`
+}
+
+func TestModel_Validate_requiredWithoutAll(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&requiredWithoutAllForm{Fallback: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected Fallback to be required when neither A nor B is set")
+	}
+
+	m2, err := New(&requiredWithoutAllForm{A: "x", Fallback: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error once A is set: %v", err)
+	}
+}
+
+type requiredWithoutForm struct {
+	A string
+	B string
+	F string `validate:"required_without(A,B)"`
+}
+
+func TestModel_Validate_requiredWithout(t *testing.T) {
+	t.Parallel()
+
+	// Only one of A/B is empty: required_without (unlike required_without_all)
+	// triggers on ANY missing field, so F is required here.
+	m, err := New(&requiredWithoutForm{A: "x", B: "", F: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected F to be required when B is empty, even though A is set")
+	}
+
+	m2, err := New(&requiredWithoutForm{A: "x", B: "y", F: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error when both A and B are set: %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ygrebnov/errorc"
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+type condAddress struct {
+	Country string
+	ZIP     string `validate:"required_if(Country,US)"`
+}
+
+type condPayment struct {
+	Method string
+	Card   string `validate:"required_if(Method,card)"`
+	Cash   string `validate:"excluded_unless(Method,cash)"`
+}
+
+func TestModel_Validate_conditionalRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		obj     any
+		wantErr bool
+	}{
+		{"required_if triggered and satisfied", &condAddress{Country: "US", ZIP: "10001"}, false},
+		{"required_if triggered and missing", &condAddress{Country: "US"}, true},
+		{"required_if not triggered", &condAddress{Country: "FR"}, false},
+		{"excluded_unless satisfied", &condPayment{Method: "cash", Cash: "yes"}, false},
+		{"excluded_unless violated", &condPayment{Method: "card", Card: "4242", Cash: "yes"}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var m *Model[condAddress]
+			var mp *Model[condPayment]
+			var err error
+			switch o := tt.obj.(type) {
+			case *condAddress:
+				m, err = New(o)
+				if err == nil {
+					err = m.Validate(context.Background())
+				}
+			case *condPayment:
+				mp, err = New(o)
+				if err == nil {
+					err = mp.Validate(context.Background())
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModel_Validate_conditionalRules_ErrRuleConditionallyRequired(t *testing.T) {
+	t.Parallel()
+
+	obj := &condAddress{Country: "US"}
+	m, err := New(obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *ValidationError", err)
+	}
+	fes := ve.ForField("ZIP")
+	if len(fes) != 1 {
+		t.Fatalf("expected 1 issue on ZIP, got %d", len(fes))
+	}
+	assertRuleErrorHas(t, fes[0].Err, modelerrors.ErrRuleConditionallyRequired, "required_if", map[errorc.Key]string{
+		modelerrors.ErrorFieldOtherFieldPath:  "Country",
+		modelerrors.ErrorFieldOtherFieldValue: "US",
+	})
+}
+
+type condDateRange struct {
+	StartDate string
+	EndDate   string `validate:"gtefield(StartDate)"`
+}
+
+type condNested struct {
+	StartDate string
+	Period    condPeriod
+}
+
+type condPeriod struct {
+	EndDate string `validate:"gtefield(.StartDate)"`
+}
+
+func TestModel_Validate_crossFieldRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		obj     any
+		wantErr bool
+	}{
+		{"gtefield sibling satisfied", &condDateRange{StartDate: "2024-01-01", EndDate: "2024-01-02"}, false},
+		{"gtefield sibling violated", &condDateRange{StartDate: "2024-01-02", EndDate: "2024-01-01"}, true},
+		{"gtefield root-prefixed satisfied", &condNested{StartDate: "2024-01-01", Period: condPeriod{EndDate: "2024-01-02"}}, false},
+		{"gtefield root-prefixed violated", &condNested{StartDate: "2024-01-02", Period: condPeriod{EndDate: "2024-01-01"}}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var err error
+			switch o := tt.obj.(type) {
+			case *condDateRange:
+				var m *Model[condDateRange]
+				m, err = New(o)
+				if err == nil {
+					err = m.Validate(context.Background())
+				}
+			case *condNested:
+				var m *Model[condNested]
+				m, err = New(o)
+				if err == nil {
+					err = m.Validate(context.Background())
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModel_Validate_crossFieldRules_ErrRuleCrossFieldMismatch(t *testing.T) {
+	t.Parallel()
+
+	obj := &condDateRange{StartDate: "2024-01-02", EndDate: "2024-01-01"}
+	m, err := New(obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *ValidationError", err)
+	}
+	fes := ve.ForField("EndDate")
+	if len(fes) != 1 {
+		t.Fatalf("expected 1 issue on EndDate, got %d", len(fes))
+	}
+	assertRuleErrorHas(t, fes[0].Err, modelerrors.ErrRuleCrossFieldMismatch, "gtefield", map[errorc.Key]string{
+		modelerrors.ErrorFieldOtherFieldPath: "StartDate",
+	})
+}
+
+type condUnexportedRef struct {
+	secret string
+	Value  string `validate:"eqfield(secret)"`
+}
+
+func TestModel_Validate_crossFieldRules_unexportedRef(t *testing.T) {
+	t.Parallel()
+
+	obj := &condUnexportedRef{secret: "x", Value: "x"}
+	_, err := New(obj, WithValidation[condUnexportedRef](context.Background()))
+	if err == nil {
+		t.Fatalf("expected error referencing unexported field, got nil")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *ValidationError", err)
+	}
+	fes := ve.ForField("Value")
+	if len(fes) != 1 {
+		t.Fatalf("expected 1 issue on Value, got %d", len(fes))
+	}
+	assertRuleErrorHas(t, fes[0].Err, modelerrors.ErrRuleFieldRefNotFound, "eqfield", map[errorc.Key]string{
+		modelerrors.ErrorFieldOtherFieldPath: "secret",
+	})
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// Sentinel errors for converter registration/lookup misuse.
+var (
+	errConverterDuplicate = errors.New("model: duplicate converter for type")
+	errConverterAmbiguous = errors.New("model: ambiguous converter for type")
+)
+
+// converterFunc parses a default-literal string into a value of some
+// registered target type, returned as interface{} for type-erased storage.
+type converterFunc func(s string) (interface{}, error)
+
+type converterEntry struct {
+	targetType reflect.Type
+	fn         converterFunc
+}
+
+// converterRegistry holds user-registered literal-default converters, keyed by
+// target reflect.Type with AssignableTo fallback, mirroring rulesRegistry.get.
+type converterRegistry struct {
+	mu      sync.RWMutex
+	entries []converterEntry
+}
+
+func newConverterRegistry() *converterRegistry {
+	return &converterRegistry{}
+}
+
+func (cr *converterRegistry) add(e converterEntry) error {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	for _, existing := range cr.entries {
+		if existing.targetType == e.targetType {
+			return errConverterDuplicate
+		}
+	}
+	cr.entries = append(cr.entries, e)
+	return nil
+}
+
+// get returns the best-matching converter for t: an exact target-type match if
+// present, otherwise the first converter whose target type t is assignable
+// to. It reports ok=false (no error) when nothing matches.
+func (cr *converterRegistry) get(t reflect.Type) (fn converterFunc, ok bool, err error) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	var exacts, assigns []converterEntry
+	for _, e := range cr.entries {
+		switch {
+		case e.targetType == t:
+			exacts = append(exacts, e)
+		case t.AssignableTo(e.targetType):
+			assigns = append(assigns, e)
+		}
+	}
+	switch {
+	case len(exacts) == 1:
+		return exacts[0].fn, true, nil
+	case len(exacts) > 1:
+		return nil, false, errConverterAmbiguous
+	case len(assigns) >= 1:
+		return assigns[0].fn, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// RegisterConverter registers fn as the literal-default converter for type T
+// on m, consulted by setLiteralDefault before falling back to the built-in
+// kind switch. This lets callers plug in parsers for domain types (net.IP,
+// uuid.UUID, big.Int, ...) without the module knowing about each one.
+func RegisterConverter[TObject any, T any](m *Model[TObject], fn func(string) (T, error)) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	return m.binding.converters().add(converterEntry{
+		targetType: t,
+		fn: func(s string) (interface{}, error) {
+			return fn(s)
+		},
+	})
+}
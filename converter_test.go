@@ -0,0 +1,34 @@
+package model
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+type ipDoc struct {
+	Addr net.IP `default:"127.0.0.1"`
+}
+
+func TestRegisterConverter(t *testing.T) {
+	t.Parallel()
+
+	d := &ipDoc{}
+	m, err := New(d)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterConverter(m, func(s string) (net.IP, error) {
+		return net.ParseIP(s), nil
+	}); err != nil {
+		t.Fatalf("RegisterConverter: %v", err)
+	}
+
+	fv := reflect.ValueOf(d).Elem().Field(0)
+	if err := setLiteralDefault(fv, "127.0.0.1", m.binding.converters()); err != nil {
+		t.Fatalf("setLiteralDefault: %v", err)
+	}
+	if !d.Addr.Equal(net.ParseIP("127.0.0.1")) {
+		t.Fatalf("got Addr=%v, want 127.0.0.1", d.Addr)
+	}
+}
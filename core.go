@@ -1,12 +1,165 @@
 package model
 
-import "reflect"
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// structRuleFunc is a type-erased struct-level validation hook. v holds the
+// reflect.Value of the struct instance (of the type it was registered for),
+// and path is its dotted location within the document being validated (""
+// at the root), so hooks can build FieldErrors relative to where the struct
+// actually occurs, including nested/embedded occurrences.
+type structRuleFunc func(ctx context.Context, v reflect.Value, path string) []FieldError
 
 type typeBinding struct {
 	// typ is the underlying struct type this binding was built for.
 	typ           reflect.Type
 	rulesRegistry rulesRegistry
 	rulesMapping  rulesMapping
+
+	structRulesMu sync.RWMutex
+	structRules   map[reflect.Type][]structRuleFunc
+
+	// tagName overrides the default "validate" struct tag name when non-empty.
+	// It is set once, before validation starts, via WithTagName/WithTagNames.
+	tagName string
+	// elemTagName overrides the default "validateElem" struct tag name when
+	// non-empty. Set via WithTagNames.
+	elemTagName string
+	// defaultTagName overrides the default "default" struct tag name when
+	// non-empty. Set via WithTagNames.
+	defaultTagName string
+	// aliases maps an alias token to the rule-list it expands to, applied once
+	// at binding build time when parsing a field's validate tag.
+	aliases map[string]string
+	// fieldNameTag, when non-empty, is the struct tag (e.g. "json") whose value
+	// is used as a field's name in dotted error paths, in place of the Go field
+	// name. Set via WithFieldNameTag.
+	fieldNameTag string
+	// defaultFuncs maps a name to the function consulted for a
+	// `default:"func:name"` tag. Set via WithDefaultFuncs.
+	defaultFuncs map[string]DefaultFunc
+	// defaultProviders maps a name to the provider consulted for a
+	// `default:"name:arg"` tag whose name isn't a built-in prefix. Set via
+	// RegisterDefaultProvider.
+	defaultProviders map[string]DefaultProvider
+	// emptyFunc overrides the default "is this field empty" predicate used by
+	// the "omitempty" validate tag token, if set. Set via WithEmptyFunc.
+	emptyFunc EmptyFunc
+	// validationMode selects ModeCollectAll (default) or ModeFailFast, set
+	// via WithValidationMode.
+	validationMode ValidationMode
+
+	convertersOnce sync.Once
+	convertersReg  *converterRegistry
+
+	crossFieldOnce sync.Once
+	crossFieldReg  *crossFieldRules
+
+	ctxRulesOnce sync.Once
+	ctxRulesReg  *ctxRules
+
+	fieldLevelOnce sync.Once
+	fieldLevelReg  *fieldLevelRules
+
+	namedStructOnce sync.Once
+	namedStructReg  *namedStructRules
+
+	// ruleResolveCache memoizes rulesRegistry.get(name, type) results, since
+	// the same (rule name, field type) pair is resolved on every Validate
+	// call for a given field. ruleResolveVersion records the rulesRegistry
+	// version the cache was built against; when rulesRegistry.version() has
+	// advanced (a rule was registered since), the whole cache is discarded.
+	ruleResolveMu      sync.RWMutex
+	ruleResolveCache   map[ruleResolveKey]ruleResolveEntry
+	ruleResolveVersion uint64
+
+	customTypesMu sync.RWMutex
+	// customTypes maps a field's reflect.Type to a function that extracts the
+	// underlying comparable value to run validate rules against, for opaque or
+	// third-party types such as sql.NullString or uuid.UUID. Registered via
+	// Model.RegisterCustomTypeFunc / WithCustomTypes.
+	customTypes map[reflect.Type]CustomTypeFunc
+}
+
+// CustomTypeFunc extracts the underlying value of v that validate rules
+// should run against, or nil if v should be treated as zero/absent.
+type CustomTypeFunc func(v reflect.Value) interface{}
+
+// registerCustomType registers fn to run for every type in types.
+func (tb *typeBinding) registerCustomType(fn CustomTypeFunc, types ...reflect.Type) {
+	tb.customTypesMu.Lock()
+	defer tb.customTypesMu.Unlock()
+	if tb.customTypes == nil {
+		tb.customTypes = make(map[reflect.Type]CustomTypeFunc)
+	}
+	for _, t := range types {
+		tb.customTypes[t] = fn
+	}
+}
+
+// customTypeFor returns the CustomTypeFunc registered for t, if any.
+func (tb *typeBinding) customTypeFor(t reflect.Type) (CustomTypeFunc, bool) {
+	tb.customTypesMu.RLock()
+	defer tb.customTypesMu.RUnlock()
+	fn, ok := tb.customTypes[t]
+	return fn, ok
+}
+
+// resolveValidationValue returns the reflect.Value that validate rules for
+// field fv should run against: fv itself, unless fv's type has a registered
+// CustomTypeFunc, in which case the extracted value is used (a nil extraction
+// is treated as an empty string, i.e. zero/absent, for rule purposes). As a
+// fallback when no CustomTypeFunc is registered, fv is unwrapped via
+// unwrapKnownWrapper (database/sql/driver.Valuer, encoding.TextMarshaler) so
+// rules run against the wrapped value rather than the wrapper struct; see
+// isEmptyValue for how such fields are skipped entirely via "omitempty" when
+// absent.
+func (tb *typeBinding) resolveValidationValue(fv reflect.Value) reflect.Value {
+	fn, ok := tb.customTypeFor(fv.Type())
+	if ok {
+		extracted := fn(fv)
+		if extracted == nil {
+			return reflect.Zero(stringType)
+		}
+		return reflect.ValueOf(extracted)
+	}
+	if v, isAbsent, ok := unwrapKnownWrapper(fv); ok {
+		if isAbsent {
+			return reflect.Zero(stringType)
+		}
+		return v
+	}
+	return fv
+}
+
+// effectiveTagName returns the struct tag name tb reads field rules from,
+// defaulting to "validate" when no custom name was configured.
+func (tb *typeBinding) effectiveTagName() string {
+	if tb.tagName != "" {
+		return tb.tagName
+	}
+	return tagValidate
+}
+
+// effectiveElemTagName returns the struct tag name tb reads per-element
+// validation rules from, defaulting to "validateElem".
+func (tb *typeBinding) effectiveElemTagName() string {
+	if tb.elemTagName != "" {
+		return tb.elemTagName
+	}
+	return tagValidateElem
+}
+
+// effectiveDefaultTagName returns the struct tag name tb reads default
+// literals from, defaulting to "default".
+func (tb *typeBinding) effectiveDefaultTagName() string {
+	if tb.defaultTagName != "" {
+		return tb.defaultTagName
+	}
+	return tagDefault
 }
 
 // buildTypeBinding creates a typeBinding for the given struct type using the
@@ -18,5 +171,111 @@ func buildTypeBinding(typ reflect.Type, reg rulesRegistry, mapping rulesMapping)
 		typ:           typ,
 		rulesRegistry: reg,
 		rulesMapping:  mapping,
+		structRules:   make(map[reflect.Type][]structRuleFunc),
 	}, nil
 }
+
+// addStructRule registers a struct-level validation hook for typ. Hooks run
+// after all tagged fields of a matching struct value have been validated.
+func (tb *typeBinding) addStructRule(typ reflect.Type, fn structRuleFunc) {
+	tb.structRulesMu.Lock()
+	defer tb.structRulesMu.Unlock()
+	tb.structRules[typ] = append(tb.structRules[typ], fn)
+}
+
+// structRulesFor returns the struct-level hooks registered for typ, if any.
+func (tb *typeBinding) structRulesFor(typ reflect.Type) []structRuleFunc {
+	tb.structRulesMu.RLock()
+	defer tb.structRulesMu.RUnlock()
+	return tb.structRules[typ]
+}
+
+// converters returns tb's lazily-initialized literal-default converter registry.
+func (tb *typeBinding) converters() *converterRegistry {
+	tb.convertersOnce.Do(func() { tb.convertersReg = newConverterRegistry() })
+	return tb.convertersReg
+}
+
+// crossFieldRules returns tb's lazily-initialized named cross-field rule set.
+func (tb *typeBinding) crossFieldRules() *crossFieldRules {
+	tb.crossFieldOnce.Do(func() { tb.crossFieldReg = newCrossFieldRules() })
+	return tb.crossFieldReg
+}
+
+// ctxRules returns tb's lazily-initialized named context-aware rule set.
+func (tb *typeBinding) ctxRules() *ctxRules {
+	tb.ctxRulesOnce.Do(func() { tb.ctxRulesReg = newCtxRules() })
+	return tb.ctxRulesReg
+}
+
+// fieldLevelRules returns tb's lazily-initialized named field-level rule set.
+func (tb *typeBinding) fieldLevelRules() *fieldLevelRules {
+	tb.fieldLevelOnce.Do(func() { tb.fieldLevelReg = newFieldLevelRules() })
+	return tb.fieldLevelReg
+}
+
+// namedStructRules returns tb's lazily-initialized named struct-level rule set.
+func (tb *typeBinding) namedStructRules() *namedStructRules {
+	tb.namedStructOnce.Do(func() { tb.namedStructReg = newNamedStructRules() })
+	return tb.namedStructReg
+}
+
+// ruleResolveKey identifies one (rule name, field type) resolution memoized
+// in tb.ruleResolveCache; the same pair is looked up on every Validate call
+// for a given field, so caching it avoids re-running rulesRegistry.get's
+// exact/assignable/built-in selection logic every time.
+type ruleResolveKey struct {
+	name string
+	typ  reflect.Type
+}
+
+// applyRule fetches the named rule from tb.rulesRegistry and applies it to v,
+// passing any additional string parameters. The (name, v.Type()) -> Rule
+// resolution is memoized in tb.ruleResolveCache and invalidated automatically
+// whenever tb.rulesRegistry.version() advances (i.e. a new rule was added).
+func (tb *typeBinding) applyRule(name string, v reflect.Value, params ...string) error {
+	r, err := tb.resolvedRule(name, v)
+	if err != nil {
+		return err
+	}
+	return r.getValidationFn()(v, params...)
+}
+
+// resolvedRule returns the memoized resolution for (name, v.Type()), falling
+// back to tb.rulesRegistry.get and caching the result (success or failure)
+// when absent or stale.
+func (tb *typeBinding) resolvedRule(name string, v reflect.Value) (Rule, error) {
+	if !v.IsValid() {
+		return tb.rulesRegistry.get(name, v)
+	}
+	key := ruleResolveKey{name: name, typ: v.Type()}
+	currentVersion := tb.rulesRegistry.version()
+
+	tb.ruleResolveMu.RLock()
+	stale := tb.ruleResolveVersion != currentVersion
+	entry, ok := tb.ruleResolveCache[key]
+	tb.ruleResolveMu.RUnlock()
+	if ok && !stale {
+		return entry.rule, entry.err
+	}
+
+	r, err := tb.rulesRegistry.get(name, v)
+
+	tb.ruleResolveMu.Lock()
+	if tb.ruleResolveCache == nil || tb.ruleResolveVersion != currentVersion {
+		tb.ruleResolveCache = make(map[ruleResolveKey]ruleResolveEntry)
+		tb.ruleResolveVersion = currentVersion
+	}
+	tb.ruleResolveCache[key] = ruleResolveEntry{rule: r, err: err}
+	tb.ruleResolveMu.Unlock()
+
+	return r, err
+}
+
+// ruleResolveEntry is the cached outcome (success or failure) of resolving
+// one ruleResolveKey, so a not-found/ambiguous error is memoized too instead
+// of re-querying the registry every call.
+type ruleResolveEntry struct {
+	rule Rule
+	err  error
+}
@@ -10,76 +10,220 @@ import (
 // Nested structs and pointers to structs are traversed recursively. The `path` argument tracks the
 // dotted field path for clearer error messages.
 func (tb *typeBinding) validateStruct(ctx context.Context, rv reflect.Value, path string, ve *ValidationError) error {
+	return tb.validateStructOpts(ctx, rv, rv, path, ve, true, true)
+}
+
+// validateStructWithRoot is like validateStruct but additionally threads the root struct
+// value through the recursion, so conditional rules (e.g. required_if) can resolve a
+// sibling field that lives outside the current struct by climbing back up to root.
+func (tb *typeBinding) validateStructWithRoot(ctx context.Context, rv, root reflect.Value, path string, ve *ValidationError) error {
+	return tb.validateStructOpts(ctx, rv, root, path, ve, true, true)
+}
+
+// validateStructOpts is validateStructWithRoot with two additional controls,
+// set per-occurrence by a parent field's "structonly"/"nostructlevel" tag
+// tokens (see tagStructOnly / tagNoStructLevel): runFields gates whether rv's
+// own tagged fields are validated, and runStructLevel gates whether struct-level
+// rules registered for rv's type run for this occurrence.
+func (tb *typeBinding) validateStructOpts(
+	ctx context.Context,
+	rv, root reflect.Value,
+	path string,
+	ve *ValidationError,
+	runFields, runStructLevel bool,
+) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	typ := rv.Type()
-	for i := 0; i < rv.NumField(); i++ {
-		if err := ctx.Err(); err != nil {
-			return err
-		}
-		field := typ.Field(i)
-		if field.PkgPath != "" { // Skip unexported fields
-			continue
-		}
-		fv := rv.Field(i)
-
-		fpath := field.Name
-		if path != "" {
-			fpath = path + "." + field.Name
-		}
-
-		// Recurse into pointers to structs
-		if fv.Kind() == reflect.Ptr && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct {
-			if err := tb.validateStruct(ctx, fv.Elem(), fpath, ve); err != nil {
+	if runFields {
+		plan := structPlanFor(typ, tb.fieldNameTag)
+		for _, fp := range plan.fields {
+			if err := ctx.Err(); err != nil {
 				return err
 			}
-		}
+			i := fp.index
+			field := typ.Field(i)
+			fv := rv.Field(i)
 
-		// Recurse into embedded/inline structs
-		if fv.Kind() == reflect.Struct {
-			if err := tb.validateStruct(ctx, fv, fpath, ve); err != nil {
-				return err
+			fpath := fp.name
+			if path != "" {
+				fpath = path + "." + fp.name
 			}
-		}
 
-		// Process `validate` tag
-		if rawTag := field.Tag.Get(tagValidate); rawTag != "" && rawTag != "-" {
-			rules, exists := tb.rulesMapping.get(typ, i, tagValidate)
-			if !exists {
-				rules = parseTag(rawTag)
-				tb.rulesMapping.add(typ, i, tagValidate, rules)
+			// Process the validation tag (the configured name, or "validate" by default).
+			tagName := tb.effectiveTagName()
+			rawTag := field.Tag.Get(tagName)
+			var rules []ruleNameParams
+			if rawTag != "" && rawTag != "-" {
+				var exists bool
+				rules, exists = tb.rulesMapping.get(typ, i, tagName)
+				if !exists {
+					rules = expandAliases(parseTag(rawTag), tb.aliases)
+					tb.rulesMapping.add(typ, i, tagName, rules)
+				}
 			}
 
+			childRunFields, childRunStructLevel := true, true
 			for _, r := range rules {
-				if err := ctx.Err(); err != nil {
+				switch r.name {
+				case tagStructOnly:
+					childRunFields = false
+				case tagNoStructLevel:
+					childRunStructLevel = false
+				}
+			}
+
+			// Recurse into pointers to structs
+			if fp.recursePtr && !fv.IsNil() && ve.pathFilter.allowsSubtree(fpath) {
+				if err := tb.validateStructOpts(ctx, fv.Elem(), root, fpath, ve, childRunFields, childRunStructLevel); err != nil {
+					return err
+				}
+			}
+
+			// Recurse into embedded/inline structs
+			if fp.recurseEmbed && ve.pathFilter.allowsSubtree(fpath) {
+				if err := tb.validateStructOpts(ctx, fv, root, fpath, ve, childRunFields, childRunStructLevel); err != nil {
 					return err
 				}
-				if err := tb.applyRule(r.name, fv, r.params...); err != nil {
-					ve.Add(FieldError{Path: fpath, Rule: r.name, Params: r.params, Err: err})
+			}
+
+			// Apply the `validate` tag's rules, unless excluded by fields/pathFilter.
+			// This is an `if`, not an early `continue`, so a field carrying only a
+			// `validateElem` tag (no `validate` tag) still reaches that block below.
+			if rawTag != "" && rawTag != "-" && ve.pathFilter.allowsField(fpath) {
+				rules = tb.applyOmitempty(rules, fv)
+
+				for _, r := range rules {
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+					switch r.name {
+					case tagStructOnly, tagNoStructLevel:
+						continue
+					}
+					if fn, ok := tb.crossFieldRules().get(r.name); ok {
+						if err := fn(rv, fv, r.params...); err != nil {
+							if err := tb.record(ve, newFieldError(fpath, r.name, r.params, fv, err)); err != nil {
+								return err
+							}
+						}
+						continue
+					}
+					if fn, ok := tb.fieldLevelRules().get(r.name); ok {
+						if err := fn(fv, rv, root, r.params...); err != nil {
+							if err := tb.record(ve, newFieldError(fpath, r.name, r.params, fv, err)); err != nil {
+								return err
+							}
+						}
+						continue
+					}
+					if isConditionalRule(r.name) {
+						if err := evalConditionalRule(r, fv, rv, root); err != nil {
+							if err := tb.record(ve, newFieldError(fpath, r.name, r.params, fv, err)); err != nil {
+								return err
+							}
+						}
+						continue
+					}
+					if err := tb.applyRuleOrChain(ctx, r, tb.resolveValidationValue(fv)); err != nil {
+						if err := tb.record(ve, newFieldError(fpath, r.name, r.params, fv, err)); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			// Process `validateElem` tag for slices, arrays, and maps
+			elemTagName := tb.effectiveElemTagName()
+			if elemRaw := field.Tag.Get(elemTagName); elemRaw != "" && elemRaw != "-" {
+				if keyRules, valueRules, segmented := parseSegmentedElemTag(elemRaw); segmented {
+					if err := tb.validateMapKeysAndValues(ctx, fv, fpath, keyRules, valueRules, ve); err != nil {
+						return err
+					}
+				} else {
+					elemRules, exists := tb.rulesMapping.get(typ, i, elemTagName)
+					if !exists {
+						elemRules = parseTag(elemRaw)
+						tb.rulesMapping.add(typ, i, elemTagName, elemRules)
+					}
+
+					if err := tb.validateElements(ctx, fv, fpath, elemRules, ve); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Process `validateKey` tag: a standalone way to validate every key
+			// of a map field, for callers who only care about the keys and find
+			// the segmented "keys=...|values=..." validateElem grammar (or a
+			// bare "dive,keys,...,endkeys" plan) more ceremony than they need.
+			if keyRaw := field.Tag.Get(tagValidateKey); keyRaw != "" && keyRaw != "-" {
+				if err := tb.validateMapKeyTag(ctx, typ, i, fv, fpath, keyRaw, ve); err != nil {
+					return err
 				}
 			}
 		}
+	}
 
-		// Process `validateElem` tag for slices, arrays, and maps
-		if elemRaw := field.Tag.Get(tagValidateElem); elemRaw != "" && elemRaw != "-" {
-			elemRules, exists := tb.rulesMapping.get(typ, i, tagValidateElem)
-			if !exists {
-				elemRules = parseTag(elemRaw)
-				tb.rulesMapping.add(typ, i, tagValidateElem, elemRules)
+	if runStructLevel {
+		// Run any struct-level validation hooks registered for this exact type.
+		for _, fn := range tb.structRulesFor(typ) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			for _, fe := range fn(ctx, rv, path) {
+				if err := tb.record(ve, fe); err != nil {
+					return err
+				}
 			}
+		}
 
-			if err := tb.validateElements(ctx, fv, fpath, elemRules, ve); err != nil {
+		// Run named struct-level hooks referenced by this type's blank "_"
+		// field, e.g. `validate:"structrule(passwordsMatch)"`.
+		for _, r := range namedStructRuleRefsFor(typ, tb.effectiveTagName()) {
+			if err := ctx.Err(); err != nil {
 				return err
 			}
+			for _, name := range r.params {
+				fn, ok := tb.namedStructRules().get(name)
+				if !ok {
+					if err := tb.record(ve, newFieldError(path, tagStructRule, r.params, rv, fmt.Errorf("structrule %q is not registered", name))); err != nil {
+						return err
+					}
+					continue
+				}
+				for _, fe := range fn(ctx, rv, path) {
+					if err := tb.record(ve, fe); err != nil {
+						return err
+					}
+				}
+			}
 		}
 	}
+
 	return nil
 }
 
 // validateElements applies validation rules to elements of a slice, array, or map
-// using pre-parsed rules (e.g., retrieved from the cache).
+// using pre-parsed rules (e.g., retrieved from the cache). The rules may contain
+// "dive" tokens (optionally followed by "keys,...,endkeys,...") describing
+// multi-level descent into nested collections; see buildElemPlan.
 func (tb *typeBinding) validateElements(ctx context.Context, fv reflect.Value, fpath string, rules []ruleNameParams, ve *ValidationError) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	return tb.applyElemPlan(ctx, fv, fpath, buildElemPlan(rules), ve)
+}
+
+// applyElemPlan executes one level of an elemPlan against container value fv:
+// it runs plan.pre against the container itself, then (if plan.dive) iterates
+// its elements or map entries, applying plan.keys to each map key and
+// descending into plan.next (nested dive) or plan.rules for each element/value.
+func (tb *typeBinding) applyElemPlan(ctx context.Context, fv reflect.Value, fpath string, plan *elemPlan, ve *ValidationError) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -87,11 +231,26 @@ func (tb *typeBinding) validateElements(ctx context.Context, fv reflect.Value, f
 	if cont.Kind() == reflect.Ptr && !cont.IsNil() {
 		cont = cont.Elem()
 	}
-	if len(rules) == 0 {
+
+	isContainer := cont.Kind() == reflect.Slice || cont.Kind() == reflect.Array || cont.Kind() == reflect.Map
+	if ve.pathFilter.allowsField(fpath) {
+		for _, r := range plan.pre {
+			var err error
+			if isContainer && containerLengthRuleNames[r.name] {
+				err = applyContainerLengthRule(r.name, r.params, cont.Len())
+			} else {
+				err = tb.applyRuleOrChain(ctx, r, cont)
+			}
+			if err != nil {
+				if err := tb.record(ve, newFieldError(fpath, r.name, r.params, cont, err)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if !plan.dive || !ve.pathFilter.allowsSubtree(fpath) {
 		return nil
 	}
-	// Special case: validateElem:"dive" means recurse into element structs
-	isDiveOnly := len(rules) == 1 && rules[0].name == tagDive && len(rules[0].params) == 0
 
 	switch cont.Kind() {
 	case reflect.Slice, reflect.Array:
@@ -101,7 +260,7 @@ func (tb *typeBinding) validateElements(ctx context.Context, fv reflect.Value, f
 			}
 			elem := cont.Index(i)
 			pathIdx := fmt.Sprintf("%s[%d]", fpath, i)
-			if err := tb.validateSingleElement(ctx, elem, pathIdx, rules, isDiveOnly, ve); err != nil {
+			if err := tb.applyElemLevel(ctx, elem, pathIdx, plan, ve); err != nil {
 				return err
 			}
 		}
@@ -110,9 +269,69 @@ func (tb *typeBinding) validateElements(ctx context.Context, fv reflect.Value, f
 			if err := ctx.Err(); err != nil {
 				return err
 			}
-			elem := cont.MapIndex(key)
 			pathKey := fmt.Sprintf("%s[%v]", fpath, key.Interface())
-			if err := tb.validateSingleElement(ctx, elem, pathKey, rules, isDiveOnly, ve); err != nil {
+			if ve.pathFilter.allowsField(pathKey) {
+				for _, r := range plan.keys {
+					if err := tb.applyRuleOrChain(ctx, r, key); err != nil {
+						if err := tb.record(ve, newFieldError(pathKey, r.name, r.params, key, err)); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			elem := cont.MapIndex(key)
+			if err := tb.applyElemLevel(ctx, elem, pathKey, plan, ve); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyElemLevel handles one element reached by a dive: when plan.next is set
+// (multi-level dive) it applies plan.rules to the element and then descends
+// into it as a nested collection; otherwise it recurses into a struct element
+// for a bare trailing "dive", or applies plan.rules.
+func (tb *typeBinding) applyElemLevel(ctx context.Context, elem reflect.Value, path string, plan *elemPlan, ve *ValidationError) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if plan.next != nil {
+		if ve.pathFilter.allowsField(path) {
+			for _, r := range plan.rules {
+				if err := tb.applyRuleOrChain(ctx, r, elem); err != nil {
+					if err := tb.record(ve, newFieldError(path, r.name, r.params, elem, err)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return tb.applyElemPlan(ctx, elem, path, plan.next, ve)
+	}
+
+	dv := elem
+	if dv.Kind() == reflect.Ptr && !dv.IsNil() {
+		dv = dv.Elem()
+	}
+	if len(plan.rules) == 0 {
+		if dv.Kind() == reflect.Struct {
+			if !ve.pathFilter.allowsSubtree(path) {
+				return nil
+			}
+			return tb.validateStruct(ctx, dv, path, ve)
+		}
+		return tb.record(ve, newFieldError(path, tagDive, nil, dv, fmt.Errorf("validateElem:\"dive\" requires struct element, got %s", dv.Kind())))
+	}
+
+	if !ve.pathFilter.allowsField(path) {
+		return nil
+	}
+	for _, r := range plan.rules {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := tb.applyRuleOrChain(ctx, r, elem); err != nil {
+			if err := tb.record(ve, newFieldError(path, r.name, r.params, elem, err)); err != nil {
 				return err
 			}
 		}
@@ -133,16 +352,17 @@ func (tb *typeBinding) validateSingleElement(ctx context.Context, elem reflect.V
 		if dv.Kind() == reflect.Struct {
 			return tb.validateStruct(ctx, dv, path, ve)
 		}
-		ve.Add(FieldError{Path: path, Rule: tagDive, Err: fmt.Errorf("validateElem:\"dive\" requires struct element, got %s", dv.Kind())})
-		return nil
+		return tb.record(ve, newFieldError(path, tagDive, nil, dv, fmt.Errorf("validateElem:\"dive\" requires struct element, got %s", dv.Kind())))
 	}
 
 	for _, r := range rules {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := tb.applyRule(r.name, elem, r.params...); err != nil {
-			ve.Add(FieldError{Path: path, Rule: r.name, Params: r.params, Err: err})
+		if err := tb.applyRuleOrChain(ctx, r, elem); err != nil {
+			if err := tb.record(ve, newFieldError(path, r.name, r.params, elem, err)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ygrebnov/errorc"
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// crossFieldRuleFunc is the type-erased form of a rule registered via
+// NewStructRule: it receives the enclosing struct value and the tagged
+// field's value.
+type crossFieldRuleFunc func(parent, field reflect.Value, params ...string) error
+
+// crossFieldRules holds the named cross-field rules registered on a Model,
+// dispatched by validateStructWithRoot ahead of the ordinary rulesRegistry
+// lookup when a validate tag token matches a registered name.
+type crossFieldRules struct {
+	mu     sync.RWMutex
+	byName map[string]crossFieldRuleFunc
+}
+
+func newCrossFieldRules() *crossFieldRules {
+	return &crossFieldRules{byName: make(map[string]crossFieldRuleFunc)}
+}
+
+func (c *crossFieldRules) add(name string, fn crossFieldRuleFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byName[name] = fn
+}
+
+func (c *crossFieldRules) get(name string) (crossFieldRuleFunc, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fn, ok := c.byName[name]
+	return fn, ok
+}
+
+// NewStructRule builds a named cross-field rule: fn receives the enclosing
+// struct (typed as Parent) and the tagged field's value (typed as Field), so
+// it can implement checks like "equal to sibling field" that a plain
+// single-value Rule cannot express. Register it on a Model with
+// RegisterStructFieldRule, then reference name from a validate tag, e.g.
+// `validate:"eqfield(PasswordConfirm)"`.
+func NewStructRule[Parent any, Field any](name string, fn func(parent Parent, field Field, params ...string) error) (string, crossFieldRuleFunc) {
+	return name, func(parent, field reflect.Value, params ...string) error {
+		p, ok := parent.Interface().(Parent)
+		if !ok {
+			return nil
+		}
+		f, ok := field.Interface().(Field)
+		if !ok {
+			return nil
+		}
+		return fn(p, f, params...)
+	}
+}
+
+// RegisterStructFieldRule registers a cross-field rule built by NewStructRule
+// on m, under the given name.
+func RegisterStructFieldRule[TObject any](m *Model[TObject], name string, fn crossFieldRuleFunc) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	m.binding.crossFieldRules().add(name, fn)
+	return nil
+}
+
+// NewCrossFieldRule builds a named cross-field rule that, unlike
+// NewStructRule, resolves the sibling field for you: the tag's first
+// parameter names it as a dotted path relative to the enclosing struct
+// (the same FieldByName-per-segment walk eqfield/gtefield/... use internally,
+// see lookupDottedField), and fn receives the tagged field and the resolved
+// sibling already typed as FieldType/OtherType. Any remaining params are
+// passed through. A path that doesn't resolve fails the rule with
+// ErrRuleFieldRefNotFound, and a sibling whose type doesn't match OtherType
+// fails it with ErrRuleTypeMismatch, rather than panicking; register the result with RegisterStructFieldRule, then reference
+// name from a validate tag, e.g. `validate:"withinBudget(Budget.Total)"`.
+//
+// The existing builtin conditional rules (eqfield, nefield, gtfield, ...;
+// see conditional.go) already cover the fixed comparison verbs from
+// go-playground/validator and additionally climb to the root struct when a
+// path doesn't resolve against the immediate parent. NewCrossFieldRule is for
+// user-defined comparisons with a richer, typed fn; a crossFieldRuleFunc only
+// receives the immediate parent, not the root, so unlike eqfield it does not
+// climb past it — most cross-field comparisons are against a direct sibling
+// anyway, and registering a struct rule with RegisterStructRule remains the
+// right tool for invariants that genuinely span the whole root object.
+//
+// No cycle detection is needed here: resolving path is a single forward walk
+// down its dotted segments, never a call back into the rule engine, so no
+// path expression can loop.
+func NewCrossFieldRule[FieldType, OtherType any](name string, fn func(v FieldType, other OtherType, params ...string) error) (string, crossFieldRuleFunc) {
+	return name, func(parent, field reflect.Value, params ...string) error {
+		if len(params) == 0 {
+			return fmt.Errorf("%s requires a field parameter", name)
+		}
+		path := params[0]
+		ov, ok := lookupDottedField(parent, path)
+		if !ok {
+			return errorc.With(
+				modelerrors.ErrRuleFieldRefNotFound,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, path),
+			)
+		}
+		v, ok := field.Interface().(FieldType)
+		if !ok {
+			return nil
+		}
+		o, ok := ov.Interface().(OtherType)
+		if !ok {
+			return errorc.With(
+				modelerrors.ErrRuleTypeMismatch,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, path),
+				errorc.String(modelerrors.ErrorFieldValueType, ov.Type().String()),
+			)
+		}
+		return fn(v, o, params[1:]...)
+	}
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type signupForm struct {
+	Password        string
+	ConfirmPassword string `validate:"eqfield(Password)"`
+}
+
+func TestModel_Validate_eqfield(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&signupForm{Password: "hunter2", ConfirmPassword: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m2, err := New(&signupForm{Password: "hunter2", ConfirmPassword: "other"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected eqfield mismatch to fail")
+	}
+}
+
+type acctForm struct {
+	Kind  string
+	Admin string
+}
+
+func TestRegisterStructFieldRule(t *testing.T) {
+	t.Parallel()
+
+	_, fn := NewStructRule("adminRequiresKind", func(parent acctForm, field string, _ ...string) error {
+		if parent.Kind == "admin" && field == "" {
+			return errors.New("admin field is required when Kind is admin")
+		}
+		return nil
+	})
+
+	m, err := New(&acctForm{Kind: "admin"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructFieldRule(m, "adminRequiresKind", fn); err != nil {
+		t.Fatalf("RegisterStructFieldRule: %v", err)
+	}
+}
+
+type budgetLine struct {
+	Total float64
+}
+
+type expenseForm struct {
+	Budget budgetLine
+	Amount float64 `validate:"withinBudget(Budget.Total)"`
+}
+
+func TestNewCrossFieldRule_typedSiblingLookup(t *testing.T) {
+	t.Parallel()
+
+	name, fn := NewCrossFieldRule("withinBudget", func(amount, total float64, _ ...string) error {
+		if amount > total {
+			return errors.New("amount exceeds budget total")
+		}
+		return nil
+	})
+
+	newExpenseModel := func(t *testing.T, obj *expenseForm) *Model[expenseForm] {
+		t.Helper()
+		m, err := New(obj)
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+		if err := RegisterStructFieldRule(m, name, fn); err != nil {
+			t.Fatalf("RegisterStructFieldRule: %v", err)
+		}
+		return m
+	}
+
+	m := newExpenseModel(t, &expenseForm{Budget: budgetLine{Total: 100}, Amount: 50})
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m2 := newExpenseModel(t, &expenseForm{Budget: budgetLine{Total: 100}, Amount: 150})
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected amount-over-budget to fail")
+	}
+}
+
+func TestNewCrossFieldRule_unresolvedPathFails(t *testing.T) {
+	t.Parallel()
+
+	name, fn := NewCrossFieldRule("withinBudget", func(amount, total float64, _ ...string) error {
+		return nil
+	})
+
+	type badForm struct {
+		Amount float64 `validate:"withinBudget(NoSuchField)"`
+	}
+
+	m, err := New(&badForm{Amount: 10})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructFieldRule(m, name, fn); err != nil {
+		t.Fatalf("RegisterStructFieldRule: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected unresolved sibling path to fail")
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// ctxRuleFunc is the type-erased form of a rule registered via NewRuleCtx: it
+// receives the validation ctx alongside the field value, so a slow or
+// external check (a DB lookup, a feature-flag read) can honor cancellation
+// and deadlines, or read request-scoped values out of ctx.
+type ctxRuleFunc func(ctx context.Context, v reflect.Value, params ...string) error
+
+// ctxRules holds the named context-aware rules registered on a Model,
+// dispatched by applyRuleOrChain ahead of the ordinary rulesRegistry lookup
+// when a validate or validateElem tag token matches a registered name. This
+// covers every call site that already routes through applyRuleOrChain: the
+// field-level validate tag, validateElem's single-level and multi-level dive
+// plans, and map key/value rules.
+type ctxRules struct {
+	mu     sync.RWMutex
+	byName map[string]ctxRuleFunc
+}
+
+func newCtxRules() *ctxRules {
+	return &ctxRules{byName: make(map[string]ctxRuleFunc)}
+}
+
+func (c *ctxRules) add(name string, fn ctxRuleFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byName[name] = fn
+}
+
+func (c *ctxRules) get(name string) (ctxRuleFunc, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	fn, ok := c.byName[name]
+	return fn, ok
+}
+
+// NewRuleCtx builds a named context-aware rule: fn receives the validation
+// ctx alongside the tagged field's value (typed as TField), so it can honor
+// cancellation/deadlines and read request-scoped values (DB handles, request
+// IDs, feature flags) out of ctx, unlike a plain Rule built by NewRule.
+// Register it on a Model with RegisterRuleCtx, then reference name from a
+// validate or validateElem tag like any other rule. An error is returned if
+// name is empty or fn is nil.
+func NewRuleCtx[TField any](name string, fn func(ctx context.Context, v TField, params ...string) error) (string, ctxRuleFunc, error) {
+	if name == "" || fn == nil {
+		return "", nil, ErrInvalidRule
+	}
+	return name, func(ctx context.Context, v reflect.Value, params ...string) error {
+		tv, ok := v.Interface().(TField)
+		if !ok {
+			return nil
+		}
+		return fn(ctx, tv, params...)
+	}, nil
+}
+
+// RegisterRuleCtx registers a context-aware rule built by NewRuleCtx on m,
+// under the given name.
+func RegisterRuleCtx[TObject any](m *Model[TObject], name string, fn ctxRuleFunc) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	m.binding.ctxRules().add(name, fn)
+	return nil
+}
+
+// WithRuleCtx registers a single context-aware rule at Model construction
+// time, mirroring WithRules for the ctx-aware registry.
+func WithRuleCtx[TObject any](name string, fn ctxRuleFunc) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return RegisterRuleCtx(m, name, fn)
+	}
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type ctxRuleDoc struct {
+	Name string `validate:"slowLookup"`
+}
+
+func TestModel_RegisterRuleCtx(t *testing.T) {
+	t.Parallel()
+
+	name, fn, err := NewRuleCtx[string]("slowLookup", func(ctx context.Context, s string, _ ...string) error {
+		if s == "" {
+			return errors.New("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRuleCtx: %v", err)
+	}
+
+	obj := ctxRuleDoc{Name: ""}
+	m, err := New(&obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterRuleCtx(m, name, fn); err != nil {
+		t.Fatalf("RegisterRuleCtx: %v", err)
+	}
+
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error for empty Name")
+	}
+
+	obj.Name = "ok"
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error once Name is set, got %v", err)
+	}
+}
+
+func TestNewRuleCtx_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := NewRuleCtx[string]("", func(context.Context, string, ...string) error { return nil }); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule for empty name, got %v", err)
+	}
+	if _, _, err := NewRuleCtx[string]("x", nil); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule for nil fn, got %v", err)
+	}
+}
+
+// TestValidate_CtxRule_ObservesCancellationMidRule mirrors
+// TestValidate_LongRunning_CanceledMidway, but the rule itself watches
+// ctx.Done() inside its own work loop instead of only being interrupted
+// between elements.
+func TestValidate_CtxRule_ObservesCancellationMidRule(t *testing.T) {
+	t.Parallel()
+
+	type LR struct {
+		Items []string `validateElem:"watchful"`
+	}
+
+	var processed int32
+	name, fn, err := NewRuleCtx[string]("watchful", func(ctx context.Context, _ string, _ ...string) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+			atomic.AddInt32(&processed, 1)
+			return nil
+		}
+	})
+	if err != nil {
+		t.Fatalf("NewRuleCtx: %v", err)
+	}
+
+	obj := LR{Items: make([]string, 200)}
+	m, err := New(&obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterRuleCtx(m, name, fn); err != nil {
+		t.Fatalf("RegisterRuleCtx: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		cancel()
+	}()
+
+	err = m.Validate(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if atomic.LoadInt32(&processed) >= int32(len(obj.Items)) {
+		t.Fatalf("expected to cancel before processing all elements; processed=%d total=%d", processed, len(obj.Items))
+	}
+}
@@ -0,0 +1,332 @@
+// Package cueschema lets a Binding source its field defaults and a handful of
+// numeric range constraints from a small, CUE-flavored schema document
+// instead of (or alongside) struct tags.
+//
+// This is NOT a CUE implementation: there is no CUE engine dependency
+// available to this module, so only the two field-value forms most commonly
+// used for simple service config schemas are understood:
+//
+//	Name: *"svc" | string   // default value + type (CUE's default disjunction)
+//	Port: >=1024 & <=65535  // numeric range constraint (conjunction)
+//
+// and the two may be combined on one line, separated by a comma:
+//
+//	Port: *8080 | int, >=1024 & <=65535
+//
+// Anything outside that grammar (nested structs, string patterns, imports,
+// the general CUE expression language, ...) is a parse error rather than a
+// best-effort guess. See internal/core's SetDefaultsFromOverlay for the same
+// philosophy applied to a different external format (a JSON/YAML overlay
+// document): report what isn't supported plainly instead of faking it.
+package cueschema
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+	"github.com/ygrebnov/model/validation"
+)
+
+// RangeConstraint is a numeric lower and/or upper bound parsed from a
+// ">=N & <=M"-style clause. A nil bound means that side is unconstrained.
+type RangeConstraint struct {
+	Min *float64
+	Max *float64
+}
+
+// FieldSpec is one field's parsed schema line: its Go field name, an
+// optional default literal (still in source form, e.g. `"svc"` or `8080`),
+// and/or an optional numeric range constraint.
+type FieldSpec struct {
+	Name           string
+	HasDefault     bool
+	DefaultLiteral string
+	Range          *RangeConstraint
+}
+
+// Schema is a parsed cueschema document: one FieldSpec per top-level field
+// label found in the source.
+type Schema struct {
+	Fields []FieldSpec
+}
+
+// Parse reads a cueschema document, one field per non-blank, non-comment
+// line of the form "FieldName: <clause>[, <clause>]". Lines starting with
+// "//" are comments. See the package doc for the supported clause grammar.
+func Parse(src []byte) (*Schema, error) {
+	schema := &Schema{}
+	for lineNo, raw := range strings.Split(string(src), "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		spec, err := parseLine(line)
+		if err != nil {
+			return nil, errorc.With(
+				modelerrors.ErrInvalidCUESchema,
+				errorc.String(modelerrors.ErrorFieldCause, fmt.Sprintf("line %d: %v", lineNo+1, err)),
+			)
+		}
+		schema.Fields = append(schema.Fields, spec)
+	}
+	return schema, nil
+}
+
+func parseLine(line string) (FieldSpec, error) {
+	idx := strings.IndexByte(line, ':')
+	if idx < 0 {
+		return FieldSpec{}, fmt.Errorf("cueschema: missing ':' in %q", line)
+	}
+	name := strings.TrimSpace(line[:idx])
+	if name == "" {
+		return FieldSpec{}, fmt.Errorf("cueschema: empty field name in %q", line)
+	}
+	spec := FieldSpec{Name: name}
+	for _, clause := range strings.Split(line[idx+1:], ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(clause, "*"):
+			lit, err := parseDefaultClause(clause)
+			if err != nil {
+				return FieldSpec{}, err
+			}
+			spec.HasDefault = true
+			spec.DefaultLiteral = lit
+		case strings.ContainsAny(clause, "<>"):
+			rng, err := parseRangeClause(clause)
+			if err != nil {
+				return FieldSpec{}, err
+			}
+			spec.Range = rng
+		default:
+			// A bare type keyword (string, int, float, bool, ...) with no
+			// default or constraint: nothing to record, but not an error —
+			// CUE schemas commonly declare a field's type alone.
+		}
+	}
+	return spec, nil
+}
+
+// parseDefaultClause parses "*LITERAL | TYPE", returning LITERAL verbatim
+// (still quoted, for string literals) for later typed parsing against the
+// bound Go field's kind.
+func parseDefaultClause(clause string) (string, error) {
+	rest := strings.TrimPrefix(clause, "*")
+	pipe := strings.IndexByte(rest, '|')
+	if pipe < 0 {
+		return "", fmt.Errorf("cueschema: default clause %q missing \"| type\"", clause)
+	}
+	literal := strings.TrimSpace(rest[:pipe])
+	if literal == "" {
+		return "", fmt.Errorf("cueschema: default clause %q has an empty literal", clause)
+	}
+	return literal, nil
+}
+
+// parseRangeClause parses "OP1 N1 & OP2 N2 & ..." where each OP is one of
+// >=, <=, >, <, combining into a single RangeConstraint.
+func parseRangeClause(clause string) (*RangeConstraint, error) {
+	rng := &RangeConstraint{}
+	for _, bound := range strings.Split(clause, "&") {
+		bound = strings.TrimSpace(bound)
+		if bound == "" {
+			continue
+		}
+		op, numeric, err := splitComparison(bound)
+		if err != nil {
+			return nil, err
+		}
+		v, err := strconv.ParseFloat(numeric, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cueschema: invalid numeric bound %q: %w", bound, err)
+		}
+		switch op {
+		case ">=", ">":
+			rng.Min = &v
+		case "<=", "<":
+			rng.Max = &v
+		}
+	}
+	if rng.Min == nil && rng.Max == nil {
+		return nil, fmt.Errorf("cueschema: range clause %q has no recognized bound", clause)
+	}
+	return rng, nil
+}
+
+func splitComparison(bound string) (op, numeric string, err error) {
+	for _, candidate := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(bound, candidate) {
+			return candidate, strings.TrimSpace(strings.TrimPrefix(bound, candidate)), nil
+		}
+	}
+	return "", "", fmt.Errorf("cueschema: %q is not a recognized comparison", bound)
+}
+
+// BindTo checks that every FieldSpec names an exported field of typ,
+// returning an error naming the first one that doesn't. Call this once, at
+// construction time, so a typo in the schema fails fast rather than
+// silently doing nothing every time defaults or constraints are applied.
+func (s *Schema) BindTo(typ reflect.Type) error {
+	for _, fs := range s.Fields {
+		field, ok := typ.FieldByName(fs.Name)
+		if !ok || field.PkgPath != "" {
+			return errorc.With(
+				modelerrors.ErrInvalidCUESchema,
+				errorc.String(modelerrors.ErrorFieldFieldName, fs.Name),
+				errorc.String(modelerrors.ErrorFieldCause, "no such exported field on "+typ.String()),
+			)
+		}
+	}
+	return nil
+}
+
+// HasConstraints reports whether any field carries a range constraint, so
+// callers can skip registering a no-op struct check.
+func (s *Schema) HasConstraints() bool {
+	for _, fs := range s.Fields {
+		if fs.Range != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyDefaults sets every field named by a FieldSpec with a default literal
+// to that literal when it is currently zero, mirroring the zero-check
+// semantics of a `default` struct tag. rv must be the addressable struct
+// value (not a pointer).
+func (s *Schema) ApplyDefaults(rv reflect.Value) error {
+	de := &validation.DefaultsError{}
+	for _, fs := range s.Fields {
+		if !fs.HasDefault {
+			continue
+		}
+		fv := rv.FieldByName(fs.Name)
+		if !fv.IsValid() || !fv.CanSet() || !fv.IsZero() {
+			continue
+		}
+		if err := setLiteral(fv, fs.DefaultLiteral); err != nil {
+			de.Add(validation.FieldError{
+				Path: fs.Name, NamePath: fs.Name, Rule: "cueschema.default",
+				Err: errorc.With(modelerrors.ErrSetDefault,
+					errorc.String(modelerrors.ErrorFieldFieldName, fs.Name),
+					errorc.Error(modelerrors.ErrorFieldCause, err)),
+			})
+		}
+	}
+	if de.Empty() {
+		return nil
+	}
+	return de
+}
+
+// setLiteral parses literal (as produced by parseDefaultClause, still
+// quoted for strings) against fv's kind and sets it.
+func setLiteral(fv reflect.Value, literal string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		unquoted := literal
+		if len(literal) >= 2 && literal[0] == '"' && literal[len(literal)-1] == '"' {
+			unquoted = literal[1 : len(literal)-1]
+		}
+		fv.SetString(unquoted)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(literal)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(literal, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(literal, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	default:
+		return errorc.With(
+			modelerrors.ErrDefaultLiteralUnsupportedKind,
+			errorc.String(modelerrors.ErrorFieldDefaultLiteralKind, fv.Kind().String()),
+		)
+	}
+	return nil
+}
+
+// ValidateConstraints checks every field carrying a RangeConstraint against
+// its current numeric value, aggregating every failure into a single
+// *validation.Error, the same way Binding.Validate does for tag-driven
+// rules. rv must be the addressable struct value (not a pointer).
+func (s *Schema) ValidateConstraints(rv reflect.Value) error {
+	ve := &validation.Error{}
+	for _, fs := range s.Fields {
+		if fs.Range == nil {
+			continue
+		}
+		fv := rv.FieldByName(fs.Name)
+		if !fv.IsValid() {
+			continue
+		}
+		if err := checkRange(fv, *fs.Range); err != nil {
+			ve.Add(validation.FieldError{
+				Path: fs.Name, NamePath: fs.Name, Rule: "cueschema.range", Err: err,
+			})
+		}
+	}
+	if ve.Empty() {
+		return nil
+	}
+	return ve
+}
+
+func checkRange(fv reflect.Value, rng RangeConstraint) error {
+	var actual float64
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(fv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(fv.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = fv.Float()
+	default:
+		return errorc.With(
+			modelerrors.ErrDefaultLiteralUnsupportedKind,
+			errorc.String(modelerrors.ErrorFieldDefaultLiteralKind, fv.Kind().String()),
+		)
+	}
+	if rng.Min != nil && actual < *rng.Min {
+		return errorc.With(
+			modelerrors.ErrRuleConstraintViolated,
+			errorc.String(modelerrors.ErrorFieldRuleName, "cueschema.range"),
+			errorc.String(modelerrors.ErrorFieldRuleValue, strconv.FormatFloat(actual, 'g', -1, 64)),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, strconv.FormatFloat(*rng.Min, 'g', -1, 64)),
+		)
+	}
+	if rng.Max != nil && actual > *rng.Max {
+		return errorc.With(
+			modelerrors.ErrRuleConstraintViolated,
+			errorc.String(modelerrors.ErrorFieldRuleName, "cueschema.range"),
+			errorc.String(modelerrors.ErrorFieldRuleValue, strconv.FormatFloat(actual, 'g', -1, 64)),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, strconv.FormatFloat(*rng.Max, 'g', -1, 64)),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package cueschema
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+func TestParse_defaultClause(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte(`Name: *"svc" | string`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(schema.Fields) != 1 {
+		t.Fatalf("len(Fields) = %d, want 1", len(schema.Fields))
+	}
+	fs := schema.Fields[0]
+	if fs.Name != "Name" || !fs.HasDefault || fs.DefaultLiteral != `"svc"` {
+		t.Fatalf("unexpected field spec: %+v", fs)
+	}
+}
+
+func TestParse_rangeClause(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte(`Port: >=0 & <=5`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	fs := schema.Fields[0]
+	if fs.Range == nil || *fs.Range.Min != 0 || *fs.Range.Max != 5 {
+		t.Fatalf("unexpected range: %+v", fs.Range)
+	}
+}
+
+func TestParse_combinedClause(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte(`Port: *8080 | int, >=1024 & <=65535`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	fs := schema.Fields[0]
+	if !fs.HasDefault || fs.DefaultLiteral != "8080" {
+		t.Fatalf("default not parsed: %+v", fs)
+	}
+	if fs.Range == nil || *fs.Range.Min != 1024 || *fs.Range.Max != 65535 {
+		t.Fatalf("range not parsed: %+v", fs.Range)
+	}
+}
+
+func TestParse_commentsAndBlankLines(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte("// a comment\n\nName: *\"svc\" | string\n"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(schema.Fields) != 1 {
+		t.Fatalf("len(Fields) = %d, want 1", len(schema.Fields))
+	}
+}
+
+func TestParse_malformedLineReportsError(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte(`Name *"svc" | string`))
+	if err == nil {
+		t.Fatalf("expected an error for a line missing ':'")
+	}
+	if !errors.Is(err, modelerrors.ErrInvalidCUESchema) {
+		t.Fatalf("expected ErrInvalidCUESchema, got %v", err)
+	}
+}
+
+func TestSchema_BindTo_unknownField(t *testing.T) {
+	t.Parallel()
+
+	type target struct{ Name string }
+	schema, err := Parse([]byte(`Missing: *"x" | string`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := schema.BindTo(reflect.TypeOf(target{})); err == nil {
+		t.Fatalf("expected an error for a field not present on target")
+	}
+}
+
+func TestSchema_ApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name string
+		Port int
+	}
+	schema, err := Parse([]byte("Name: *\"svc\" | string\nPort: *8080 | int\n"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	obj := config{}
+	if err := schema.ApplyDefaults(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if obj.Name != "svc" || obj.Port != 8080 {
+		t.Fatalf("unexpected defaults: %+v", obj)
+	}
+
+	obj2 := config{Name: "already-set"}
+	if err := schema.ApplyDefaults(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("ApplyDefaults: %v", err)
+	}
+	if obj2.Name != "already-set" {
+		t.Fatalf("Name = %q, want unchanged since it was non-zero", obj2.Name)
+	}
+	if obj2.Port != 8080 {
+		t.Fatalf("Port = %d, want 8080", obj2.Port)
+	}
+}
+
+func TestSchema_ValidateConstraints(t *testing.T) {
+	t.Parallel()
+
+	type config struct{ Port int }
+	schema, err := Parse([]byte(`Port: >=1024 & <=65535`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	ok := config{Port: 8080}
+	if err := schema.ValidateConstraints(reflect.ValueOf(&ok).Elem()); err != nil {
+		t.Fatalf("ValidateConstraints: %v", err)
+	}
+
+	bad := config{Port: 80}
+	err = schema.ValidateConstraints(reflect.ValueOf(&bad).Elem())
+	if err == nil {
+		t.Fatalf("expected a range violation for Port=80")
+	}
+}
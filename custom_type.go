@@ -0,0 +1,46 @@
+package model
+
+import "reflect"
+
+// RegisterCustomTypeFunc registers fn to extract the underlying value that
+// validate rules should run against for fields whose type is one of types
+// (e.g. uuid.UUID). fn should return nil to indicate the field is
+// zero/absent, e.g.:
+//
+//	m.RegisterCustomTypeFunc(func(v reflect.Value) interface{} {
+//		id := v.Interface().(uuid.UUID)
+//		if id == uuid.Nil {
+//			return nil
+//		}
+//		return id.String()
+//	}, uuid.UUID{})
+//
+// Types implementing database/sql/driver.Valuer (sql.NullString,
+// sql.NullInt64, sql.NullFloat64, sql.NullTime, ...) or encoding.TextMarshaler
+// are unwrapped automatically and do not need a registered CustomTypeFunc:
+// rules run against the driver value or marshaled text, and "omitempty"
+// treats a nil driver value (or a MarshalText error) as empty (see
+// isEmptyValue and unwrapKnownWrapper). Register one here only to override
+// that default, or for types that are neither. Whichever extraction applies
+// (registered CustomTypeFunc or the automatic unwrap) is also what "omitempty"
+// checks for zero-ness, so a field's own notion of "empty" (e.g. fn returning
+// nil for a sentinel value) is honored consistently by both.
+func (m *Model[TObject]) RegisterCustomTypeFunc(fn func(reflect.Value) interface{}, types ...interface{}) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	rtypes := make([]reflect.Type, 0, len(types))
+	for _, t := range types {
+		rtypes = append(rtypes, reflect.TypeOf(t))
+	}
+	m.binding.registerCustomType(fn, rtypes...)
+	return nil
+}
+
+// WithCustomTypes registers a CustomTypeFunc for the given sample values as a
+// Model construction Option. See RegisterCustomTypeFunc for details.
+func WithCustomTypes[TObject any](fn func(reflect.Value) interface{}, types ...interface{}) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return m.RegisterCustomTypeFunc(fn, types...)
+	}
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+type nullableForm struct {
+	Name sql.NullString `validate:"omitempty,nonempty"`
+}
+
+func TestModel_Validate_driverValuer_omitempty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   sql.NullString
+		wantErr bool
+	}{
+		{"absent (Valid=false) is skipped", sql.NullString{}, false},
+		{"present and non-empty passes", sql.NullString{String: "ada", Valid: true}, false},
+		{"present but empty fails nonempty", sql.NullString{String: "", Valid: true}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&nullableForm{Name: tt.value})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type nullStringRule struct {
+	Name sql.NullString `validate:"sqlNameEquals(ada)"`
+}
+
+func TestModel_Validate_driverValuer_unwrappedValue(t *testing.T) {
+	t.Parallel()
+
+	equalsAda, err := NewRule[string]("sqlNameEquals", func(s string, params ...string) error {
+		if len(params) != 1 || s != params[0] {
+			t.Fatalf("sqlNameEquals rule should observe the unwrapped string %q, got %q", "ada", s)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	m, err := New(
+		&nullStringRule{Name: sql.NullString{String: "ada", Valid: true}},
+		WithRules[nullStringRule](equalsAda),
+	)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+// textMarshalerID is a minimal encoding.TextMarshaler, standing in for types
+// like uuid.UUID that expose their canonical form via MarshalText rather than
+// database/sql/driver.Valuer.
+type textMarshalerID struct{ raw string }
+
+func (id textMarshalerID) MarshalText() ([]byte, error) { return []byte(id.raw), nil }
+
+// sentinelID stands in for a domain type with its own "unset" sentinel value
+// (e.g. uuid.Nil), which a registered CustomTypeFunc projects to nil.
+type sentinelID struct{ raw string }
+
+type sentinelIDForm struct {
+	ID sentinelID `validate:"omitempty,nonempty"`
+}
+
+func TestModel_Validate_customTypeFunc_omitempty(t *testing.T) {
+	t.Parallel()
+
+	extract := func(v reflect.Value) interface{} {
+		id := v.Interface().(sentinelID)
+		if id.raw == "" {
+			return nil
+		}
+		return id.raw
+	}
+
+	tests := []struct {
+		name    string
+		id      sentinelID
+		wantErr bool
+	}{
+		{"sentinel (nil extraction) is skipped by omitempty", sentinelID{}, false},
+		{"non-sentinel value passes nonempty", sentinelID{raw: "abc"}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(
+				&sentinelIDForm{ID: tt.id},
+				WithCustomTypes[sentinelIDForm](extract, sentinelID{}),
+			)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type textMarshalerForm struct {
+	ID textMarshalerID `validate:"idEquals(abc-123)"`
+}
+
+func TestModel_Validate_textMarshaler_unwrappedValue(t *testing.T) {
+	t.Parallel()
+
+	idEquals, err := NewRule[string]("idEquals", func(s string, params ...string) error {
+		if len(params) != 1 || s != params[0] {
+			t.Fatalf("idEquals rule should observe the marshaled text %q, got %q", "abc-123", s)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	m, err := New(
+		&textMarshalerForm{ID: textMarshalerID{raw: "abc-123"}},
+		WithRules[textMarshalerForm](idEquals),
+	)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/ygrebnov/model/errors"
+)
+
+// Decoder decodes a document directly into *T, then applies T's defaults and
+// validates the result in a single Decode call.
+//
+// It also preserves which of T's top-level fields the input explicitly set,
+// via a shallow scan of the decoded document's top-level keys, so a field's
+// `default` tag only fires when the field was absent from the input — not
+// merely when it decoded to its zero value. This is what lets a bool field
+// default correctly even though `false` is indistinguishable from "absent"
+// under IsZero alone; nested fields still follow ordinary IsZero semantics,
+// since the presence scan is deliberately shallow (top-level keys only).
+//
+// Only JSON is implemented; NewYAMLDecoder reports a clear
+// unsupported-format error rather than a half-working conversion, since this
+// module has no YAML parser dependency to convert through — the same
+// constraint, and the same honesty, as Binding.ApplyDefaultsFromOverlay
+// already applies to "yaml"/"yml" overlay documents.
+type Decoder[T any] struct {
+	r    io.Reader
+	b    *Binding[T]
+	yaml bool
+
+	presence map[string]bool
+}
+
+// NewJSONDecoder returns a Decoder that reads a JSON document from r and
+// applies/validates it against b.
+func NewJSONDecoder[T any](r io.Reader, b *Binding[T]) *Decoder[T] {
+	return &Decoder[T]{r: r, b: b}
+}
+
+// NewYAMLDecoder returns a Decoder whose Decode always reports an
+// unsupported-format error; see the Decoder doc comment.
+func NewYAMLDecoder[T any](r io.Reader, b *Binding[T]) *Decoder[T] {
+	return &Decoder[T]{r: r, b: b, yaml: true}
+}
+
+// Decode reads the Decoder's input, unmarshals it into out, applies T's
+// defaults (skipping any top-level field the input explicitly set, even to
+// its zero value), and validates the result. Call Presence afterward to see
+// which top-level fields the input set.
+func (d *Decoder[T]) Decode(out *T) error {
+	if d.yaml {
+		return fmt.Errorf("model: Decoder: YAML is not yet supported, use NewJSONDecoder")
+	}
+	if out == nil {
+		return errors.ErrNilObject
+	}
+
+	data, err := io.ReadAll(d.r)
+	if err != nil {
+		return fmt.Errorf("model: Decoder: read: %w", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("model: Decoder: decode: %w", err)
+	}
+
+	rv := reflect.ValueOf(out).Elem()
+	typ := rv.Type()
+	d.presence = topLevelPresence(data, typ)
+	snapshot := snapshotPresentFields(rv, typ, d.presence)
+
+	if err := d.b.ApplyDefaults(out); err != nil {
+		return err
+	}
+	restoreSnapshot(rv, snapshot)
+
+	return d.b.Validate(context.Background(), out)
+}
+
+// Presence reports, after a call to Decode, which of T's top-level fields
+// (keyed by Go field name) were explicitly present in the decoded document.
+// A field absent from this map was not present in the input, so it was
+// eligible for its `default` tag; a field present is reported true whether
+// or not its decoded value happened to be the zero value.
+func (d *Decoder[T]) Presence() map[string]bool {
+	return d.presence
+}
+
+// topLevelPresence shallow-scans data's top-level JSON object keys and maps
+// each one back to typ's matching Go field name (by json tag, falling back
+// to the field's own name), mirroring the name-tag resolution
+// ValidateStruct/SetDefaultsStruct use for FieldError.NamePath.
+func topLevelPresence(data []byte, typ reflect.Type) map[string]bool {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+
+	byJSONName := make(map[string]string, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			if idx := strings.IndexByte(tag, ','); idx != -1 {
+				tag = tag[:idx]
+			}
+			if tag != "" && tag != "-" {
+				name = tag
+			}
+		}
+		byJSONName[name] = field.Name
+	}
+
+	presence := make(map[string]bool, len(raw))
+	for key := range raw {
+		if fieldName, ok := byJSONName[key]; ok {
+			presence[fieldName] = true
+		}
+	}
+	return presence
+}
+
+// snapshotPresentFields copies the current value of every top-level,
+// exported field named in presence, so Decode can restore it after
+// ApplyDefaults runs (which cannot otherwise tell an explicit zero value
+// apart from an absent field).
+func snapshotPresentFields(rv reflect.Value, typ reflect.Type, presence map[string]bool) map[int]any {
+	if len(presence) == 0 {
+		return nil
+	}
+	snapshot := make(map[int]any, len(presence))
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" || !presence[field.Name] {
+			continue
+		}
+		snapshot[i] = rv.Field(i).Interface()
+	}
+	return snapshot
+}
+
+// restoreSnapshot writes every captured value in snapshot back onto rv,
+// undoing any default ApplyDefaults mistakenly applied to a field the input
+// had explicitly set to its zero value.
+func restoreSnapshot(rv reflect.Value, snapshot map[int]any) {
+	for idx, v := range snapshot {
+		rv.Field(idx).Set(reflect.ValueOf(v))
+	}
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	modelErrors "github.com/ygrebnov/model/errors"
+)
+
+func TestDecoder_JSON_defaultsAbsentFieldsOnly(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Name    string `json:"name" default:"svc"`
+		Enabled bool   `json:"enabled" default:"true"`
+		Port    int    `json:"port" default:"8080"`
+	}
+
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewJSONDecoder[server](strings.NewReader(`{"enabled": false}`), b)
+	var out server
+	if err := dec.Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	if out.Enabled {
+		t.Fatalf("Enabled = true, want false (explicitly set in input)")
+	}
+	if out.Name != "svc" {
+		t.Fatalf("Name = %q, want %q (absent from input, so defaulted)", out.Name, "svc")
+	}
+	if out.Port != 8080 {
+		t.Fatalf("Port = %d, want 8080 (absent from input, so defaulted)", out.Port)
+	}
+
+	presence := dec.Presence()
+	if !presence["Enabled"] {
+		t.Fatalf("Presence()[\"Enabled\"] = false, want true")
+	}
+	if presence["Name"] || presence["Port"] {
+		t.Fatalf("Presence() reported an absent field as present: %+v", presence)
+	}
+}
+
+func TestDecoder_JSON_absentFieldDefaults(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Port int `json:"port" default:"8080"`
+	}
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewJSONDecoder[server](strings.NewReader(`{}`), b)
+	var out server
+	if err := dec.Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if out.Port != 8080 {
+		t.Fatalf("Port = %d, want 8080", out.Port)
+	}
+}
+
+func TestDecoder_JSON_explicitZeroIsPreserved(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Port int `json:"port" default:"8080"`
+	}
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewJSONDecoder[server](strings.NewReader(`{"port": 0}`), b)
+	var out server
+	if err := dec.Decode(&out); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if out.Port != 0 {
+		t.Fatalf("Port = %d, want 0 (explicitly set in input)", out.Port)
+	}
+}
+
+func TestDecoder_JSON_runsValidation(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Port int `json:"port" validate:"gte=1"`
+	}
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewJSONDecoder[server](strings.NewReader(`{"port": -1}`), b)
+	var out server
+	if err := dec.Decode(&out); err == nil {
+		t.Fatalf("expected a validation error for port=-1")
+	}
+}
+
+func TestDecoder_YAML_reportsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	type server struct{ Name string }
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewYAMLDecoder[server](strings.NewReader(`name: svc`), b)
+	var out server
+	err = dec.Decode(&out)
+	if err == nil {
+		t.Fatalf("expected an unsupported-format error")
+	}
+}
+
+func TestDecoder_Decode_nilObject(t *testing.T) {
+	t.Parallel()
+
+	type server struct{ Name string }
+	b, err := NewBinding[server]()
+	if err != nil {
+		t.Fatalf("NewBinding: %v", err)
+	}
+
+	dec := NewJSONDecoder[server](strings.NewReader(`{}`), b)
+	if err := dec.Decode(nil); !errors.Is(err, modelErrors.ErrNilObject) {
+		t.Fatalf("Decode(nil): %v", err)
+	}
+}
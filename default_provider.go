@@ -0,0 +1,54 @@
+package model
+
+import "fmt"
+
+// builtinDefaultPrefixes names the fixed provider prefixes
+// resolveDefaultLiteral already understands natively. RegisterDefaultProvider
+// rejects reusing one of these names, since a provider registered under them
+// would never be consulted — resolveDefaultLiteral tries the built-ins
+// first, before resolveDefaultProvider ever sees the literal.
+var builtinDefaultPrefixes = map[string]bool{
+	"env": true, "envOr": true, "func": true, "now": true, "uuid": true, "hostname": true,
+}
+
+// RegisterDefaultProvider registers fn under name, extending the fixed
+// env:/envOr:/func:/now/uuid/hostname prefixes resolveDefaultLiteral
+// understands natively with a user-defined one: a `default:"name:arg"` tag
+// invokes fn with the tagged field's type and the text after the colon,
+// setting the result if the field is still zero. Register it as an Option
+// to New, e.g.:
+//
+//	m, err := New(&obj, RegisterDefaultProvider[MyObject]("region", func(t reflect.Type, arg string) (interface{}, error) {
+//		return lookupRegion(arg)
+//	}))
+//
+// Unlike a rule registered with RegisterRules (which happens after New, once
+// the Model already exists), RegisterDefaultProvider is a functional Option
+// supplied to New itself, so every provider name a struct's default tags
+// might reference is already known by the time ensureBinding runs — not
+// just by the time SetDefaults happens to be called.
+//
+// fn itself is not invoked at bind time, only recorded: calling it eagerly
+// and caching the result would go stale for a provider meant to produce a
+// fresh value per call (an environment lookup, a random ID, anything in the
+// spirit of the built-in "now"), defeating the reason SetDefaults resolves
+// these sources lazily in the first place. name is also not cross-checked
+// against every default tag in the struct up front: a tag literal that
+// happens to contain a colon (a map literal like `default:"{a:1,b:2}"`, see
+// setMapLiteral) is common enough that treating every "word:rest"-shaped
+// literal as a misconfigured provider reference would misfire on fields that
+// were never meant to use one; resolveDefaultProvider only ever dispatches
+// a name this function actually registered, so an unrelated colon-bearing
+// literal is left untouched.
+func RegisterDefaultProvider[TObject any](name string, fn DefaultProvider) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if builtinDefaultPrefixes[name] {
+			return fmt.Errorf("default provider %q shadows a built-in prefix", name)
+		}
+		if m.defaultProviders == nil {
+			m.defaultProviders = make(map[string]DefaultProvider)
+		}
+		m.defaultProviders[name] = fn
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type providerDefaultHolder struct {
+	Region string
+	Count  int
+}
+
+func TestRegisterDefaultProvider_rejectsBuiltinPrefix(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&providerDefaultHolder{}, RegisterDefaultProvider[providerDefaultHolder]("env", func(reflect.Type, string) (interface{}, error) {
+		return "x", nil
+	}))
+	if err == nil {
+		t.Fatalf("expected registering a provider named after a built-in prefix to fail")
+	}
+}
+
+func TestSetLiteralDefaultWithProviders_dispatchesRegisteredProvider(t *testing.T) {
+	t.Parallel()
+
+	providers := map[string]DefaultProvider{
+		"region": func(fieldType reflect.Type, arg string) (interface{}, error) {
+			return fmt.Sprintf("%s-%s", arg, fieldType.Kind()), nil
+		},
+	}
+
+	obj := &providerDefaultHolder{}
+	if err := setLiteralDefaultWithProviders(sourceField(obj, "Region"), "region:us-east", nil, nil, providers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "us-east-string"; obj.Region != want {
+		t.Fatalf("got Region=%q, want %q", obj.Region, want)
+	}
+}
+
+func TestSetLiteralDefaultWithProviders_unregisteredNamePassesThroughAsLiteral(t *testing.T) {
+	t.Parallel()
+
+	obj := &providerDefaultHolder{}
+	if err := setLiteralDefaultWithProviders(sourceField(obj, "Region"), "unregistered:arg", nil, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "unregistered:arg"; obj.Region != want {
+		t.Fatalf("got Region=%q, want literal %q unchanged", obj.Region, want)
+	}
+}
+
+func TestSetLiteralDefaultWithProviders_propagatesProviderError(t *testing.T) {
+	t.Parallel()
+
+	providers := map[string]DefaultProvider{
+		"region": func(reflect.Type, string) (interface{}, error) {
+			return nil, fmt.Errorf("lookup failed")
+		},
+	}
+
+	obj := &providerDefaultHolder{}
+	if err := setLiteralDefaultWithProviders(sourceField(obj, "Region"), "region:bad", nil, nil, providers); err == nil {
+		t.Fatalf("expected provider error to propagate")
+	}
+}
+
+func TestSetLiteralDefaultWithProviders_doesNotOverwriteNonZero(t *testing.T) {
+	t.Parallel()
+
+	providers := map[string]DefaultProvider{
+		"region": func(reflect.Type, string) (interface{}, error) { return "fresh", nil },
+	}
+
+	obj := &providerDefaultHolder{Region: "already-set"}
+	if err := setLiteralDefaultWithProviders(sourceField(obj, "Region"), "region:us-east", nil, nil, providers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Region != "already-set" {
+		t.Fatalf("expected existing non-zero value to be preserved, got %q", obj.Region)
+	}
+}
+
+func TestResolveDefaultProvider_mapLiteralNotMistakenForProvider(t *testing.T) {
+	t.Parallel()
+
+	// A registered provider named "a" must not hijack an ordinary
+	// "{a:1,b:2}"-style map-literal default for an unrelated field; only the
+	// exact literal text "a:..." (not "{a:1,b:2}") would match the provider
+	// dispatch shape, and resolveDefaultProvider is only ever consulted from
+	// setLiteralDefaultWithProviders for scalar `default:"..."` fields, not
+	// map literals routed through setMapLiteral.
+	providers := map[string]DefaultProvider{
+		"a": func(reflect.Type, string) (interface{}, error) { return "hijacked", nil },
+	}
+	_, matched, err := resolveDefaultProvider("{a:1,b:2}", providers, reflect.TypeOf(map[string]int{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if matched {
+		t.Fatalf("expected a braced map literal not to match provider dispatch")
+	}
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DefaultFunc produces a field's default value on demand, for use with a
+// `default:"func:name"` tag. Register one or more under WithDefaultFuncs.
+type DefaultFunc func() (interface{}, error)
+
+// DefaultProvider produces a field's default value for a `default:"name:arg"`
+// tag whose name isn't one of the fixed env/envOr/func/now/uuid/hostname
+// prefixes resolveDefaultLiteral understands natively. It receives the
+// tagged field's declared type (so one provider can branch on kind, e.g.
+// return an int for an int field and a string for a string field) and the
+// raw text after the colon. Register one under a chosen name with
+// RegisterDefaultProvider.
+type DefaultProvider func(fieldType reflect.Type, arg string) (interface{}, error)
+
+// resolveDefaultProvider checks whether lit has the shape "name:arg" with
+// name matching a provider registered via RegisterDefaultProvider, and
+// invokes it if so. matched is false — not an error — for any lit that
+// isn't "name:arg"-shaped or whose name isn't a registered provider, so it
+// is safe to try against every already-resolved literal default without
+// risking a false positive against, say, a `default:"{a:1,b:2}"` map
+// literal (see setMapLiteral): only a name an actual RegisterDefaultProvider
+// call opted into is ever dispatched.
+func resolveDefaultProvider(lit string, providers map[string]DefaultProvider, fieldType reflect.Type) (value interface{}, matched bool, err error) {
+	if len(providers) == 0 {
+		return nil, false, nil
+	}
+	name, arg, ok := strings.Cut(lit, ":")
+	if !ok {
+		return nil, false, nil
+	}
+	p, ok := providers[name]
+	if !ok {
+		return nil, false, nil
+	}
+	v, err := p(fieldType, arg)
+	if err != nil {
+		return nil, false, fmt.Errorf("default provider %q: %w", name, err)
+	}
+	return v, true, nil
+}
+
+// resolveDefaultLiteral expands a default:"..." literal that carries one of
+// the env:/envOr:/func: prefixes into the concrete value to apply:
+//
+//   - "env:NAME" resolves to the NAME environment variable (empty if unset).
+//   - "envOr:NAME|fallback" resolves to NAME, or fallback if NAME is unset.
+//   - "func:name" invokes the DefaultFunc registered under name via
+//     WithDefaultFuncs, producing a typed value rather than a string literal.
+//   - "now" resolves to the current time.Time, for time.Time fields.
+//   - "uuid" resolves to a freshly generated v4 UUID string, for string ID
+//     fields.
+//   - "hostname" resolves to os.Hostname().
+//
+// Any other literal is returned unchanged, with hasTyped false.
+func resolveDefaultLiteral(lit string, funcs map[string]DefaultFunc) (resolved string, typed interface{}, hasTyped bool, err error) {
+	switch {
+	case strings.HasPrefix(lit, "envOr:"):
+		name, fallback, _ := strings.Cut(strings.TrimPrefix(lit, "envOr:"), "|")
+		if v, ok := os.LookupEnv(name); ok {
+			return v, nil, false, nil
+		}
+		return fallback, nil, false, nil
+	case strings.HasPrefix(lit, "env:"):
+		return os.Getenv(strings.TrimPrefix(lit, "env:")), nil, false, nil
+	case strings.HasPrefix(lit, "func:"):
+		name := strings.TrimPrefix(lit, "func:")
+		fn, ok := funcs[name]
+		if !ok {
+			return "", nil, false, fmt.Errorf("default func %q is not registered", name)
+		}
+		v, err := fn()
+		if err != nil {
+			return "", nil, false, fmt.Errorf("default func %q: %w", name, err)
+		}
+		return "", v, true, nil
+	case lit == "now":
+		return "", time.Now(), true, nil
+	case lit == "uuid":
+		id, err := newUUIDv4()
+		if err != nil {
+			return "", nil, false, fmt.Errorf("default uuid: %w", err)
+		}
+		return "", id, true, nil
+	case lit == "hostname":
+		h, err := os.Hostname()
+		if err != nil {
+			return "", nil, false, fmt.Errorf("default hostname: %w", err)
+		}
+		return "", h, true, nil
+	default:
+		return lit, nil, false, nil
+	}
+}
+
+// newUUIDv4 generates a random (version 4, variant 1) UUID per RFC 4122,
+// for the "uuid" default source. It only fails if the system entropy
+// source is unavailable.
+func newUUIDv4() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// setTypedDefault sets fv to v if fv is currently zero, allocating through one
+// level of pointer indirection like setLiteralDefault does for literals. Used
+// for default:"func:..." sources, whose DefaultFunc already returns a
+// concrete Go value rather than a string to parse.
+func setTypedDefault(fv reflect.Value, v interface{}) error {
+	target := fv
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	if !target.CanSet() || !target.IsZero() {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil
+	}
+	if !rv.Type().AssignableTo(target.Type()) {
+		return fmt.Errorf("default func result type %s is not assignable to field type %s", rv.Type(), target.Type())
+	}
+	target.Set(rv)
+	return nil
+}
+
+// WithDefaultFuncs registers named functions usable from a `default:"func:name"`
+// tag, so a default can be computed at SetDefaults time (e.g. a generated UUID
+// or a value read from some external source) rather than written as a literal.
+func WithDefaultFuncs[TObject any](fns map[string]DefaultFunc) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if m.defaultFuncs == nil {
+			m.defaultFuncs = make(map[string]DefaultFunc, len(fns))
+		}
+		for name, fn := range fns {
+			m.defaultFuncs[name] = fn
+		}
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package model
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type sourceDefaultHolder struct {
+	Env      string
+	EnvOr    string
+	Func     string
+	FuncID   int
+	Now      time.Time
+	UUID     string
+	Hostname string
+}
+
+func sourceField(obj interface{}, name string) reflect.Value {
+	return reflect.ValueOf(obj).Elem().FieldByName(name)
+}
+
+func TestSetLiteralDefault_envSource(t *testing.T) {
+	t.Setenv("MODEL_TEST_DEFAULT_VAR", "from-env")
+
+	obj := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj, "Env"), "env:MODEL_TEST_DEFAULT_VAR", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Env != "from-env" {
+		t.Fatalf("expected Env=%q, got %q", "from-env", obj.Env)
+	}
+}
+
+func TestSetLiteralDefault_envOrSource(t *testing.T) {
+	obj := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj, "EnvOr"), "envOr:MODEL_TEST_UNSET_VAR|fallback", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.EnvOr != "fallback" {
+		t.Fatalf("expected EnvOr=%q, got %q", "fallback", obj.EnvOr)
+	}
+
+	t.Setenv("MODEL_TEST_DEFAULT_VAR2", "set")
+	obj2 := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj2, "EnvOr"), "envOr:MODEL_TEST_DEFAULT_VAR2|fallback", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj2.EnvOr != "set" {
+		t.Fatalf("expected EnvOr=%q, got %q", "set", obj2.EnvOr)
+	}
+}
+
+func TestSetLiteralDefault_funcSource(t *testing.T) {
+	funcs := map[string]DefaultFunc{
+		"makeGreeting": func() (interface{}, error) { return "hello", nil },
+	}
+
+	obj := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj, "Func"), "func:makeGreeting", nil, funcs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Func != "hello" {
+		t.Fatalf("expected Func=%q, got %q", "hello", obj.Func)
+	}
+}
+
+func TestSetLiteralDefault_funcSource_notRegistered(t *testing.T) {
+	obj := &sourceDefaultHolder{}
+	err := setLiteralDefault(sourceField(obj, "Func"), "func:missing", nil, map[string]DefaultFunc{})
+	if err == nil {
+		t.Fatalf("expected error for unregistered default func")
+	}
+}
+
+func TestSetLiteralDefault_funcSource_propagatesError(t *testing.T) {
+	boom := errors.New("boom")
+	funcs := map[string]DefaultFunc{
+		"bad": func() (interface{}, error) { return nil, boom },
+	}
+	obj := &sourceDefaultHolder{}
+	err := setLiteralDefault(sourceField(obj, "FuncID"), "func:bad", nil, funcs)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected wrapped boom error, got %v", err)
+	}
+}
+
+func TestSetLiteralDefault_nowSource(t *testing.T) {
+	obj := &sourceDefaultHolder{}
+	before := time.Now().Add(-time.Second)
+	if err := setLiteralDefault(sourceField(obj, "Now"), "now", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Now.Before(before) || obj.Now.After(time.Now().Add(time.Second)) {
+		t.Fatalf("expected Now to be set to roughly now, got %v", obj.Now)
+	}
+}
+
+func TestSetLiteralDefault_uuidSource(t *testing.T) {
+	obj := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj, "UUID"), "uuid", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(obj.UUID) != 36 {
+		t.Fatalf("expected a 36-character UUID string, got %q", obj.UUID)
+	}
+
+	obj2 := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj2, "UUID"), "uuid", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj2.UUID == obj.UUID {
+		t.Fatalf("expected each uuid default to be freshly generated, got the same value twice: %q", obj.UUID)
+	}
+}
+
+func TestSetLiteralDefault_hostnameSource(t *testing.T) {
+	obj := &sourceDefaultHolder{}
+	if err := setLiteralDefault(sourceField(obj, "Hostname"), "hostname", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Hostname == "" {
+		t.Fatalf("expected a non-empty hostname")
+	}
+}
+
+func TestWithDefaultFuncs(t *testing.T) {
+	called := false
+	m, err := New(&struct{ X string }{}, WithDefaultFuncs[struct{ X string }](map[string]DefaultFunc{
+		"mark": func() (interface{}, error) { called = true; return "v", nil },
+	}))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if m.defaultFuncs["mark"] == nil {
+		t.Fatalf("expected defaultFuncs to be populated")
+	}
+	if _, err := m.defaultFuncs["mark"](); err != nil || !called {
+		t.Fatalf("expected registered func to be callable, called=%v err=%v", called, err)
+	}
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// containerLengthRuleNames are validateElem "pre" rule names interpreted as a
+// length check against the container itself (its slice/array length, or its
+// number of map entries), rather than dispatched through the per-type Rule
+// registry: the registry's exact/assignable lookup has no generic notion of
+// "any slice or map type", so these few names are handled directly in
+// applyElemPlan instead of requiring a registered overload per concrete
+// container type.
+var containerLengthRuleNames = map[string]bool{
+	"min": true, "max": true, "len": true, "range": true,
+}
+
+// applyContainerLengthRule evaluates one of containerLengthRuleNames against
+// a container of the given length, parsing params the same way as the
+// equivalent per-type builtin rule (see builtin.go).
+func applyContainerLengthRule(name string, params []string, length int) error {
+	switch name {
+	case "min":
+		if len(params) != 1 {
+			return fmt.Errorf(`min requires exactly one parameter, e.g. validateElem:"min(1),dive,..."`)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+		if err != nil {
+			return fmt.Errorf("invalid min parameter %q for container length: %v", params[0], err)
+		}
+		if length < n {
+			return fmt.Errorf("length must be >= %d", n)
+		}
+	case "max":
+		if len(params) != 1 {
+			return fmt.Errorf(`max requires exactly one parameter, e.g. validateElem:"max(10),dive,..."`)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+		if err != nil {
+			return fmt.Errorf("invalid max parameter %q for container length: %v", params[0], err)
+		}
+		if length > n {
+			return fmt.Errorf("length must be <= %d", n)
+		}
+	case "len":
+		if len(params) != 1 {
+			return fmt.Errorf(`len requires exactly one parameter, e.g. validateElem:"len(3),dive,..."`)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+		if err != nil {
+			return fmt.Errorf("invalid len parameter %q for container length: %v", params[0], err)
+		}
+		if length != n {
+			return fmt.Errorf("length must be exactly %d", n)
+		}
+	case "range":
+		lo, hi, err := parseIntRangeParams(params)
+		if err != nil {
+			return err
+		}
+		if length < lo || length > hi {
+			return fmt.Errorf("length must be between %d and %d", lo, hi)
+		}
+	}
+	return nil
+}
+
+// elemPlan is a parsed validateElem rule list, supporting "dive" and
+// "keys,...,endkeys,..." grammar for descending into slices, arrays, and maps
+// at arbitrary nesting depth (e.g. "min(1),dive,required,dive,email" for
+// [][]string, or "dive,keys,min(3),endkeys,required" for map[string]string).
+type elemPlan struct {
+	pre   []ruleNameParams // rules applied to the container itself, before any dive
+	dive  bool             // whether this level descends into elements
+	keys  []ruleNameParams // map only: rules applied to each key (the keys..endkeys segment)
+	rules []ruleNameParams // rules applied to each element/value at this level
+	next  *elemPlan        // plan for the next dive level, set for multi-level descent
+}
+
+// buildElemPlan turns an already-tokenized rule list (as produced by parseTag)
+// into an elemPlan tree. Rules preceding the first "dive" token apply to the
+// container itself; everything from "dive" onward describes what happens to
+// each element, with a nested "dive" starting a further level of descent.
+func buildElemPlan(rules []ruleNameParams) *elemPlan {
+	plan := &elemPlan{}
+
+	i := 0
+	for i < len(rules) && rules[i].name != tagDive {
+		plan.pre = append(plan.pre, rules[i])
+		i++
+	}
+	if i == len(rules) {
+		return plan
+	}
+	plan.dive = true
+	rest := rules[i+1:]
+
+	if len(rest) > 0 && rest[0].name == tagKeys {
+		j := 1
+		for j < len(rest) && rest[j].name != tagEndKeys {
+			plan.keys = append(plan.keys, rest[j])
+			j++
+		}
+		if j < len(rest) {
+			rest = rest[j+1:] // skip past endkeys
+		} else {
+			rest = nil // malformed: keys without endkeys, treat remainder as consumed
+		}
+	}
+
+	nextDive := -1
+	for k, r := range rest {
+		if r.name == tagDive {
+			nextDive = k
+			break
+		}
+	}
+	if nextDive == -1 {
+		plan.rules = rest
+		return plan
+	}
+	plan.rules = rest[:nextDive]
+	plan.next = buildElemPlan(rest[nextDive:])
+	return plan
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+// diveContainerDoc exercises rules placed before "dive" (applied once, to the
+// container itself) alongside per-element rules applied after it, e.g.
+// "min(1),dive,min(1)" on a []string: the slice itself must have at least one
+// element, and each element must be a non-empty string.
+type diveContainerDoc struct {
+	Items []string `validateElem:"min(1),max(2),dive,min(1)"`
+}
+
+func TestModel_Validate_dive_containerAndElementRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		items   []string
+		wantErr bool
+	}{
+		{"within bounds, all elements valid", []string{"a", "b"}, false},
+		{"container too short", nil, true},
+		{"container too long", []string{"a", "b", "c"}, true},
+		{"element too short", []string{""}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&diveContainerDoc{Items: tt.items})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type diveMapOfSlicesDoc struct {
+	// map[string][]string: dive into the map, then dive into each slice value.
+	Groups map[string][]string `validateElem:"dive,dive,min(1)"`
+	// Same shape, but also validate the map keys via keys/endkeys before diving
+	// into the slice values.
+	Scored map[string][]string `validateElem:"dive,keys,min(3),endkeys,dive,min(1)"`
+}
+
+func TestModel_Validate_diveMapOfSlices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		obj     diveMapOfSlicesDoc
+		wantErr bool
+	}{
+		{
+			name:    "all valid",
+			obj:     diveMapOfSlicesDoc{Groups: map[string][]string{"a": {"x", "y"}}},
+			wantErr: false,
+		},
+		{
+			name:    "empty slice element fails dive,dive",
+			obj:     diveMapOfSlicesDoc{Groups: map[string][]string{"a": {""}}},
+			wantErr: true,
+		},
+		{
+			name:    "short map key fails keys,endkeys",
+			obj:     diveMapOfSlicesDoc{Scored: map[string][]string{"ab": {"x"}}},
+			wantErr: true,
+		},
+		{
+			name:    "valid key but empty slice element fails dive after endkeys",
+			obj:     diveMapOfSlicesDoc{Scored: map[string][]string{"abc": {""}}},
+			wantErr: true,
+		},
+		{
+			name:    "valid key and valid slice elements",
+			obj:     diveMapOfSlicesDoc{Scored: map[string][]string{"abc": {"x"}}},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := New(&tt.obj)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModel_Validate_diveMapOfSlices_errorPath(t *testing.T) {
+	t.Parallel()
+
+	obj := diveMapOfSlicesDoc{Groups: map[string][]string{"a": {"x", ""}}}
+	m, err := New(&obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(ve.ForField("Groups[a][1]")) != 1 {
+		t.Fatalf("expected exactly one error at Groups[a][1], got fields=%v", ve.Fields())
+	}
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+// diveItem is validated via its own `validate` tags once Items[i]
+// re-enters struct validation through a bare "dive" (no rules after it).
+type diveItem struct {
+	SKU string `validate:"nonempty"`
+}
+
+type diveStructsDoc struct {
+	Items  []diveItem `validateElem:"dive"`
+	Colors []string   `validateElem:"dive,oneof(red,green,blue)"`
+}
+
+func TestModel_Validate_dive_structSlice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		obj     diveStructsDoc
+		wantErr bool
+	}{
+		{"all items valid", diveStructsDoc{Items: []diveItem{{SKU: "a"}, {SKU: "b"}}}, false},
+		{"one item invalid", diveStructsDoc{Items: []diveItem{{SKU: "a"}, {SKU: ""}}}, true},
+		{"empty slice", diveStructsDoc{}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := New(&tt.obj)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModel_Validate_dive_stringSliceOneof(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		colors  []string
+		wantErr bool
+	}{
+		{"all allowed", []string{"red", "blue"}, false},
+		{"one disallowed", []string{"red", "purple"}, true},
+		{"empty slice", nil, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := New(&diveStructsDoc{Colors: tt.colors})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
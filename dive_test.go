@@ -0,0 +1,68 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type diveDoc struct {
+	Tags   []string          `validateElem:"dive,min(1)"`
+	Grid   [][]string        `validateElem:"dive,required,dive,min(1)"`
+	Labels map[string]string `validateElem:"dive,keys,min(3),endkeys,required"`
+}
+
+func TestModel_Validate_dive(t *testing.T) {
+	t.Parallel()
+
+	// "required" is not a built-in rule, so the test registers the two
+	// overloads its tags reach: Labels values and Grid rows.
+	requiredStr, err := NewRule[string]("required", func(s string, _ ...string) error {
+		if s == "" {
+			return errors.New("required")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	requiredRow, err := NewRule[[]string]("required", func(s []string, _ ...string) error {
+		if len(s) == 0 {
+			return errors.New("required")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		obj     diveDoc
+		wantErr bool
+	}{
+		{"all valid", diveDoc{Tags: []string{"a"}, Grid: [][]string{{"x"}}, Labels: map[string]string{"env": "prod"}}, false},
+		{"empty tag element", diveDoc{Tags: []string{""}}, true},
+		{"empty nested grid element", diveDoc{Grid: [][]string{{""}}}, true},
+		{"short map key", diveDoc{Labels: map[string]string{"ab": "x"}}, true},
+		{"empty map value", diveDoc{Labels: map[string]string{"env": ""}}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := New(&tt.obj)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			if err := m.RegisterRules(requiredStr, requiredRow); err != nil {
+				t.Fatalf("RegisterRules: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ var (
 	ErrAmbiguousRule                 = namespace.NewError("ambiguous rule")
 	ErrSetDefault                    = namespace.NewError("cannot set default value")
 	ErrDefaultLiteralUnsupportedKind = namespace.NewError("default literal unsupported kind")
+	ErrRuleCrossFieldMismatch        = namespace.NewError("cross-field rule mismatch")
+	ErrRuleConditionallyRequired     = namespace.NewError("conditionally required rule violated")
+	ErrRuleFieldRefNotFound          = namespace.NewError("referenced field not found or unexported")
+	ErrInvalidCUESchema              = namespace.NewError("invalid cueschema document")
 
 	// Validation rule argument and parameter errors
 	ErrRuleMissingParameter   = namespace.NewError("rule parameter is required")
@@ -30,6 +34,19 @@ var (
 	// Test-only/sample rule errors (used in model_validate_test)
 	ErrRuleMin1Failed       = namespace.NewError("min(1) rule failed")
 	ErrRuleNonZeroDurFailed = namespace.NewError("nonZeroDur rule failed")
+
+	// Sentinel errors for FieldError.Kind, one per Kind value. A FieldError
+	// matches its corresponding sentinel via errors.Is regardless of what its
+	// own Err wraps, so callers can classify failures without string parsing.
+	ErrKindInvalid      = namespace.NewError("invalid value")
+	ErrKindRequired     = namespace.NewError("required value")
+	ErrKindNotFound     = namespace.NewError("value not found")
+	ErrKindDuplicate    = namespace.NewError("duplicate value")
+	ErrKindNotSupported = namespace.NewError("unsupported value")
+	ErrKindTooLong      = namespace.NewError("value too long")
+	ErrKindTooMany      = namespace.NewError("too many items")
+	ErrKindForbidden    = namespace.NewError("forbidden value")
+	ErrKindInternal     = namespace.NewError("internal error")
 )
 
 var newKey = errorc.KeyFactory(constants.ErrorFieldNamespace)
@@ -49,9 +66,30 @@ var (
 	ErrorFieldAvailableTypes = newKey("available_types", keySegmentRule) // model.rule.available_types
 	ErrorFieldExactTypes     = newKey("exact_types", keySegmentRule)     // model.rule.exact_types (reserved)
 
+	// ErrorFieldFieldTypeA and ErrorFieldFieldTypeB name the two competing
+	// overloads on an ErrAmbiguousRule tie in RulesRegistry.Get.
+	ErrorFieldFieldTypeA = newKey("field_type_a", keySegmentRule) // model.rule.field_type_a
+	ErrorFieldFieldTypeB = newKey("field_type_b", keySegmentRule) // model.rule.field_type_b
+
 	// Parameters/arguments for a rule invocation
 	ErrorFieldRuleParamName  = newKey("param_name", keySegmentRule)  // model.rule.param_name
 	ErrorFieldRuleParamValue = newKey("param_value", keySegmentRule) // model.rule.param_value
+
+	// ErrorFieldRuleValue carries the offending field value itself (as
+	// formatted text), alongside ErrorFieldRuleParamValue's threshold, so a
+	// comparison rule's failure (min, max, gt, between, ...) can be filtered
+	// or displayed without re-deriving the value from the rest of the error.
+	ErrorFieldRuleValue = newKey("value", keySegmentRule) // model.rule.value
+
+	// ErrorFieldSubRuleName names the child rule that failed inside a
+	// combinator (AllOf, AnyOf, Not, When); see validation/combinators.go.
+	ErrorFieldSubRuleName = newKey("sub_rule", keySegmentRule) // model.rule.sub_rule
+
+	// ErrorFieldOtherFieldPath and ErrorFieldOtherFieldValue name the sibling
+	// field a cross-field rule (eqfield, gtfield, ...) compared against, and
+	// the value it held; see validation/cross_field.go.
+	ErrorFieldOtherFieldPath  = newKey("other_field_path", keySegmentRule)  // model.rule.other_field_path
+	ErrorFieldOtherFieldValue = newKey("other_field_value", keySegmentRule) // model.rule.other_field_value
 )
 
 var (
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type rangeForm struct {
+	Min int
+	Max int `validate:"gtfield(Min)"`
+
+	Start time.Time
+	End   time.Time `validate:"gtefield(Start)"`
+
+	A string
+	B string `validate:"nefield(A)"`
+
+	Hi int
+	Lo int `validate:"ltfield(Hi)"`
+
+	HiEq int
+	LoEq int `validate:"ltefield(HiEq)"`
+}
+
+func TestModel_Validate_fieldCompareRules(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	valid := rangeForm{
+		Min: 1, Max: 2,
+		Start: now, End: now.Add(time.Hour),
+		A: "x", B: "y",
+		Hi: 5, Lo: 4,
+		HiEq: 5, LoEq: 5,
+	}
+	m, err := New(&valid)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := rangeForm{
+		Min: 5, Max: 2, // gtfield fails
+		Start: now, End: now, // gtefield passes (equal)
+		A: "same", B: "same", // nefield fails
+		Hi: 4, Lo: 5, // ltfield fails
+		HiEq: 4, LoEq: 5, // ltefield fails
+	}
+	m2, err := New(&invalid)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m2.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation errors")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	for _, field := range []string{"Max", "B", "Lo", "LoEq"} {
+		if len(ve.ForField(field)) == 0 {
+			t.Errorf("expected an error on field %s", field)
+		}
+	}
+	if len(ve.ForField("End")) != 0 {
+		t.Errorf("did not expect an error on End (equal times satisfy gtefield)")
+	}
+}
@@ -3,8 +3,46 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// Kind classifies the nature of a FieldError, modeled after Kubernetes'
+// field.ErrorType, so consumers can branch on failure type without parsing
+// Rule names or messages.
+type Kind string
+
+// Kind values for the FieldError constructors below.
+const (
+	KindInvalid      Kind = "Invalid"
+	KindRequired     Kind = "Required"
+	KindNotFound     Kind = "NotFound"
+	KindDuplicate    Kind = "Duplicate"
+	KindNotSupported Kind = "NotSupported"
+	KindTooLong      Kind = "TooLong"
+	KindTooMany      Kind = "TooMany"
+	KindForbidden    Kind = "Forbidden"
+	KindInternal     Kind = "Internal"
 )
 
+// kindSentinels maps each Kind to the sentinel error FieldError.Is matches
+// against, so errors.Is(fe, errors.ErrKindRequired) succeeds for any
+// FieldError with Kind == KindRequired, independent of what Err wraps.
+var kindSentinels = map[Kind]error{
+	KindInvalid:      modelerrors.ErrKindInvalid,
+	KindRequired:     modelerrors.ErrKindRequired,
+	KindNotFound:     modelerrors.ErrKindNotFound,
+	KindDuplicate:    modelerrors.ErrKindDuplicate,
+	KindNotSupported: modelerrors.ErrKindNotSupported,
+	KindTooLong:      modelerrors.ErrKindTooLong,
+	KindTooMany:      modelerrors.ErrKindTooMany,
+	KindForbidden:    modelerrors.ErrKindForbidden,
+	KindInternal:     modelerrors.ErrKindInternal,
+}
+
 // FieldError represents a single validation failure for a specific field and validationRule.
 // It implements error and unwraps to the underlying cause so callers can use errors.Is/As.
 type FieldError struct {
@@ -12,6 +50,23 @@ type FieldError struct {
 	Rule   string   // validationRule name that failed
 	Params []string // parameters provided to the validationRule via validate tag
 	Err    error    // underlying error from the validationRule
+	Code   string   // stable, machine-readable error code (optional; set via WithCode on a Rule)
+	Kind   Kind     // machine-readable failure classification; zero value ("") when not set via a constructor
+	Type   string   // Go type of the offending field value (e.g. "string", "int64"); empty when not applicable
+	Value  any      // the offending field value itself, for integrators that want to re-render or re-translate the message
+}
+
+// newFieldError builds a FieldError for a failing validate/validateElem rule,
+// filling Type and Value from v when it holds a concrete value (v may be the
+// zero reflect.Value for failures that are not about one field's contents,
+// e.g. a malformed "dive" tag).
+func newFieldError(path, rule string, params []string, v reflect.Value, err error) FieldError {
+	fe := FieldError{Path: path, Rule: rule, Params: params, Err: err}
+	if v.IsValid() {
+		fe.Type = v.Type().String()
+		fe.Value = v.Interface()
+	}
+	return fe
 }
 
 func (e FieldError) Error() string {
@@ -23,6 +78,141 @@ func (e FieldError) Error() string {
 
 func (e FieldError) Unwrap() error { return e.Err }
 
+// Localized renders e using NewLocaleTranslator(locale), for callers that want
+// a single issue's message in a given language without going through
+// Model.Localize/LocalizeAll or attaching a Translator via WithTranslator.
+func (e FieldError) Localized(locale string) string {
+	return NewLocaleTranslator(locale).Translate(e)
+}
+
+// Is reports whether target is the sentinel error corresponding to e.Kind,
+// so errors.Is(fe, errors.ErrKindRequired) works regardless of the concrete
+// error e.Err wraps.
+func (e FieldError) Is(target error) bool {
+	sentinel, ok := kindSentinels[e.Kind]
+	return ok && target == sentinel
+}
+
+// Invalid returns a FieldError of KindInvalid reporting that value at path is
+// invalid for the stated detail reason.
+func Invalid(path string, value any, detail string) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "invalid",
+		Kind:   KindInvalid,
+		Params: []string{fmt.Sprint(value)},
+		Err:    fmt.Errorf("invalid value: %v: %s", value, detail),
+	}
+}
+
+// Required returns a FieldError of KindRequired reporting that path is
+// missing a required value.
+func Required(path, detail string) FieldError {
+	return FieldError{
+		Path: path,
+		Rule: "required",
+		Kind: KindRequired,
+		Err:  fmt.Errorf("required value: %s", detail),
+	}
+}
+
+// NotFound returns a FieldError of KindNotFound reporting that value at path
+// could not be found.
+func NotFound(path string, value any) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "notfound",
+		Kind:   KindNotFound,
+		Params: []string{fmt.Sprint(value)},
+		Err:    fmt.Errorf("not found: %v", value),
+	}
+}
+
+// Duplicate returns a FieldError of KindDuplicate reporting that value at
+// path duplicates another entry where it must be unique.
+func Duplicate(path string, value any) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "duplicate",
+		Kind:   KindDuplicate,
+		Params: []string{fmt.Sprint(value)},
+		Err:    fmt.Errorf("duplicate value: %v", value),
+	}
+}
+
+// NotSupported returns a FieldError of KindNotSupported reporting that value
+// at path is not one of validValues.
+func NotSupported(path string, value any, validValues []string) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "notsupported",
+		Kind:   KindNotSupported,
+		Params: append([]string{fmt.Sprint(value)}, validValues...),
+		Err: fmt.Errorf(
+			"unsupported value: %v: supported values: %s",
+			value,
+			strings.Join(quoteAll(validValues), ", "),
+		),
+	}
+}
+
+// TooLong returns a FieldError of KindTooLong reporting that the value at
+// path exceeds maxLength.
+func TooLong(path string, value any, maxLength int) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "toolong",
+		Kind:   KindTooLong,
+		Params: []string{fmt.Sprint(maxLength)},
+		Err:    fmt.Errorf("too long: may not be longer than %d", maxLength),
+	}
+}
+
+// TooMany returns a FieldError of KindTooMany reporting that path has actual
+// items where at most maxAllowed are permitted.
+func TooMany(path string, actual, maxAllowed int) FieldError {
+	return FieldError{
+		Path:   path,
+		Rule:   "toomany",
+		Kind:   KindTooMany,
+		Params: []string{fmt.Sprint(actual), fmt.Sprint(maxAllowed)},
+		Err:    fmt.Errorf("too many: %d: must have at most %d items", actual, maxAllowed),
+	}
+}
+
+// Forbidden returns a FieldError of KindForbidden reporting that path is not
+// permitted, for the stated detail reason.
+func Forbidden(path, detail string) FieldError {
+	return FieldError{
+		Path: path,
+		Rule: "forbidden",
+		Kind: KindForbidden,
+		Err:  fmt.Errorf("forbidden: %s", detail),
+	}
+}
+
+// Internal returns a FieldError of KindInternal wrapping cause, for failures
+// that are not a property of the input value (e.g. a rule's own dependency
+// failing). Unlike the other constructors, Err here is cause itself, so
+// errors.Is(fe, cause) also succeeds, in addition to errors.Is(fe,
+// errors.ErrKindInternal) via FieldError.Is.
+func Internal(path string, cause error) FieldError {
+	return FieldError{
+		Path: path,
+		Rule: "internal",
+		Kind: KindInternal,
+		Err:  cause,
+	}
+}
+
+func quoteAll(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strconv.Quote(v)
+	}
+	return out
+}
+
 // MarshalJSON exports FieldError as an object with path, validationRule, and message fields.
 func (e FieldError) MarshalJSON() ([]byte, error) {
 	msg := ""
@@ -34,10 +224,18 @@ func (e FieldError) MarshalJSON() ([]byte, error) {
 		Rule    string   `json:"validationRule"`
 		Params  []string `json:"params,omitempty"`
 		Message string   `json:"message"`
+		Code    string   `json:"code,omitempty"`
+		Kind    Kind     `json:"kind,omitempty"`
+		Type    string   `json:"type,omitempty"`
+		Value   any      `json:"value,omitempty"`
 	}{
 		Path:    e.Path,
 		Rule:    e.Rule,
 		Params:  e.Params,
 		Message: msg,
+		Code:    e.Code,
+		Kind:    e.Kind,
+		Type:    e.Type,
+		Value:   e.Value,
 	})
 }
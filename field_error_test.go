@@ -5,6 +5,8 @@ import (
 	"errors"
 	"strings"
 	"testing"
+
+	modelerrors "github.com/ygrebnov/model/errors"
 )
 
 func TestFieldError_Error(t *testing.T) {
@@ -88,6 +90,18 @@ func TestFieldError_Unwrap(t *testing.T) {
 	}
 }
 
+func TestFieldError_Localized(t *testing.T) {
+	t.Parallel()
+
+	fe := FieldError{Path: "Name", Rule: "nonempty", Err: errors.New("must not be empty")}
+	if got, want := fe.Localized("fr"), "Name ne doit pas être vide"; got != want {
+		t.Fatalf("Localized(fr) = %q, want %q", got, want)
+	}
+	if got, want := fe.Localized("en"), "Name must not be empty"; got != want {
+		t.Fatalf("Localized(en) = %q, want %q", got, want)
+	}
+}
+
 func TestFieldError_MarshalJSON(t *testing.T) {
 	t.Parallel()
 
@@ -167,3 +181,137 @@ func TestFieldError_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldError_Kind_Constructors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		fe         FieldError
+		wantKind   Kind
+		wantRule   string
+		wantErr    error
+		wantHasMsg string
+	}{
+		{
+			name:       "Invalid",
+			fe:         Invalid("Name", "", "must not be empty"),
+			wantKind:   KindInvalid,
+			wantRule:   "invalid",
+			wantErr:    modelerrors.ErrKindInvalid,
+			wantHasMsg: "must not be empty",
+		},
+		{
+			name:       "Required",
+			fe:         Required("Name", "this field is required"),
+			wantKind:   KindRequired,
+			wantRule:   "required",
+			wantErr:    modelerrors.ErrKindRequired,
+			wantHasMsg: "this field is required",
+		},
+		{
+			name:       "NotFound",
+			fe:         NotFound("Owner", "alice"),
+			wantKind:   KindNotFound,
+			wantRule:   "notfound",
+			wantErr:    modelerrors.ErrKindNotFound,
+			wantHasMsg: "alice",
+		},
+		{
+			name:       "Duplicate",
+			fe:         Duplicate("Tags[0]", "a"),
+			wantKind:   KindDuplicate,
+			wantRule:   "duplicate",
+			wantErr:    modelerrors.ErrKindDuplicate,
+			wantHasMsg: "a",
+		},
+		{
+			name:       "NotSupported",
+			fe:         NotSupported("Color", "puce", []string{"red", "green"}),
+			wantKind:   KindNotSupported,
+			wantRule:   "notsupported",
+			wantErr:    modelerrors.ErrKindNotSupported,
+			wantHasMsg: `"red", "green"`,
+		},
+		{
+			name:       "TooLong",
+			fe:         TooLong("Bio", "...", 100),
+			wantKind:   KindTooLong,
+			wantRule:   "toolong",
+			wantErr:    modelerrors.ErrKindTooLong,
+			wantHasMsg: "100",
+		},
+		{
+			name:       "TooMany",
+			fe:         TooMany("Items", 12, 10),
+			wantKind:   KindTooMany,
+			wantRule:   "toomany",
+			wantErr:    modelerrors.ErrKindTooMany,
+			wantHasMsg: "must have at most 10 items",
+		},
+		{
+			name:       "Forbidden",
+			fe:         Forbidden("Role", "admins only"),
+			wantKind:   KindForbidden,
+			wantRule:   "forbidden",
+			wantErr:    modelerrors.ErrKindForbidden,
+			wantHasMsg: "admins only",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.fe.Kind != tt.wantKind {
+				t.Fatalf("Kind = %v, want %v", tt.fe.Kind, tt.wantKind)
+			}
+			if tt.fe.Rule != tt.wantRule {
+				t.Fatalf("Rule = %v, want %v", tt.fe.Rule, tt.wantRule)
+			}
+			if !errors.Is(tt.fe, tt.wantErr) {
+				t.Fatalf("errors.Is(fe, %v) = false, want true", tt.wantErr)
+			}
+			if !strings.Contains(tt.fe.Err.Error(), tt.wantHasMsg) {
+				t.Fatalf("Err = %q, want to contain %q", tt.fe.Err.Error(), tt.wantHasMsg)
+			}
+		})
+	}
+}
+
+func TestFieldError_Internal(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("dial tcp: connection refused")
+	fe := Internal("Lookup", cause)
+
+	if fe.Kind != KindInternal {
+		t.Fatalf("Kind = %v, want KindInternal", fe.Kind)
+	}
+	if !errors.Is(fe, modelerrors.ErrKindInternal) {
+		t.Fatalf("errors.Is(fe, ErrKindInternal) = false, want true")
+	}
+	if !errors.Is(fe, cause) {
+		t.Fatalf("errors.Is(fe, cause) = false, want true")
+	}
+}
+
+func TestFieldError_MarshalJSON_Kind(t *testing.T) {
+	t.Parallel()
+
+	data, err := Required("Name", "must be set").MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if !strings.Contains(string(data), `"kind":"Required"`) {
+		t.Fatalf("expected kind field in JSON, got %s", data)
+	}
+
+	// Zero-value Kind is omitted.
+	data, err = FieldError{Path: "X", Rule: "r", Err: errors.New("x")}.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	if strings.Contains(string(data), `"kind"`) {
+		t.Fatalf("expected no kind field for zero-value Kind, got %s", data)
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type typeValueDoc struct {
+	Name string `validate:"nonempty"`
+	Age  int    `validate:"positive"`
+}
+
+func TestModel_Validate_aggregatesWithTypeAndValue(t *testing.T) {
+	obj := typeValueDoc{Name: "", Age: -1}
+	_, err := New(&obj, WithValidation[typeValueDoc](context.Background()))
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+
+	// Both fields must be reported together (collect-all is the default),
+	// each carrying the Go type and offending value of the field it failed on.
+	by := ve.ByField()
+	name := by["Name"]
+	if len(name) != 1 || name[0].Type != "string" || name[0].Value != "" {
+		t.Fatalf("unexpected Name field error: %+v", name)
+	}
+	age := by["Age"]
+	if len(age) != 1 || age[0].Type != "int" || age[0].Value != -1 {
+		t.Fatalf("unexpected Age field error: %+v", age)
+	}
+}
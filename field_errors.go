@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// FieldErrors aggregates independently-constructed FieldError values, for
+// callers assembling validation results outside of a Model/ValidationError
+// (e.g. from a hand-written admission check using Invalid/Required/...).
+type FieldErrors []FieldError
+
+// Filter returns the FieldErrors for which keep reports true, preserving order.
+func (fs FieldErrors) Filter(keep func(FieldError) bool) FieldErrors {
+	var out FieldErrors
+	for _, fe := range fs {
+		if keep(fe) {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// ByKind returns the FieldErrors whose Kind equals k.
+func (fs FieldErrors) ByKind(k Kind) FieldErrors {
+	return fs.Filter(func(fe FieldError) bool { return fe.Kind == k })
+}
+
+// ByPathPrefix returns the FieldErrors whose Path starts with prefix.
+func (fs FieldErrors) ByPathPrefix(prefix string) FieldErrors {
+	return fs.Filter(func(fe FieldError) bool { return strings.HasPrefix(fe.Path, prefix) })
+}
+
+// ToAggregate joins fs into a single error via errors.Join, so callers can
+// return one error from a function that collects many FieldErrors. It
+// returns nil when fs is empty.
+func (fs FieldErrors) ToAggregate() error {
+	if len(fs) == 0 {
+		return nil
+	}
+	errs := make([]error, len(fs))
+	for i, fe := range fs {
+		errs[i] = fe
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+func TestFieldErrors_Filter_ByKind_ByPathPrefix(t *testing.T) {
+	t.Parallel()
+
+	fs := FieldErrors{
+		Required("User.Name", "must be set"),
+		Invalid("User.Age", -1, "must be positive"),
+		NotFound("User.Address.City", "Atlantis"),
+		Required("Order.ID", "must be set"),
+	}
+
+	byKind := fs.ByKind(KindRequired)
+	if len(byKind) != 2 {
+		t.Fatalf("ByKind(Required) len = %d, want 2", len(byKind))
+	}
+
+	byPrefix := fs.ByPathPrefix("User.")
+	if len(byPrefix) != 3 {
+		t.Fatalf("ByPathPrefix(User.) len = %d, want 3", len(byPrefix))
+	}
+
+	filtered := fs.Filter(func(fe FieldError) bool { return fe.Kind == KindInvalid })
+	if len(filtered) != 1 || filtered[0].Path != "User.Age" {
+		t.Fatalf("Filter(Invalid) = %+v, want [User.Age]", filtered)
+	}
+}
+
+func TestFieldErrors_ToAggregate(t *testing.T) {
+	t.Parallel()
+
+	var empty FieldErrors
+	if err := empty.ToAggregate(); err != nil {
+		t.Fatalf("ToAggregate() on empty = %v, want nil", err)
+	}
+
+	fs := FieldErrors{
+		Required("Name", "must be set"),
+		Invalid("Age", -1, "must be positive"),
+	}
+	agg := fs.ToAggregate()
+	if agg == nil {
+		t.Fatalf("ToAggregate() = nil, want an error")
+	}
+	if !errors.Is(agg, modelerrors.ErrKindRequired) || !errors.Is(agg, modelerrors.ErrKindInvalid) {
+		t.Fatalf("ToAggregate() should join every FieldError: %v", agg)
+	}
+}
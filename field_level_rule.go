@@ -0,0 +1,80 @@
+package model
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldLevelRuleFunc is the type-erased form of a rule registered via
+// NewFieldLevelRule: besides the tagged field's own value and its tag
+// params, it receives the struct value immediately enclosing the field
+// (parent) and the top-level struct passed to Validate (root), so a custom
+// rule can implement checks that need more context than a sibling lookup by
+// name (see NewStructRule) without forking the package.
+type fieldLevelRuleFunc func(field, parent, root reflect.Value, params ...string) error
+
+// fieldLevelRules holds the named field-level rules registered on a Model,
+// dispatched by validateStructOpts ahead of the ordinary rulesRegistry
+// lookup, mirroring ctxRules and crossFieldRules.
+type fieldLevelRules struct {
+	mu     sync.RWMutex
+	byName map[string]fieldLevelRuleFunc
+}
+
+func newFieldLevelRules() *fieldLevelRules {
+	return &fieldLevelRules{byName: make(map[string]fieldLevelRuleFunc)}
+}
+
+func (f *fieldLevelRules) add(name string, fn fieldLevelRuleFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.byName[name] = fn
+}
+
+func (f *fieldLevelRules) get(name string) (fieldLevelRuleFunc, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	fn, ok := f.byName[name]
+	return fn, ok
+}
+
+// NewFieldLevelRule builds a named field-level rule: fn receives the tagged
+// field's value (typed as TField), the struct value immediately enclosing
+// it, and the top-level struct passed to Validate, so it can implement
+// checks like a custom isbn13 digit check or a lookup keyed by a sibling
+// field's value. Register it on a Model with RegisterFieldLevelRule, then
+// reference name from a validate tag like any other rule. An error is
+// returned if name is empty or fn is nil.
+func NewFieldLevelRule[TField any](
+	name string,
+	fn func(field TField, parent, root reflect.Value, params ...string) error,
+) (string, fieldLevelRuleFunc, error) {
+	if name == "" || fn == nil {
+		return "", nil, ErrInvalidRule
+	}
+	return name, func(field, parent, root reflect.Value, params ...string) error {
+		tv, ok := field.Interface().(TField)
+		if !ok {
+			return nil
+		}
+		return fn(tv, parent, root, params...)
+	}, nil
+}
+
+// RegisterFieldLevelRule registers a field-level rule built by
+// NewFieldLevelRule on m, under the given name.
+func RegisterFieldLevelRule[TObject any](m *Model[TObject], name string, fn fieldLevelRuleFunc) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	m.binding.fieldLevelRules().add(name, fn)
+	return nil
+}
+
+// WithFieldLevelRule registers a single field-level rule at Model
+// construction time, mirroring WithRuleCtx for the field-level registry.
+func WithFieldLevelRule[TObject any](name string, fn fieldLevelRuleFunc) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return RegisterFieldLevelRule(m, name, fn)
+	}
+}
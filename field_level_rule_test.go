@@ -0,0 +1,97 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type fieldLevelSignup struct {
+	Username string `validate:"notSameAsEmailLocalPart"`
+	Email    string
+}
+
+func TestModel_RegisterFieldLevelRule(t *testing.T) {
+	t.Parallel()
+
+	name, fn, err := NewFieldLevelRule[string]("notSameAsEmailLocalPart",
+		func(username string, parent, _ reflect.Value, _ ...string) error {
+			email := parent.FieldByName("Email").String()
+			if username != "" && username == email {
+				return errors.New("must differ from the email address")
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("NewFieldLevelRule: %v", err)
+	}
+
+	obj := fieldLevelSignup{Username: "alice@example.com", Email: "alice@example.com"}
+	m, err := New(&obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterFieldLevelRule(m, name, fn); err != nil {
+		t.Fatalf("RegisterFieldLevelRule: %v", err)
+	}
+
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error when Username equals Email")
+	}
+
+	obj.Username = "alice"
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error once Username differs, got %v", err)
+	}
+}
+
+func TestModel_WithFieldLevelRule_ReceivesRoot(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		Zip string `validate:"matchesAccountZip"`
+	}
+	type account struct {
+		Zip     string
+		Address address
+	}
+
+	name, fn, err := NewFieldLevelRule[string]("matchesAccountZip",
+		func(zip string, _, root reflect.Value, _ ...string) error {
+			want := root.FieldByName("Zip").String()
+			if zip != want {
+				return errors.New("must match the account zip")
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("NewFieldLevelRule: %v", err)
+	}
+
+	obj := account{Zip: "10001", Address: address{Zip: "99999"}}
+	m, err := New(&obj, WithFieldLevelRule[account](name, fn))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validation error when Address.Zip does not match root Zip")
+	}
+
+	obj.Address.Zip = "10001"
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error once Address.Zip matches, got %v", err)
+	}
+}
+
+func TestNewFieldLevelRule_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := NewFieldLevelRule[string]("", func(string, reflect.Value, reflect.Value, ...string) error { return nil }); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule for empty name, got %v", err)
+	}
+	if _, _, err := NewFieldLevelRule[string]("x", nil); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule for nil fn, got %v", err)
+	}
+}
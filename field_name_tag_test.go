@@ -0,0 +1,50 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type jsonNamedAddress struct {
+	Street string `json:"street_name" validate:"nonempty"`
+}
+
+type jsonNamedDoc struct {
+	UserName string           `json:"user_name,omitempty" validate:"nonempty"`
+	Internal string           `validate:"nonempty"`
+	Skipped  string           `json:"-" validate:"nonempty"`
+	Address  jsonNamedAddress `json:"address"`
+}
+
+func TestWithFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&jsonNamedDoc{}, WithFieldNameTag[jsonNamedDoc]("json"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+
+	fields := ve.Fields()
+	want := map[string]bool{
+		"user_name":           true,
+		"Internal":            true,
+		"Skipped":             true,
+		"address.street_name": true,
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("expected %d distinct field paths, got %v", len(want), fields)
+	}
+	for _, f := range fields {
+		if !want[f] {
+			t.Fatalf("unexpected field path %q, want one of %v", f, want)
+		}
+	}
+}
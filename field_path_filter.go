@@ -0,0 +1,81 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+// fieldPathFilter restricts a single validate walk to a subset of dotted
+// field paths, attached to a *ValidationError the same way modeOverride
+// threads ValidateAll's mode through typeBinding.validateStructOpts without
+// mutating the shared *typeBinding (see ValidationError.modeOverride).
+// include selects the two directions of Model.ValidatePartial /
+// Model.ValidateExcept: true means set is the only paths to validate, false
+// means set is the only paths to skip.
+type fieldPathFilter struct {
+	include bool
+	set     map[string]struct{}
+}
+
+// indexSegmentPattern matches one bracketed slice/array index or map key
+// segment, e.g. "[3]" or "[user-42]".
+var indexSegmentPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// normalizeFieldPath collapses every bracketed segment in path to a literal
+// "[*]" wildcard, so a caller-supplied pattern such as "MPtr[*].B" matches
+// the concrete runtime path "MPtr[2].B" regardless of which index or key was
+// actually walked.
+func normalizeFieldPath(path string) string {
+	return indexSegmentPattern.ReplaceAllString(path, "[*]")
+}
+
+// newFieldPathFilter builds a fieldPathFilter from the dotted field paths
+// passed to ValidatePartial/ValidateExcept, normalizing each one up front so
+// matching at walk time is a plain map lookup.
+func newFieldPathFilter(include bool, fields []string) *fieldPathFilter {
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[normalizeFieldPath(f)] = struct{}{}
+	}
+	return &fieldPathFilter{include: include, set: set}
+}
+
+// allowsField reports whether rules tagged directly on the field at path
+// should be evaluated.
+func (f *fieldPathFilter) allowsField(path string) bool {
+	if f == nil {
+		return true
+	}
+	_, in := f.set[normalizeFieldPath(path)]
+	if f.include {
+		return in
+	}
+	return !in
+}
+
+// allowsSubtree reports whether the walk should recurse into the nested
+// struct, pointer, or collection rooted at path at all. For ValidateExcept,
+// an excluded path prunes its entire subtree (matching the granularity of
+// go-playground/validator's StructExcept). For ValidatePartial, a subtree is
+// only worth entering when one of the included paths names it exactly or
+// lies somewhere underneath it.
+func (f *fieldPathFilter) allowsSubtree(path string) bool {
+	if f == nil {
+		return true
+	}
+	norm := normalizeFieldPath(path)
+	if !f.include {
+		_, excluded := f.set[norm]
+		return !excluded
+	}
+	if _, ok := f.set[norm]; ok {
+		return true
+	}
+	dotPrefix, idxPrefix := norm+".", norm+"["
+	for p := range f.set {
+		if strings.HasPrefix(p, dotPrefix) || strings.HasPrefix(p, idxPrefix) {
+			return true
+		}
+	}
+	return false
+}
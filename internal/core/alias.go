@@ -0,0 +1,154 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// Sentinel errors returned by Service.AddAlias when a proposed alias name or
+// expansion is unusable.
+var (
+	ErrAliasEmptyName    = errors.New("core: alias name must not be empty")
+	ErrAliasInvalidChars = errors.New("core: alias name must not contain '.', ',', '|', '=', '[', ']', '(' or ')'")
+	ErrAliasReservedName = errors.New("core: alias name collides with a reserved tag token")
+	ErrAliasCycle        = errors.New("core: alias expansion would create a cycle")
+)
+
+// maxAliasExpansionDepth bounds recursive alias expansion to guard against
+// aliases that (accidentally or maliciously) reference each other in a cycle.
+const maxAliasExpansionDepth = 8
+
+// restrictedAliasNames cannot be registered as alias names since they are
+// reserved for built-in tag grammar.
+var restrictedAliasNames = map[string]bool{
+	tagDive:    true,
+	tagKeys:    true,
+	tagEndKeys: true,
+}
+
+// AddAlias registers alias as shorthand for the given rule-list expansion, so
+// that a validate tag like `validate:"iso3166_alpha2"` behaves as if it had
+// been written out as `validate:"required,len(2),uppercase,alpha"`. Aliases
+// are expanded at tag-parse time and may themselves reference other aliases
+// (up to a bounded depth). It returns an error if alias is empty, contains
+// rule-grammar punctuation, collides with a reserved tag token, or its
+// expansion would create a cycle with an already-registered alias.
+func (s *Service) AddAlias(alias, expansion string) error {
+	if err := validateAliasName(alias); err != nil {
+		return err
+	}
+	s.aliasesMu.Lock()
+	defer s.aliasesMu.Unlock()
+	if err := detectAliasCycle(alias, expansion, s.aliases); err != nil {
+		return err
+	}
+	if s.aliases == nil {
+		s.aliases = make(map[string]string)
+	}
+	s.aliases[alias] = expansion
+	return nil
+}
+
+// aliasesSnapshot returns a copy of s.aliases safe for use outside s's lock.
+func (s *Service) aliasesSnapshot() map[string]string {
+	s.aliasesMu.RLock()
+	defer s.aliasesMu.RUnlock()
+	if len(s.aliases) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(s.aliases))
+	for k, v := range s.aliases {
+		out[k] = v
+	}
+	return out
+}
+
+// expandAliases replaces every validation.RuleNameParams in rules whose name
+// is a key of aliases with the parsed rule list its expansion string
+// represents, applying expansion recursively up to maxAliasExpansionDepth.
+// Rules with no matching alias are passed through unchanged.
+func expandAliases(rules []validation.RuleNameParams, aliases map[string]string) []validation.RuleNameParams {
+	if len(aliases) == 0 {
+		return rules
+	}
+	return expandAliasesDepth(rules, aliases, 0)
+}
+
+func expandAliasesDepth(rules []validation.RuleNameParams, aliases map[string]string, depth int) []validation.RuleNameParams {
+	if depth >= maxAliasExpansionDepth {
+		return rules
+	}
+	var out []validation.RuleNameParams
+	for _, r := range rules {
+		expansion, ok := aliases[r.Name]
+		if !ok {
+			out = append(out, r)
+			continue
+		}
+		alias := r.Name
+		if r.Alias != "" {
+			alias = r.Alias // a chained alias keeps citing the outermost name
+		}
+		for _, expanded := range expandAliasesDepth(validation.ParseTag(expansion), aliases, depth+1) {
+			expanded.Alias = alias
+			out = append(out, expanded)
+		}
+	}
+	return out
+}
+
+// validateAliasName reports whether name is usable as an alias: non-empty,
+// free of rule-grammar punctuation, and not colliding with a reserved token.
+func validateAliasName(name string) error {
+	if name == "" {
+		return ErrAliasEmptyName
+	}
+	for _, r := range name {
+		switch r {
+		case '.', ',', '|', '=', '[', ']', '(', ')':
+			return ErrAliasInvalidChars
+		}
+	}
+	if restrictedAliasNames[name] {
+		return ErrAliasReservedName
+	}
+	return nil
+}
+
+// detectAliasCycle reports whether registering alias -> expansion into the
+// existing alias set would let alias's expansion reach alias again, directly
+// or through other aliases.
+func detectAliasCycle(alias, expansion string, existing map[string]string) error {
+	candidate := make(map[string]string, len(existing)+1)
+	for k, v := range existing {
+		candidate[k] = v
+	}
+	candidate[alias] = expansion
+
+	visited := make(map[string]bool)
+	var walk func(name string) bool
+	walk = func(name string) bool {
+		exp, ok := candidate[name]
+		if !ok {
+			return false
+		}
+		for _, r := range validation.ParseTag(exp) {
+			if r.Name == alias {
+				return true
+			}
+			if visited[r.Name] {
+				continue
+			}
+			visited[r.Name] = true
+			if walk(r.Name) {
+				return true
+			}
+		}
+		return false
+	}
+	if walk(alias) {
+		return ErrAliasCycle
+	}
+	return nil
+}
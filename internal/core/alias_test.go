@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_ValidateStruct_aliasRetainsRuleAndAliasName(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code string `validate:"strict"`
+	}
+
+	obj := holder{Code: "ab"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+	if err := s.AddAlias("strict", "required,min(3)"); err != nil {
+		t.Fatalf("AddAlias: %v", err)
+	}
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Code")
+	if len(fe) != 1 {
+		t.Fatalf("expected a single Code failure, got %v", ve)
+	}
+	if fe[0].Rule != "min" {
+		t.Fatalf("expected the failure to cite the underlying rule %q, got %q", "min", fe[0].Rule)
+	}
+	if fe[0].Alias != "strict" {
+		t.Fatalf("expected the failure to cite the alias %q, got %q", "strict", fe[0].Alias)
+	}
+}
+
+func TestService_AddAlias_rejectsRuleGrammarCharacters(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code string
+	}
+	s := newTestService(t, reflect.TypeOf(holder{}))
+
+	for _, name := range []string{"a.b", "a,b", "a|b", "a=b", "a[b", "a]b", "a(b", "a)b"} {
+		if err := s.AddAlias(name, "required"); err != ErrAliasInvalidChars {
+			t.Fatalf("AddAlias(%q): got %v, want ErrAliasInvalidChars", name, err)
+		}
+	}
+}
+
+func TestService_AddAlias_usernameCompositeExample(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Name string `validate:"username"`
+	}
+	s := newTestService(t, reflect.TypeOf(holder{}))
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "max", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) > 32 {
+			return errTooShort
+		}
+		return nil
+	})
+	if err := s.AddAlias("username", "min(3),max(32)"); err != nil {
+		t.Fatalf("AddAlias: %v", err)
+	}
+
+	obj := holder{Name: "ab"}
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Name")
+	if len(fe) != 1 || fe[0].Rule != "min" || fe[0].Alias != "username" {
+		t.Fatalf("expected a single min failure citing alias username, got %v", ve)
+	}
+}
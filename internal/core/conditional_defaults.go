@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	"github.com/ygrebnov/model/errors"
+)
+
+// defaultIfOp names the comparison a defaultIf predicate runs against its
+// sibling field.
+type defaultIfOp int
+
+const (
+	defaultIfEq defaultIfOp = iota
+	defaultIfNe
+	defaultIfEmpty
+	defaultIfNotEmpty
+)
+
+// defaultIfPredicate is the preparsed, sibling-index-resolved form of a
+// defaultIf tag, built once in buildPlan. err is set instead when the tag
+// failed to parse or named a field that doesn't exist on the struct, so
+// every SetDefaultsStruct call reports the same misconfiguration rather than
+// re-deriving it.
+type defaultIfPredicate struct {
+	otherIndex int
+	op         defaultIfOp
+	value      string
+	err        error
+}
+
+// defaultFromPlan is the preparsed, sibling-index-resolved form of a
+// defaultFrom tag, built once in buildPlan.
+type defaultFromPlan struct {
+	otherIndex int
+	err        error
+}
+
+// parseDefaultIfTag splits a defaultIf tag into its sibling field name,
+// operator, and (for ==/!=) comparison value. Recognized forms:
+// "Field==value", "Field!=value", "Field empty", "Field notempty".
+func parseDefaultIfTag(tag string) (otherField string, op defaultIfOp, value string, err error) {
+	if idx := strings.Index(tag, "=="); idx >= 0 {
+		return strings.TrimSpace(tag[:idx]), defaultIfEq, strings.TrimSpace(tag[idx+2:]), nil
+	}
+	if idx := strings.Index(tag, "!="); idx >= 0 {
+		return strings.TrimSpace(tag[:idx]), defaultIfNe, strings.TrimSpace(tag[idx+2:]), nil
+	}
+	if fields := strings.Fields(tag); len(fields) == 2 {
+		switch fields[1] {
+		case "empty":
+			return fields[0], defaultIfEmpty, "", nil
+		case "notempty":
+			return fields[0], defaultIfNotEmpty, "", nil
+		}
+	}
+	return "", 0, "", fmt.Errorf(
+		"invalid defaultIf tag %q: want \"Field==value\", \"Field!=value\", \"Field empty\", or \"Field notempty\"", tag,
+	)
+}
+
+// buildDefaultIfPredicate resolves a defaultIf tag against nameToIndex (every
+// exported field of the struct, by Go field name), returning a predicate
+// whose err is set if the tag is malformed or its sibling field isn't found.
+func buildDefaultIfPredicate(tag string, nameToIndex map[string]int) *defaultIfPredicate {
+	otherField, op, value, err := parseDefaultIfTag(tag)
+	if err != nil {
+		return &defaultIfPredicate{err: err}
+	}
+	idx, ok := nameToIndex[otherField]
+	if !ok {
+		return &defaultIfPredicate{err: errorc.With(
+			errors.ErrRuleFieldRefNotFound,
+			errorc.String(errors.ErrorFieldOtherFieldPath, otherField),
+		)}
+	}
+	return &defaultIfPredicate{otherIndex: idx, op: op, value: value}
+}
+
+// buildDefaultFromPlan resolves a defaultFrom tag (a bare sibling field name)
+// against nameToIndex, mirroring buildDefaultIfPredicate's error handling.
+func buildDefaultFromPlan(tag string, nameToIndex map[string]int) *defaultFromPlan {
+	name := strings.TrimSpace(tag)
+	idx, ok := nameToIndex[name]
+	if !ok {
+		return &defaultFromPlan{err: errorc.With(
+			errors.ErrRuleFieldRefNotFound,
+			errorc.String(errors.ErrorFieldOtherFieldPath, name),
+		)}
+	}
+	return &defaultFromPlan{otherIndex: idx}
+}
+
+// evaluateDefaultIf reports whether pred's predicate is satisfied against
+// rv (the struct value defaultIf's own field belongs to), assuming pred.err
+// is nil.
+func evaluateDefaultIf(rv reflect.Value, pred defaultIfPredicate) bool {
+	other := indirectDefaultsValue(rv.Field(pred.otherIndex))
+	switch pred.op {
+	case defaultIfEmpty:
+		return !other.IsValid() || other.IsZero()
+	case defaultIfNotEmpty:
+		return other.IsValid() && !other.IsZero()
+	case defaultIfEq:
+		return formatDefaultsValue(other) == pred.value
+	case defaultIfNe:
+		return formatDefaultsValue(other) != pred.value
+	default:
+		return false
+	}
+}
+
+// indirectDefaultsValue dereferences a chain of non-nil pointers, the same
+// way validation's cross-field/conditional rules do.
+func indirectDefaultsValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// formatDefaultsValue renders v (already dereferenced) for comparison
+// against a defaultIf tag's literal.
+func formatDefaultsValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%v", v.Interface())
+}
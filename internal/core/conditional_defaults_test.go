@@ -0,0 +1,169 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_SetDefaultsStruct_defaultIf_equality(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		TLS         bool
+		ProtoScheme string `default:"https" defaultIf:"TLS==true"`
+	}
+
+	obj := server{TLS: true}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.ProtoScheme != "https" {
+		t.Fatalf("ProtoScheme = %q, want %q when TLS is true", obj.ProtoScheme, "https")
+	}
+
+	obj2 := server{TLS: false}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.ProtoScheme != "" {
+		t.Fatalf("ProtoScheme = %q, want unset when TLS is false", obj2.ProtoScheme)
+	}
+}
+
+func TestService_SetDefaultsStruct_defaultIf_inequalityAndEmpty(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Env     string
+		Debug   string `default:"on" defaultIf:"Env!=production"`
+		Backup  string
+		Fresh   string `default:"yes" defaultIf:"Backup empty"`
+		NonZero string `default:"no" defaultIf:"Backup notempty"`
+	}
+
+	obj := config{Env: "staging", Backup: ""}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Debug != "on" {
+		t.Fatalf("Debug = %q, want %q (Env != production)", obj.Debug, "on")
+	}
+	if obj.Fresh != "yes" {
+		t.Fatalf("Fresh = %q, want %q (Backup is empty)", obj.Fresh, "yes")
+	}
+	if obj.NonZero != "" {
+		t.Fatalf("NonZero = %q, want unset (Backup is empty)", obj.NonZero)
+	}
+
+	obj2 := config{Env: "production", Backup: "2024-01-01"}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.Debug != "" {
+		t.Fatalf("Debug = %q, want unset (Env == production)", obj2.Debug)
+	}
+	if obj2.Fresh != "" {
+		t.Fatalf("Fresh = %q, want unset (Backup is not empty)", obj2.Fresh)
+	}
+	if obj2.NonZero != "no" {
+		t.Fatalf("NonZero = %q, want %q (Backup is not empty)", obj2.NonZero, "no")
+	}
+}
+
+func TestService_SetDefaultsStruct_defaultIf_unknownFieldReportsError(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name string `default:"x" defaultIf:"Missing==1"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem())
+	if err == nil {
+		t.Fatalf("expected an error for a defaultIf tag naming a nonexistent field")
+	}
+	var de *validation.DefaultsError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected a *validation.DefaultsError, got %T", err)
+	}
+	if obj.Name != "" {
+		t.Fatalf("Name = %q, want unset when defaultIf fails to resolve", obj.Name)
+	}
+}
+
+func TestService_SetDefaultsStruct_defaultFrom(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Username    string
+		DisplayName string `defaultFrom:"Username"`
+	}
+
+	obj := user{Username: "ada"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.DisplayName != "ada" {
+		t.Fatalf("DisplayName = %q, want %q copied from Username", obj.DisplayName, "ada")
+	}
+
+	obj2 := user{Username: "grace", DisplayName: "already set"}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.DisplayName != "already set" {
+		t.Fatalf("DisplayName = %q, want unchanged since it was already non-zero", obj2.DisplayName)
+	}
+}
+
+func TestService_SetDefaultsStruct_defaultFrom_typeMismatchReportsError(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Count int
+		Label string `defaultFrom:"Count"`
+	}
+	obj := config{Count: 5}
+	s := newTestService(t, reflect.TypeOf(obj))
+	err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem())
+	if err == nil {
+		t.Fatalf("expected an error for a defaultFrom tag naming an unassignable sibling type")
+	}
+	var de *validation.DefaultsError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected a *validation.DefaultsError, got %T", err)
+	}
+}
+
+func TestService_SetDefaultsStruct_defaultIf_gatesDefaultFromToo(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Mode        string
+		Source      string
+		Destination string `defaultFrom:"Source" defaultIf:"Mode==copy"`
+	}
+
+	obj := config{Mode: "noop", Source: "hello"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Destination != "" {
+		t.Fatalf("Destination = %q, want unset since Mode != copy", obj.Destination)
+	}
+
+	obj2 := config{Mode: "copy", Source: "hello"}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.Destination != "hello" {
+		t.Fatalf("Destination = %q, want %q since Mode == copy", obj2.Destination, "hello")
+	}
+}
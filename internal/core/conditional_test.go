@@ -0,0 +1,97 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+	"github.com/ygrebnov/model/validation"
+)
+
+type signupForm struct {
+	Password        string
+	ConfirmPassword string `validate:"eqfield=Password"`
+	Type            string
+	Plan            string `validate:"required_if=Type paid"`
+}
+
+func TestService_ValidateStruct_crossFieldRule(t *testing.T) {
+	t.Parallel()
+
+	typ := reflect.TypeOf(signupForm{})
+	s := newTestService(t, typ)
+	if err := validation.RegisterCrossFieldRules(s.Registry()); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+	if err := validation.RegisterConditionalRules(s.Registry()); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	obj := signupForm{Password: "secret", ConfirmPassword: "secret"}
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected matching ConfirmPassword to pass eqfield, got %v", ve)
+	}
+
+	obj2 := signupForm{Password: "secret", ConfirmPassword: "other"}
+	ve2 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj2).Elem(), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve2.Empty() {
+		t.Fatalf("expected mismatched ConfirmPassword to fail eqfield")
+	}
+	fes := ve2.ForField("ConfirmPassword")
+	if len(fes) != 1 || !errors.Is(fes[0].Err, errorsPkg.ErrRuleCrossFieldMismatch) {
+		t.Fatalf("ForField(ConfirmPassword) = %v, want a single ErrRuleCrossFieldMismatch", fes)
+	}
+}
+
+func TestService_ValidateStruct_fieldLevelRule(t *testing.T) {
+	t.Parallel()
+
+	typ := reflect.TypeOf(signupForm{})
+	s := newTestService(t, typ)
+	if err := validation.RegisterConditionalRules(s.Registry()); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+	if err := validation.RegisterCrossFieldRules(s.Registry()); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	obj := signupForm{Type: "free"}
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected required_if to be satisfied when Type is not \"paid\", got %v", ve)
+	}
+
+	obj2 := signupForm{Type: "paid"}
+	ve2 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj2).Elem(), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve2.Empty() {
+		t.Fatalf("expected required_if to fail with Type=paid and Plan unset")
+	}
+	fes := ve2.ForField("Plan")
+	if len(fes) != 1 || !errors.Is(fes[0].Err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("ForField(Plan) = %v, want a single ErrRuleConditionallyRequired", fes)
+	}
+
+	obj3 := signupForm{Type: "paid", Plan: "pro"}
+	ve3 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj3).Elem(), "", ve3); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve3.Empty() {
+		t.Fatalf("expected required_if to be satisfied once Plan is set, got %v", ve3)
+	}
+}
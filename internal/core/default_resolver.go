@@ -0,0 +1,137 @@
+package core
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultResolverFunc resolves key (the part of a default-tag alternative
+// after its registered prefix) to a value. ok is false when key has no value
+// to contribute (e.g. an unset environment variable), which is not an error:
+// the alternative is simply skipped in favor of the next one in the chain.
+type DefaultResolverFunc func(key string) (value string, ok bool, err error)
+
+// Built-in default-tag resolver prefixes.
+const (
+	envResolverPrefix       = "$ENV:"
+	envAltResolverPrefix    = "env:"
+	fileResolverPrefix      = "file:"
+	nowResolverKeyword      = "now"
+	uuidResolverKeyword     = "uuid"
+	hostnameResolverKeyword = "hostname"
+)
+
+// builtinDefaultResolvers returns the resolver set every new Service starts
+// with: "$ENV:" and its "env:" alias (both os.Getenv), "file:" (file
+// contents, trimmed of surrounding whitespace), and three bare-keyword
+// sources that take no parameter — "now" (the current time, RFC3339), "uuid"
+// (a freshly generated v4 UUID), and "hostname" (os.Hostname()). Because
+// resolverFor matches by prefix, a bare-keyword entry here is registered
+// under its own full name, so `default:"now"` resolves it exactly the same
+// way `default:"$ENV:NAME"` resolves the "$ENV:" entry.
+func builtinDefaultResolvers() map[string]DefaultResolverFunc {
+	return map[string]DefaultResolverFunc{
+		envResolverPrefix:       envDefaultResolver,
+		envAltResolverPrefix:    envDefaultResolver,
+		fileResolverPrefix:      fileDefaultResolver,
+		nowResolverKeyword:      nowDefaultResolver,
+		uuidResolverKeyword:     uuidDefaultResolver,
+		hostnameResolverKeyword: hostnameDefaultResolver,
+	}
+}
+
+func envDefaultResolver(key string) (string, bool, error) {
+	v, ok := os.LookupEnv(key)
+	return v, ok, nil
+}
+
+func fileDefaultResolver(key string) (string, bool, error) {
+	data, err := os.ReadFile(key)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return strings.TrimSpace(string(data)), true, nil
+}
+
+// nowDefaultResolver ignores its key (the keyword takes no parameter) and
+// resolves to the current time formatted as RFC3339, for setLiteralDefault
+// to parse into a time.Time field.
+func nowDefaultResolver(string) (string, bool, error) {
+	return time.Now().Format(time.RFC3339), true, nil
+}
+
+// uuidDefaultResolver ignores its key and resolves to a freshly generated
+// v4 UUID, for string-typed ID fields.
+func uuidDefaultResolver(string) (string, bool, error) {
+	id, err := newUUIDv4()
+	if err != nil {
+		return "", false, err
+	}
+	return id, true, nil
+}
+
+// hostnameDefaultResolver ignores its key and resolves to os.Hostname().
+func hostnameDefaultResolver(string) (string, bool, error) {
+	h, err := os.Hostname()
+	if err != nil {
+		return "", false, err
+	}
+	return h, true, nil
+}
+
+// RegisterDefaultResolver registers fn as the resolver for default-tag
+// alternatives beginning with prefix, so a tag like
+// `default:"lookup:region|us-east-1"` calls fn("region") for its first
+// alternative. Registering a prefix that is already registered, including a
+// built-in one ("$ENV:", "file:"), replaces its resolver.
+func (s *Service) RegisterDefaultResolver(prefix string, fn func(key string) (string, bool, error)) {
+	s.resolversMu.Lock()
+	defer s.resolversMu.Unlock()
+	if s.resolvers == nil {
+		s.resolvers = make(map[string]DefaultResolverFunc)
+	}
+	s.resolvers[prefix] = fn
+}
+
+// resolverFor returns the resolver registered for the longest prefix of alt,
+// so that overlapping prefixes (e.g. "a:" and "ab:") resolve unambiguously.
+func (s *Service) resolverFor(alt string) (fn DefaultResolverFunc, prefix string, found bool) {
+	s.resolversMu.RLock()
+	defer s.resolversMu.RUnlock()
+	for p, f := range s.resolvers {
+		if strings.HasPrefix(alt, p) && len(p) > len(prefix) {
+			prefix, fn, found = p, f, true
+		}
+	}
+	return fn, prefix, found
+}
+
+// resolveDefaultTag evaluates tag's pipe-separated alternatives left to
+// right, returning the first one that resolves to a non-empty value. An
+// alternative with no registered prefix is used verbatim as a literal, which
+// always succeeds unless it is itself empty. ok is false when every
+// alternative resolves empty (or tag carries none at all), meaning the field
+// should be left at its zero value.
+func (s *Service) resolveDefaultTag(tag string) (resolved string, ok bool, err error) {
+	for _, alt := range strings.Split(tag, "|") {
+		if alt == "" {
+			continue
+		}
+		fn, prefix, found := s.resolverFor(alt)
+		if !found {
+			return alt, true, nil
+		}
+		v, resolvedOK, err := fn(strings.TrimPrefix(alt, prefix))
+		if err != nil {
+			return "", false, err
+		}
+		if resolvedOK && v != "" {
+			return v, true, nil
+		}
+	}
+	return "", false, nil
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestService_SetDefaultsStruct_orChain(t *testing.T) {
+	type config struct {
+		Port string `default:"$ENV:TEST_CHUNK11_3_PORT|8080"`
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Port != "8080" {
+		t.Fatalf("expected fallback literal 8080 when env var is unset, got %q", obj.Port)
+	}
+
+	t.Setenv("TEST_CHUNK11_3_PORT", "9090")
+	obj2 := config{}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.Port != "9090" {
+		t.Fatalf("expected env var value to win, got %q", obj2.Port)
+	}
+}
+
+func TestService_SetDefaultsStruct_fileResolver(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "region.txt")
+	if err := os.WriteFile(path, []byte("eu-west-1\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	// The resolved path is only known at runtime, so the struct type carrying
+	// it is built dynamically rather than declared with a literal tag.
+	typ := reflect.StructOf([]reflect.StructField{
+		{
+			Name: "Region",
+			Type: reflect.TypeOf(""),
+			Tag:  reflect.StructTag(`default:"file:` + path + `|us-east-1"`),
+		},
+	})
+	s2 := newTestService(t, typ)
+	rv := reflect.New(typ).Elem()
+	if err := s2.SetDefaultsStruct(rv); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if got := rv.Field(0).String(); got != "eu-west-1" {
+		t.Fatalf("expected file contents to win, got %q", got)
+	}
+
+	// Missing file falls through to the literal fallback.
+	typMissing := reflect.StructOf([]reflect.StructField{
+		{
+			Name: "Region",
+			Type: reflect.TypeOf(""),
+			Tag:  reflect.StructTag(`default:"file:` + filepath.Join(dir, "missing.txt") + `|us-east-1"`),
+		},
+	})
+	s3 := newTestService(t, typMissing)
+	rv3 := reflect.New(typMissing).Elem()
+	if err := s3.SetDefaultsStruct(rv3); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if got := rv3.Field(0).String(); got != "us-east-1" {
+		t.Fatalf("expected fallback literal when file is missing, got %q", got)
+	}
+}
+
+func TestService_SetDefaultsStruct_allAlternativesEmpty(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name string `default:"$ENV:TEST_CHUNK11_3_UNSET_VAR"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Name != "" {
+		t.Fatalf("expected field to stay zero when every alternative is empty, got %q", obj.Name)
+	}
+}
+
+func TestService_SetDefaultsStruct_envAlias(t *testing.T) {
+	type config struct {
+		Host string `default:"env:TEST_CHUNK13_1_HOST|localhost"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Host != "localhost" {
+		t.Fatalf("expected fallback literal when env var is unset, got %q", obj.Host)
+	}
+
+	t.Setenv("TEST_CHUNK13_1_HOST", "db.internal")
+	obj2 := config{}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.Host != "db.internal" {
+		t.Fatalf("expected env var value to win via the env: alias, got %q", obj2.Host)
+	}
+}
+
+func TestService_SetDefaultsStruct_now(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		CreatedAt time.Time `default:"now"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	before := time.Now().Add(-time.Second)
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.CreatedAt.Before(before) || obj.CreatedAt.After(time.Now().Add(time.Second)) {
+		t.Fatalf("expected CreatedAt to be set to roughly now, got %v", obj.CreatedAt)
+	}
+}
+
+func TestService_SetDefaultsStruct_uuid(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		ID string `default:"uuid"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if len(obj.ID) != 36 {
+		t.Fatalf("expected a 36-character UUID string, got %q", obj.ID)
+	}
+
+	obj2 := config{}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.ID == obj.ID {
+		t.Fatalf("expected each uuid default to be freshly generated, got the same value twice: %q", obj.ID)
+	}
+}
+
+func TestService_SetDefaultsStruct_hostname(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Host string `default:"hostname"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	wantHost, err := os.Hostname()
+	if err != nil {
+		t.Fatalf("os.Hostname: %v", err)
+	}
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Host != wantHost {
+		t.Fatalf("expected os.Hostname() value %q, got %q", wantHost, obj.Host)
+	}
+}
+
+func TestService_RegisterDefaultResolver(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Region string `default:"lookup:region"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	s.RegisterDefaultResolver("lookup:", func(key string) (string, bool, error) {
+		if key == "region" {
+			return "ap-south-1", true, nil
+		}
+		return "", false, nil
+	})
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Region != "ap-south-1" {
+		t.Fatalf("expected custom resolver value, got %q", obj.Region)
+	}
+}
+
+func TestService_RegisterDefaultResolver_propagatesError(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Region string `default:"lookup:region"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	wantErr := errors.New("lookup unavailable")
+	s.RegisterDefaultResolver("lookup:", func(key string) (string, bool, error) {
+		return "", false, wantErr
+	})
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err == nil {
+		t.Fatalf("expected SetDefaultsStruct to propagate the resolver error")
+	}
+}
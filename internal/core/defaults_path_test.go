@@ -0,0 +1,83 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_SetDefaultsStruct_errorPathAndNamePath(t *testing.T) {
+	t.Parallel()
+
+	// A bool field with a nonsense literal fails setLiteralDefault's parse
+	// deterministically, so its error path can be asserted exactly.
+	type tlsB struct {
+		Enabled bool `json:"enabled" default:"not-a-bool"`
+	}
+	type serverB struct {
+		TLS tlsB `json:"tls" default:"dive"`
+	}
+	type rootB struct {
+		Servers []serverB `json:"servers" defaultElem:"dive"`
+	}
+
+	obj := rootB{Servers: []serverB{{}, {}}}
+	obj.Servers[1].TLS.Enabled = false
+
+	s := newTestService(t, reflect.TypeOf(obj))
+	err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem())
+	if err == nil {
+		t.Fatalf("expected an error for the malformed bool default")
+	}
+
+	var de *validation.DefaultsError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected a *validation.DefaultsError, got %T", err)
+	}
+	if de.Len() != 2 {
+		t.Fatalf("expected one issue per malformed element, got %d: %v", de.Len(), de)
+	}
+
+	wantPath := "Servers[0].TLS.Enabled"
+	wantNamePath := "servers[0].tls.enabled"
+	issues := de.ForField(wantPath)
+	if len(issues) != 1 {
+		t.Fatalf("expected one issue at path %q, got %d", wantPath, len(issues))
+	}
+	if issues[0].NamePath != wantNamePath {
+		t.Fatalf("NamePath = %q, want %q", issues[0].NamePath, wantNamePath)
+	}
+}
+
+func TestService_SetDefaultsStruct_continuesAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Bad  bool   `default:"not-a-bool"`
+		Good string `default:"fallback"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem())
+	if err == nil {
+		t.Fatalf("expected an error for the malformed bool default")
+	}
+	if obj.Good != "fallback" {
+		t.Fatalf("expected the Good field to still receive its default, got %q", obj.Good)
+	}
+}
+
+func TestService_SetDefaultsStruct_noIssuesReturnsNil(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package core
+
+import "github.com/ygrebnov/model/validation"
+
+// elemPlan is a parsed validateElem rule list, supporting "dive" and
+// "keys,...,endkeys,..." grammar for descending into slices, arrays, and maps
+// at arbitrary nesting depth (e.g. "min(1),dive,required,dive,email" for
+// [][]string, or "dive,keys,min(3),endkeys,required" for map[string]string).
+type elemPlan struct {
+	pre   []validation.RuleNameParams // rules applied to the container itself, before any dive
+	dive  bool                        // whether this level descends into elements
+	keys  []validation.RuleNameParams // map only: rules applied to each key (the keys..endkeys segment)
+	rules []validation.RuleNameParams // rules applied to each element/value at this level
+	next  *elemPlan                   // plan for the next dive level, set for multi-level descent
+}
+
+// buildElemPlan turns an already-tokenized rule list (as produced by
+// validation.ParseTag) into an elemPlan tree. Rules preceding the first
+// "dive" token apply to the container itself; everything from "dive" onward
+// describes what happens to each element, with a nested "dive" starting a
+// further level of descent.
+func buildElemPlan(rules []validation.RuleNameParams) *elemPlan {
+	plan := &elemPlan{}
+
+	i := 0
+	for i < len(rules) && rules[i].Name != tagDive {
+		plan.pre = append(plan.pre, rules[i])
+		i++
+	}
+	if i == len(rules) {
+		return plan
+	}
+	plan.dive = true
+	rest := rules[i+1:]
+
+	if len(rest) > 0 && rest[0].Name == tagKeys {
+		j := 1
+		for j < len(rest) && rest[j].Name != tagEndKeys {
+			plan.keys = append(plan.keys, rest[j])
+			j++
+		}
+		if j < len(rest) {
+			rest = rest[j+1:] // skip past endkeys
+		} else {
+			rest = nil // malformed: keys without endkeys, treat remainder as consumed
+		}
+	}
+
+	nextDive := -1
+	for k, r := range rest {
+		if r.Name == tagDive {
+			nextDive = k
+			break
+		}
+	}
+	if nextDive == -1 {
+		plan.rules = rest
+		return plan
+	}
+	plan.rules = rest[:nextDive]
+	plan.next = buildElemPlan(rest[nextDive:])
+	return plan
+}
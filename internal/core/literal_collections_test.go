@@ -0,0 +1,104 @@
+package core
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestService_SetDefaultsStruct_sliceLiteral(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Tags    []string        `default:"[a,b,c]"`
+		Nums    []int           `default:"1,2,3"`
+		Waits   []time.Duration `default:"[1s,2s]"`
+		Escaped []string        `default:"[a\\,b,c]"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if got := obj.Tags; len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Fatalf("expected [a b c], got %v", got)
+	}
+	if got := obj.Nums; len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+	if got := obj.Waits; len(got) != 2 || got[0] != time.Second || got[1] != 2*time.Second {
+		t.Fatalf("expected [1s 2s], got %v", got)
+	}
+	if got := obj.Escaped; len(got) != 2 || got[0] != "a,b" || got[1] != "c" {
+		t.Fatalf("expected an escaped comma to stay inside its token, got %v", got)
+	}
+}
+
+func TestService_SetDefaultsStruct_mapLiteral(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Labels map[string]string `default:"{env:prod,region:eu-west-1}"`
+		Counts map[string]int    `default:"{a:1,b:2}"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Labels["env"] != "prod" || obj.Labels["region"] != "eu-west-1" {
+		t.Fatalf("expected map literal to populate both entries, got %v", obj.Labels)
+	}
+	if obj.Counts["a"] != 1 || obj.Counts["b"] != 2 {
+		t.Fatalf("expected int-valued map literal, got %v", obj.Counts)
+	}
+}
+
+func TestService_SetDefaultsStruct_urlAndIP(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Endpoint url.URL `default:"https://example.com/path"`
+		Host     net.IP  `default:"127.0.0.1"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Endpoint.Host != "example.com" || obj.Endpoint.Path != "/path" {
+		t.Fatalf("expected parsed url.URL, got %+v", obj.Endpoint)
+	}
+	if obj.Host.String() != "127.0.0.1" {
+		t.Fatalf("expected net.IP parsed via TextUnmarshaler, got %v", obj.Host)
+	}
+}
+
+func TestTokenizeList(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"[]", nil},
+		{"a,b,c", []string{"a", "b", "c"}},
+		{"[a,b,c]", []string{"a", "b", "c"}},
+		{`"a,b",c`, []string{"a,b", "c"}},
+		{`a\,b,c`, []string{"a,b", "c"}},
+	}
+	for _, tc := range cases {
+		got := tokenizeList(tc.in)
+		if len(got) != len(tc.want) {
+			t.Fatalf("tokenizeList(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("tokenizeList(%q) = %v, want %v", tc.in, got, tc.want)
+			}
+		}
+	}
+}
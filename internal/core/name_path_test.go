@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_ValidateStruct_namePath(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		Street string `json:"street" validate:"required"`
+	}
+	type person struct {
+		Name    string  `json:"full_name,omitempty" validate:"required"`
+		Address address `json:"address"`
+	}
+
+	obj := person{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+
+	if fe := ve.ForField("Name"); len(fe) != 1 || fe[0].NamePath != "full_name" {
+		t.Fatalf("expected Name's NamePath to be full_name, got %+v", fe)
+	}
+	if fe := ve.ForField("Address.Street"); len(fe) != 1 || fe[0].NamePath != "address.street" {
+		t.Fatalf("expected Address.Street's NamePath to be address.street, got %+v", fe)
+	}
+}
+
+func TestService_ValidateStruct_namePathFallsBackToGoName(t *testing.T) {
+	t.Parallel()
+
+	type sample struct {
+		Code string `validate:"required"` // no json tag
+	}
+
+	obj := sample{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if fe := ve.ForField("Code"); len(fe) != 1 || fe[0].NamePath != "Code" {
+		t.Fatalf("expected NamePath to fall back to the Go field name, got %+v", fe)
+	}
+}
+
+func TestService_ValidateStruct_namePathCustomTag(t *testing.T) {
+	t.Parallel()
+
+	type sample struct {
+		Code string `yaml:"code" validate:"required"`
+	}
+
+	obj := sample{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	s.SetNameTag("yaml")
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if fe := ve.ForField("Code"); len(fe) != 1 || fe[0].NamePath != "code" {
+		t.Fatalf("expected NamePath to use the configured yaml tag, got %+v", fe)
+	}
+}
+
+func TestService_ValidateStruct_namePathElementIndex(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Tags []string `json:"tags" validateElem:"dive,min(3)"`
+	}
+
+	obj := holder{Tags: []string{"ok", "no"}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Tags[1]")
+	if len(fe) != 1 || fe[0].NamePath != "tags[1]" {
+		t.Fatalf("expected Tags[1]'s NamePath to be tags[1], got %+v", fe)
+	}
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_ValidateStruct_orGroupPassesOnFirstAlternative(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Contact string `validate:"email|url"`
+	}
+	obj := holder{Contact: "ok-as-email"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "email", func(v string, _ ...string) error {
+		return nil // first alternative passes
+	})
+	mustAddRule[string](t, s, "url", func(v string, _ ...string) error {
+		t.Fatalf("url should not run once email already passed")
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected no failures, got %v", ve)
+	}
+}
+
+func TestService_ValidateStruct_orGroupPassesOnLaterAlternative(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Contact string `validate:"email|url"`
+	}
+	obj := holder{Contact: "http://example.com"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "email", func(v string, _ ...string) error {
+		return errNotAlphanum
+	})
+	mustAddRule[string](t, s, "url", func(v string, _ ...string) error {
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected no failures once url passes, got %v", ve)
+	}
+}
+
+func TestService_ValidateStruct_orGroupAllFailAggregatesErrors(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Contact string `validate:"email|url"`
+	}
+	obj := holder{Contact: "neither"}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "email", func(v string, _ ...string) error {
+		return errNotAlphanum
+	})
+	mustAddRule[string](t, s, "url", func(v string, _ ...string) error {
+		return errTooShort
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Contact")
+	if len(fe) != 1 {
+		t.Fatalf("expected a single aggregated failure for the OR-group, got %v", ve)
+	}
+	if fe[0].Rule != "email|url" {
+		t.Fatalf("expected Rule to be the pipe-joined alternative names, got %q", fe[0].Rule)
+	}
+	if !errors.Is(fe[0].Err, errNotAlphanum) || !errors.Is(fe[0].Err, errTooShort) {
+		t.Fatalf("expected Err to join both alternatives' failures, got %v", fe[0].Err)
+	}
+}
+
+func TestService_ValidateStruct_orGroupOnElemTag(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Tags []string `validateElem:"dive,alphanum|numeric"`
+	}
+	obj := holder{Tags: []string{"abc", "123", "!!!"}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "alphanum", func(v string, _ ...string) error {
+		for _, r := range v {
+			if !(r >= 'a' && r <= 'z') {
+				return errNotAlphanum
+			}
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "numeric", func(v string, _ ...string) error {
+		for _, r := range v {
+			if !(r >= '0' && r <= '9') {
+				return errNotAlphanum
+			}
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Tags[0]")) != 0 || len(ve.ForField("Tags[1]")) != 0 {
+		t.Fatalf("expected Tags[0] and Tags[1] to each satisfy one alternative, got %v", ve)
+	}
+	fe := ve.ForField("Tags[2]")
+	if len(fe) != 1 || fe[0].Rule != "alphanum|numeric" {
+		t.Fatalf("expected Tags[2] to fail both alternatives, got %+v", fe)
+	}
+}
+
+func TestService_ValidateStruct_orGroupParsedRulesAreCached(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Contact string `validate:"email|url"`
+	}
+	s := newTestService(t, reflect.TypeOf(holder{}))
+	mustAddRule[string](t, s, "email", func(v string, _ ...string) error { return nil })
+	mustAddRule[string](t, s, "url", func(v string, _ ...string) error { return nil })
+
+	typ := reflect.TypeOf(holder{})
+	if _, exists := s.rulesMapping.Get(typ, 0, tagValidate); exists {
+		t.Fatalf("did not expect a cache entry before the first validation pass")
+	}
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(holder{Contact: "x"}), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+
+	rules, exists := s.rulesMapping.Get(typ, 0, tagValidate)
+	if !exists || len(rules) != 1 || len(rules[0].Alts) != 2 {
+		t.Fatalf("expected the OR-group to be cached in rulesMapping, got %+v, exists=%v", rules, exists)
+	}
+}
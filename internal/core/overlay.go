@@ -0,0 +1,47 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// SetDefaultsFromOverlay decodes an overlay document from overlay in the
+// given format into rv (respecting json tags via encoding/json), then runs
+// SetDefaultsStruct so `default`/`defaultElem` tags only fill whatever fields
+// are still zero after the overlay is applied. This gives callers a layered
+// config story — code defaults (the struct's zero value) ← file overlay ←
+// runtime tag defaults — without writing ad-hoc merge logic themselves, and
+// it composes with SetDefaultsStruct's existing dive/alloc/defaultElem
+// semantics for nested structs and collections.
+//
+// Only "json" is implemented; "yaml"/"yml" report a clear unsupported-format
+// error rather than a half-working conversion, since this module has no YAML
+// parser dependency to convert through.
+func (s *Service) SetDefaultsFromOverlay(rv reflect.Value, overlay io.Reader, format string) error {
+	data, err := io.ReadAll(overlay)
+	if err != nil {
+		return fmt.Errorf("core: SetDefaultsFromOverlay: read: %w", err)
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+	case "yaml", "yml":
+		return fmt.Errorf("core: SetDefaultsFromOverlay: format %q is not yet supported, use \"json\"", format)
+	default:
+		return fmt.Errorf("core: SetDefaultsFromOverlay: unknown format %q", format)
+	}
+
+	if !rv.CanAddr() {
+		return fmt.Errorf("core: SetDefaultsFromOverlay: rv must be addressable")
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, rv.Addr().Interface()); err != nil {
+			return fmt.Errorf("core: SetDefaultsFromOverlay: decode: %w", err)
+		}
+	}
+
+	return s.SetDefaultsStruct(rv)
+}
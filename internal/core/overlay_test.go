@@ -0,0 +1,88 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestService_SetDefaultsFromOverlay_jsonThenTagDefaults(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Host string `json:"host" default:"localhost"`
+		Port string `json:"port" default:"8080"`
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	overlay := strings.NewReader(`{"host":"example.com"}`)
+	if err := s.SetDefaultsFromOverlay(reflect.ValueOf(&obj).Elem(), overlay, "json"); err != nil {
+		t.Fatalf("SetDefaultsFromOverlay: %v", err)
+	}
+	if obj.Host != "example.com" {
+		t.Fatalf("expected overlay value to win over the tag default, got %q", obj.Host)
+	}
+	if obj.Port != "8080" {
+		t.Fatalf("expected tag default to fill the field the overlay left zero, got %q", obj.Port)
+	}
+}
+
+func TestService_SetDefaultsFromOverlay_emptyOverlay(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsFromOverlay(reflect.ValueOf(&obj).Elem(), strings.NewReader(""), "json"); err != nil {
+		t.Fatalf("SetDefaultsFromOverlay: %v", err)
+	}
+	if obj.Port != "8080" {
+		t.Fatalf("expected tag default with no overlay content, got %q", obj.Port)
+	}
+}
+
+func TestService_SetDefaultsFromOverlay_yamlNotYetSupported(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	err := s.SetDefaultsFromOverlay(reflect.ValueOf(&obj).Elem(), strings.NewReader("port: 9090"), "yaml")
+	if err == nil {
+		t.Fatalf("expected an unsupported-format error for yaml")
+	}
+	if !strings.Contains(err.Error(), "not yet supported") {
+		t.Fatalf("expected a clear not-yet-supported message, got %q", err)
+	}
+}
+
+func TestService_SetDefaultsFromOverlay_unknownFormat(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsFromOverlay(reflect.ValueOf(&obj).Elem(), strings.NewReader("{}"), "toml"); err == nil {
+		t.Fatalf("expected an unknown-format error for toml")
+	}
+}
+
+func TestService_SetDefaultsFromOverlay_malformedJSON(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsFromOverlay(reflect.ValueOf(&obj).Elem(), strings.NewReader("{not json"), "json"); err == nil {
+		t.Fatalf("expected a decode error for malformed JSON")
+	}
+}
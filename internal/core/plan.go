@@ -0,0 +1,177 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldPlan precomputes, for one exported field of a struct type, the
+// reflection metadata that ValidateStruct and SetDefaultsStruct need on every
+// call: the field index, its name, its alternate (tag-based) name, whether it
+// recurses (pointer-to-struct or embedded struct), and its raw
+// default/defaultElem tag values. Building this once per reflect.Type avoids
+// re-reading field.PkgPath, field.Tag.Get, and re-branching on
+// field.Type.Kind() on every traversal.
+type fieldPlan struct {
+	index           int
+	name            string
+	altName         string // name under the Service's configured name tag (see SetNameTag), for FieldError.NamePath
+	recursePtr      bool   // field is a pointer to struct: recurse via Elem() when non-nil
+	recurseEmbed    bool   // field is itself a struct: recurse directly
+	defaultTag      string
+	defaultElemTag  string
+	hasValidate     bool
+	hasValidateElem bool
+
+	// literalPlan is the pre-parsed form of defaultTag, populated only when
+	// defaultTag is a single (no "|") literal, starting with none of the
+	// built-in resolver prefixes, against a fast-path scalar kind — see
+	// preparseScalarLiteral. nil means the tag must still go
+	// through resolveDefaultTag/setLiteralDefault on every call, either
+	// because it isn't a plain scalar literal or because it failed to
+	// preparse (in which case the per-call path reports the same error it
+	// always did). applyDefaultTag only takes this fast path when
+	// resolveDefaultTag returns defaultTag unchanged, so an instance-specific
+	// resolver registered after the plan was cached (plans are shared across
+	// every Service for a given type) is never shadowed by a stale value.
+	literalPlan *preparsedLiteral
+
+	// defaultIf gates this field's default and defaultFrom tags behind a
+	// predicate evaluated against a sibling field, resolved by Go field name
+	// once here rather than on every SetDefaultsStruct call; nil means no
+	// defaultIf tag. See conditional_defaults.go.
+	defaultIf *defaultIfPredicate
+	// defaultFrom copies a sibling field's value into this field when it is
+	// zero, resolved the same way as defaultIf; nil means no defaultFrom tag.
+	defaultFrom *defaultFromPlan
+
+	// defaultRoles and validateRoles gate this field's default/defaultElem
+	// and validate/validateElem tags behind the active role set a Service
+	// was scoped to via WithActiveRoles; nil means "always applies". See
+	// rolePredicate.
+	defaultRoles  *rolePredicate
+	validateRoles *rolePredicate
+}
+
+// structPlan is the precomputed, per-type field plan shared by ValidateStruct
+// and SetDefaultsStruct.
+type structPlan struct {
+	fields []fieldPlan
+}
+
+// planKey distinguishes cached plans by both struct type and the configured
+// name tag, since altName depends on it (see Service.SetNameTag).
+type planKey struct {
+	typ     reflect.Type
+	nameTag string
+}
+
+// planCache caches structPlan by (reflect.Type, name tag) across all Services
+// in the process, since the plan depends only on the struct's shape, the
+// fixed tag names in tags.go, and the configured name tag — not on any
+// particular Service's registry or mapping.
+var planCache sync.Map // map[planKey]*structPlan
+
+// planFor returns the cached structPlan for typ under nameTag, building it on
+// first use. Safe for concurrent use.
+func planFor(typ reflect.Type, nameTag string) *structPlan {
+	key := planKey{typ: typ, nameTag: nameTag}
+	if v, ok := planCache.Load(key); ok {
+		return v.(*structPlan)
+	}
+	plan := buildPlan(typ, nameTag)
+	actual, _ := planCache.LoadOrStore(key, plan)
+	return actual.(*structPlan)
+}
+
+// buildPlan walks typ's fields once, recording plan data for every exported
+// field and skipping unexported ones entirely. A first pass over the fields
+// builds a name->index map so defaultIf/defaultFrom tags can reference a
+// sibling declared later in the struct.
+func buildPlan(typ reflect.Type, nameTag string) *structPlan {
+	plan := &structPlan{}
+	nameToIndex := make(map[string]int, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		nameToIndex[field.Name] = i
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		fp := fieldPlan{
+			index:          i,
+			name:           field.Name,
+			altName:        resolveNameTag(field, nameTag),
+			defaultTag:     field.Tag.Get(tagDefault),
+			defaultElemTag: field.Tag.Get(tagDefaultElem),
+			defaultRoles:   parseRolePredicate(field.Tag.Get(tagDefaultRole)),
+			validateRoles:  parseRolePredicate(field.Tag.Get(tagValidateRole)),
+		}
+		if raw := field.Tag.Get(tagValidate); raw != "" && raw != "-" {
+			fp.hasValidate = true
+		}
+		if raw := field.Tag.Get(tagValidateElem); raw != "" && raw != "-" {
+			fp.hasValidateElem = true
+		}
+		if raw := field.Tag.Get(tagDefaultIf); raw != "" {
+			fp.defaultIf = buildDefaultIfPredicate(raw, nameToIndex)
+		}
+		if raw := field.Tag.Get(tagDefaultFrom); raw != "" {
+			fp.defaultFrom = buildDefaultFromPlan(raw, nameToIndex)
+		}
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+			fp.recursePtr = true
+		}
+		if ft.Kind() == reflect.Struct {
+			fp.recurseEmbed = true
+		}
+		builtinResolved := false
+		for prefix := range builtinDefaultResolvers() {
+			if strings.HasPrefix(fp.defaultTag, prefix) {
+				builtinResolved = true
+				break
+			}
+		}
+		if fp.defaultTag != "" && fp.defaultTag != "-" &&
+			fp.defaultTag != tagDive && fp.defaultTag != tagAlloc &&
+			!strings.Contains(fp.defaultTag, "|") && !builtinResolved {
+			literalType := ft
+			if literalType.Kind() == reflect.Ptr {
+				literalType = literalType.Elem()
+			}
+			if pl, ok := preparseScalarLiteral(literalType, fp.defaultTag); ok {
+				fp.literalPlan = &pl
+			}
+		}
+		plan.fields = append(plan.fields, fp)
+	}
+	return plan
+}
+
+// resolveNameTag returns the name to use for field in FieldError.NamePath:
+// the value of the nameTag struct tag (stripped of ",omitempty"-style options
+// after the first comma) when present and not "-"; otherwise the Go field
+// name.
+func resolveNameTag(field reflect.StructField, nameTag string) string {
+	if nameTag == "" {
+		return field.Name
+	}
+	raw, ok := field.Tag.Lookup(nameTag)
+	if !ok || raw == "-" {
+		return field.Name
+	}
+	if idx := strings.IndexByte(raw, ','); idx != -1 {
+		raw = raw[:idx]
+	}
+	if raw == "" {
+		return field.Name
+	}
+	return raw
+}
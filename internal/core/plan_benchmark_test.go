@@ -0,0 +1,140 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// benchPlanStruct exercises the planFor cache on a struct with roughly 20
+// fields, the shape where the per-call reflect.StructField fetch, Tag.Get,
+// and Kind branching that planFor now does once per type (instead of once
+// per field per SetDefaultsStruct/ValidateStruct call) adds up the most.
+type benchPlanStruct struct {
+	F1  string `validate:"min(1)" default:"a"`
+	F2  string `validate:"min(1)" default:"b"`
+	F3  string `validate:"min(1)" default:"c"`
+	F4  string `validate:"min(1)" default:"d"`
+	F5  string `validate:"min(1)" default:"e"`
+	F6  string `validate:"min(1)" default:"f"`
+	F7  string `validate:"min(1)" default:"g"`
+	F8  string `validate:"min(1)" default:"h"`
+	F9  string `validate:"min(1)" default:"i"`
+	F10 string `validate:"min(1)" default:"j"`
+	F11 string `validate:"min(1)" default:"k"`
+	F12 string `validate:"min(1)" default:"l"`
+	F13 string `validate:"min(1)" default:"m"`
+	F14 string `validate:"min(1)" default:"n"`
+	F15 string `validate:"min(1)" default:"o"`
+	F16 string `validate:"min(1)" default:"p"`
+	F17 string `validate:"min(1)" default:"q"`
+	F18 string `validate:"min(1)" default:"r"`
+	F19 string `validate:"min(1)" default:"s"`
+	F20 string `validate:"min(1)" default:"t"`
+}
+
+func newBenchPlanService(b *testing.B) *Service {
+	b.Helper()
+	reg := validation.NewRulesRegistry()
+	mapping := validation.NewMapping()
+	s, err := NewService(reflect.TypeOf(benchPlanStruct{}), reg, mapping)
+	if err != nil {
+		b.Fatalf("NewService error: %v", err)
+	}
+	r, err := validation.NewRule[string]("min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 1 {
+			return errTooShort
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatalf("NewRule(%q) error: %v", "min", err)
+	}
+	if err := s.AddRule(r); err != nil {
+		b.Fatalf("AddRule(%q) error: %v", "min", err)
+	}
+	return s
+}
+
+// BenchmarkValidateStruct_Plan measures repeatedly validating the same
+// 20-field struct value, which is where planFor's cached field plan (index,
+// recursion flags) pays off since every call would otherwise re-derive them
+// from reflect.StructField.
+func BenchmarkValidateStruct_Plan(b *testing.B) {
+	s := newBenchPlanService(b)
+	obj := benchPlanStruct{
+		F1: "a", F2: "b", F3: "c", F4: "d", F5: "e",
+		F6: "f", F7: "g", F8: "h", F9: "i", F10: "j",
+		F11: "k", F12: "l", F13: "m", F14: "n", F15: "o",
+		F16: "p", F17: "q", F18: "r", F19: "s", F20: "t",
+	}
+	rv := reflect.ValueOf(obj)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ve := &validation.Error{}
+		if err := s.ValidateStruct(context.Background(), rv, "", ve); err != nil {
+			b.Fatalf("ValidateStruct: %v", err)
+		}
+	}
+}
+
+// BenchmarkSetDefaultsStruct_Plan measures repeatedly applying defaults to a
+// fresh 20-field struct value, exercising planFor's cached default/defaultElem
+// tag strings in place of per-call field.Tag.Get.
+func BenchmarkSetDefaultsStruct_Plan(b *testing.B) {
+	s := newBenchPlanService(b)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var obj benchPlanStruct
+		if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+			b.Fatalf("SetDefaultsStruct: %v", err)
+		}
+	}
+}
+
+// BenchmarkSetDefaultsStruct_PreparsedLiteral measures the same workload as
+// BenchmarkSetDefaultsStruct_Plan but against a struct whose default tags are
+// all plain scalar literals, so every field takes fieldPlan.literalPlan's
+// fast path (preparsed once in buildPlan) instead of reparsing its tag string
+// through setLiteralDefault/setScalarLiteral on every call.
+func BenchmarkSetDefaultsStruct_PreparsedLiteral(b *testing.B) {
+	type benchLiteralStruct struct {
+		F1  int           `default:"1"`
+		F2  int           `default:"2"`
+		F3  int           `default:"3"`
+		F4  int           `default:"4"`
+		F5  uint          `default:"5"`
+		F6  uint          `default:"6"`
+		F7  float64       `default:"7.5"`
+		F8  float64       `default:"8.5"`
+		F9  bool          `default:"true"`
+		F10 bool          `default:"false"`
+		F11 string        `default:"a"`
+		F12 string        `default:"b"`
+		F13 time.Duration `default:"1s"`
+		F14 time.Duration `default:"2s"`
+	}
+
+	reg := validation.NewRulesRegistry()
+	mapping := validation.NewMapping()
+	s, err := NewService(reflect.TypeOf(benchLiteralStruct{}), reg, mapping)
+	if err != nil {
+		b.Fatalf("NewService error: %v", err)
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var obj benchLiteralStruct
+		if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+			b.Fatalf("SetDefaultsStruct: %v", err)
+		}
+	}
+}
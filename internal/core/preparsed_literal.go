@@ -0,0 +1,119 @@
+package core
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// literalKind distinguishes which field of preparsedLiteral to read. It is
+// kept separate from reflect.Kind because time.Duration and a plain integer
+// share the same reflect.Kind (Int64) but need different formatting.
+type literalKind int
+
+const (
+	literalString literalKind = iota
+	literalBool
+	literalInt
+	literalUint
+	literalFloat
+	literalDuration
+)
+
+// preparsedLiteral holds a default literal's value already parsed into its
+// field's scalar Go type, computed once in buildPlan rather than on every
+// SetDefaultsStruct call. It is only populated for the fixed scalar kinds
+// setScalarLiteral already handles (string, bool, integer, float,
+// time.Duration); collections, time.Time, and types with custom
+// TextUnmarshaler/json.Unmarshaler/url.URL parsing are left to the general
+// setLiteralDefault path, since those either need a fresh reflect.Value per
+// call (slices/maps) or carry parsing logic not worth duplicating here.
+type preparsedLiteral struct {
+	lk literalKind
+	s  string
+	b  bool
+	i  int64
+	u  uint64
+	f  float64
+	d  time.Duration
+}
+
+// preparseScalarLiteral attempts to parse tag ahead of time against
+// fieldType (a pointer type's element, already unwrapped by the caller). ok
+// is false for any type outside the fast-path set described on
+// preparsedLiteral, or when tag fails to parse — in both cases the caller
+// falls back to the general, per-call setLiteralDefault/setScalarLiteral
+// path.
+func preparseScalarLiteral(fieldType reflect.Type, tag string) (preparsedLiteral, bool) {
+	// A named scalar type can still implement encoding.TextUnmarshaler or
+	// json.Unmarshaler with parsing semantics unrelated to its underlying
+	// kind (an enum parsed from a label, say); defer those to the general
+	// path, which already prefers those interfaces over the raw kind switch.
+	ptrType := reflect.PointerTo(fieldType)
+	if ptrType.Implements(textUnmarshalerType) || ptrType.Implements(jsonUnmarshalerType) {
+		return preparsedLiteral{}, false
+	}
+
+	if fieldType == durationType {
+		d, err := time.ParseDuration(tag)
+		if err != nil {
+			return preparsedLiteral{}, false
+		}
+		return preparsedLiteral{lk: literalDuration, d: d}, true
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return preparsedLiteral{lk: literalString, s: tag}, true
+	case reflect.Bool:
+		switch strings.ToLower(tag) {
+		case "1", "true", "t", "yes", "y", "on":
+			return preparsedLiteral{lk: literalBool, b: true}, true
+		case "0", "false", "f", "no", "n", "off":
+			return preparsedLiteral{lk: literalBool, b: false}, true
+		default:
+			return preparsedLiteral{}, false
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv, err := strconv.ParseInt(strings.TrimSpace(tag), 10, 64)
+		if err != nil {
+			return preparsedLiteral{}, false
+		}
+		return preparsedLiteral{lk: literalInt, i: iv}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		uv, err := strconv.ParseUint(strings.TrimSpace(tag), 10, 64)
+		if err != nil {
+			return preparsedLiteral{}, false
+		}
+		return preparsedLiteral{lk: literalUint, u: uv}, true
+	case reflect.Float32, reflect.Float64:
+		fv, err := strconv.ParseFloat(strings.TrimSpace(tag), 64)
+		if err != nil {
+			return preparsedLiteral{}, false
+		}
+		return preparsedLiteral{lk: literalFloat, f: fv}, true
+	default:
+		return preparsedLiteral{}, false
+	}
+}
+
+// apply sets target (assumed already zero and settable, with any pointer
+// indirection already resolved by the caller) to pl's value with no string
+// reparsing.
+func (pl preparsedLiteral) apply(target reflect.Value) {
+	switch pl.lk {
+	case literalString:
+		target.SetString(pl.s)
+	case literalBool:
+		target.SetBool(pl.b)
+	case literalInt:
+		target.SetInt(pl.i)
+	case literalUint:
+		target.SetUint(pl.u)
+	case literalFloat:
+		target.SetFloat(pl.f)
+	case literalDuration:
+		target.SetInt(int64(pl.d))
+	}
+}
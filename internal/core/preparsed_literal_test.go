@@ -0,0 +1,197 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestPreparseScalarLiteral_plansScalarKinds asserts buildPlan populates
+// literalPlan for every fast-path scalar kind, preparsed from the field's
+// own default tag.
+func TestPreparseScalarLiteral_plansScalarKinds(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name    string        `default:"widget"`
+		Count   int           `default:"3"`
+		Scale   uint          `default:"7"`
+		Ratio   float64       `default:"1.5"`
+		Enabled bool          `default:"true"`
+		Wait    time.Duration `default:"2s"`
+	}
+
+	plan := buildPlan(reflect.TypeOf(config{}), defaultNameTag)
+	want := map[string]bool{
+		"Name": true, "Count": true, "Scale": true,
+		"Ratio": true, "Enabled": true, "Wait": true,
+	}
+	for _, fp := range plan.fields {
+		if !want[fp.name] {
+			continue
+		}
+		if fp.literalPlan == nil {
+			t.Fatalf("field %s: expected a preparsed literal plan, got nil", fp.name)
+		}
+	}
+}
+
+// TestPreparseScalarLiteral_skipsIneligibleTags asserts buildPlan leaves
+// literalPlan nil for tags that must still be resolved per call: "dive",
+// "alloc", pipe-chains, and resolver-prefixed tags.
+func TestPreparseScalarLiteral_skipsIneligibleTags(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Name string `default:"x"`
+	}
+	type config struct {
+		Sub     nested            `default:"dive"`
+		Tags    []string          `default:"alloc"`
+		Port    string            `default:"$ENV:CHUNK13_3_PORT|8080"`
+		Host    string            `default:"env:CHUNK13_3_HOST"`
+		Started string            `default:"now"`
+		ID      string            `default:"uuid"`
+		Machine string            `default:"hostname"`
+		Labels  map[string]string `default:"{a:1}"`
+	}
+
+	plan := buildPlan(reflect.TypeOf(config{}), defaultNameTag)
+	for _, fp := range plan.fields {
+		if fp.literalPlan != nil {
+			t.Fatalf("field %s: expected no preparsed literal plan, got %+v", fp.name, *fp.literalPlan)
+		}
+	}
+}
+
+// TestService_SetDefaultsStruct_preparsedLiteralMatchesGeneralPath asserts
+// the fast path driven by fieldPlan.literalPlan produces the exact same
+// result as the general setLiteralDefault path would, across every fast-path
+// kind.
+func TestService_SetDefaultsStruct_preparsedLiteralMatchesGeneralPath(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name    string        `default:"widget"`
+		Count   int           `default:"-3"`
+		Scale   uint          `default:"7"`
+		Ratio   float64       `default:"1.5"`
+		Enabled bool          `default:"yes"`
+		Wait    time.Duration `default:"250ms"`
+		PtrName *string       `default:"ptr"`
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+
+	if obj.Name != "widget" {
+		t.Fatalf("Name = %q, want %q", obj.Name, "widget")
+	}
+	if obj.Count != -3 {
+		t.Fatalf("Count = %d, want -3", obj.Count)
+	}
+	if obj.Scale != 7 {
+		t.Fatalf("Scale = %d, want 7", obj.Scale)
+	}
+	if obj.Ratio != 1.5 {
+		t.Fatalf("Ratio = %v, want 1.5", obj.Ratio)
+	}
+	if !obj.Enabled {
+		t.Fatalf("Enabled = false, want true")
+	}
+	if obj.Wait != 250*time.Millisecond {
+		t.Fatalf("Wait = %v, want 250ms", obj.Wait)
+	}
+	if obj.PtrName == nil || *obj.PtrName != "ptr" {
+		t.Fatalf("PtrName = %v, want pointer to %q", obj.PtrName, "ptr")
+	}
+}
+
+// TestService_SetDefaultsStruct_customResolverOverridesPreparsedLiteral
+// guards the correctness condition the fast path relies on: a plain literal
+// tag is still eligible for preparsing at plan-build time (since plans are
+// cached globally per type, independent of any one Service's resolvers), but
+// a Service that later registers a resolver whose prefix happens to match
+// that literal must still see its resolver's value, not the stale
+// preparsed one, since applyDefaultTag only takes the fast path when
+// resolveDefaultTag returns the tag unchanged.
+func TestService_SetDefaultsStruct_customResolverOverridesPreparsedLiteral(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Code string `default:"42"`
+	}
+
+	// Warm the shared plan cache with the plain-literal (preparsable) form
+	// first, the same way any earlier Service for this type would have.
+	plan := buildPlan(reflect.TypeOf(config{}), defaultNameTag)
+	found := false
+	for _, fp := range plan.fields {
+		if fp.name == "Code" {
+			found = true
+			if fp.literalPlan == nil {
+				t.Fatalf("expected Code's tag to be preparsed as a plain literal")
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected a Code field in the plan")
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	s.RegisterDefaultResolver("4", func(key string) (string, bool, error) {
+		return "override-" + key, true, nil
+	})
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Code != "override-2" {
+		t.Fatalf("Code = %q, want %q (the registered resolver must win over the preparsed literal)", obj.Code, "override-2")
+	}
+}
+
+// TestPreparseScalarLiteral_deferToTextUnmarshaler asserts a named scalar
+// type implementing encoding.TextUnmarshaler is never preparsed, since its
+// UnmarshalText may not agree with its underlying kind's literal syntax.
+func TestPreparseScalarLiteral_deferToTextUnmarshaler(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Level textLevel `default:"high"`
+	}
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Level != textLevelHigh {
+		t.Fatalf("Level = %v, want %v", obj.Level, textLevelHigh)
+	}
+
+	plan := buildPlan(reflect.TypeOf(config{}), defaultNameTag)
+	for _, fp := range plan.fields {
+		if fp.name == "Level" && fp.literalPlan != nil {
+			t.Fatalf("expected Level's tag not to be preparsed, since textLevel implements encoding.TextUnmarshaler")
+		}
+	}
+}
+
+type textLevel int
+
+const (
+	textLevelLow textLevel = iota
+	textLevelHigh
+)
+
+func (l *textLevel) UnmarshalText(b []byte) error {
+	if string(b) == "high" {
+		*l = textLevelHigh
+	} else {
+		*l = textLevelLow
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"reflect"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// resolvedFieldRuleKey identifies one (struct field, rule name) pair whose
+// rule overload resolvedFieldRule has already resolved and cached. It is
+// keyed per field — not merely per reflect.Type — because distinct Service
+// instances validating the same struct type may carry different registered
+// overloads for the same rule name (see rulesRegistry), so a cache shared
+// process-wide and keyed only by type would silently leak one Service's
+// resolved Rule into another's validation of the same field.
+type resolvedFieldRuleKey struct {
+	parent reflect.Type
+	index  int
+	name   string
+}
+
+// resolvedFieldRule returns the validation.Rule that applies name to field
+// fieldIndex of structType, resolving it via rulesRegistry.Get and caching
+// the result on s for subsequent calls against the same field.
+//
+// Caching is safe only when the field's static type fully determines which
+// overload applies: rulesRegistry.Get dispatches purely on the value's
+// reflect.Type (see typeDistance), so for a field whose declared type is not
+// reflect.Interface and has no RegisterTypeFunc extractor registered, the
+// resolved overload is identical on every call. An interface-typed field's
+// dynamic type can vary between values, and an extracted field's effective
+// type is whatever its extractor returns, so both bypass the cache and
+// resolve against v directly, exactly as before this cache existed.
+func (s *Service) resolvedFieldRule(structType reflect.Type, fieldIndex int, name string, v reflect.Value) (validation.Rule, error) {
+	fieldType := structType.Field(fieldIndex).Type
+	if fieldType.Kind() == reflect.Interface {
+		return s.rulesRegistry.Get(name, v)
+	}
+	if _, extracted := s.typeFuncFor(fieldType); extracted {
+		return s.rulesRegistry.Get(name, v)
+	}
+
+	key := resolvedFieldRuleKey{parent: structType, index: fieldIndex, name: name}
+	if cached, ok := s.resolvedFieldRules.Load(key); ok {
+		return cached.(validation.Rule), nil
+	}
+
+	r, err := s.rulesRegistry.Get(name, v)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := s.resolvedFieldRules.LoadOrStore(key, r)
+	return actual.(validation.Rule), nil
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// countingRegistry wraps a validation.RulesRegistry, counting Get calls so
+// a test can assert a field's rule overload was resolved once and then
+// served from resolvedFieldRule's cache on subsequent ValidateStruct calls.
+type countingRegistry struct {
+	validation.RulesRegistry
+	getCalls int
+}
+
+func (c *countingRegistry) Get(name string, v reflect.Value) (validation.Rule, error) {
+	c.getCalls++
+	return c.RulesRegistry.Get(name, v)
+}
+
+func TestService_ValidateStruct_cachesConcreteFieldRuleOverload(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Name string `validate:"min(3)"`
+	}
+	typ := reflect.TypeOf(holder{})
+	reg := &countingRegistry{RulesRegistry: validation.NewRulesRegistry()}
+	s, err := NewService(typ, reg, validation.NewMapping())
+	if err != nil {
+		t.Fatalf("NewService error: %v", err)
+	}
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	for i := 0; i < 3; i++ {
+		ve := &validation.Error{}
+		obj := holder{Name: "ab"}
+		if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+			t.Fatalf("ValidateStruct: %v", err)
+		}
+		if len(ve.ForField("Name")) != 1 {
+			t.Fatalf("iteration %d: expected min(3) to fail, got %v", i, ve)
+		}
+	}
+	if reg.getCalls != 1 {
+		t.Fatalf("expected rulesRegistry.Get to be called once across repeated ValidateStruct calls, got %d", reg.getCalls)
+	}
+}
+
+func TestService_ValidateStruct_interfaceFieldBypassesCache(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Value any `validate:"required"`
+	}
+	typ := reflect.TypeOf(holder{})
+	reg := &countingRegistry{RulesRegistry: validation.NewRulesRegistry()}
+	s, err := NewService(typ, reg, validation.NewMapping())
+	if err != nil {
+		t.Fatalf("NewService error: %v", err)
+	}
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+	mustAddRule[int](t, s, "required", func(v int, _ ...string) error {
+		if v == 0 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve1 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(holder{Value: "ok"}), "", ve1); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve1.Empty() {
+		t.Fatalf("expected string value to pass required, got %v", ve1)
+	}
+
+	ve2 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(holder{Value: 0}), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve2.ForField("Value")) != 1 {
+		t.Fatalf("expected int value 0 to fail required, got %v", ve2)
+	}
+	if reg.getCalls != 2 {
+		t.Fatalf("expected an interface-typed field to resolve its overload dynamically every call, got %d Get calls", reg.getCalls)
+	}
+}
+
+func TestService_ValidateStruct_extractedFieldBypassesCache(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code wrappedString `validate:"min(3)"`
+	}
+	typ := reflect.TypeOf(holder{})
+	reg := &countingRegistry{RulesRegistry: validation.NewRulesRegistry()}
+	s, err := NewService(typ, reg, validation.NewMapping())
+	if err != nil {
+		t.Fatalf("NewService error: %v", err)
+	}
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+	s.RegisterTypeFunc(func(v reflect.Value) reflect.Value {
+		return reflect.ValueOf(v.Interface().(wrappedString).String)
+	}, reflect.TypeOf(wrappedString{}))
+
+	for i := 0; i < 2; i++ {
+		ve := &validation.Error{}
+		obj := holder{Code: wrappedString{Valid: true, String: "ab"}}
+		if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+			t.Fatalf("ValidateStruct: %v", err)
+		}
+		if len(ve.ForField("Code")) != 1 {
+			t.Fatalf("iteration %d: expected unwrapped string to fail min(3), got %v", i, ve)
+		}
+	}
+	if reg.getCalls != 2 {
+		t.Fatalf("expected a field with a RegisterTypeFunc extractor to resolve its overload every call, got %d Get calls", reg.getCalls)
+	}
+}
+
+func TestService_ValidateStruct_cachedOverloadStillHandlesCrossFieldRule(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Password        string `validate:"required"`
+		PasswordConfirm string `validate:"eqfield(Password)"`
+	}
+	typ := reflect.TypeOf(holder{})
+	s := newTestService(t, typ)
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+	if err := validation.RegisterCrossFieldRules(s.Registry()); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		ve := &validation.Error{}
+		obj := holder{Password: "secret", PasswordConfirm: "different"}
+		if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+			t.Fatalf("ValidateStruct: %v", err)
+		}
+		if len(ve.ForField("PasswordConfirm")) != 1 {
+			t.Fatalf("iteration %d: expected eqfield to fail, got %v", i, ve)
+		}
+	}
+}
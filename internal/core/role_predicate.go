@@ -0,0 +1,59 @@
+package core
+
+import "strings"
+
+// rolePredicate is the OR-of-AND role gate parsed from a defaultRole/
+// validateRole tag, borrowed from aicra's Auth.Required/Auth.Active model:
+// groups are separated by "|" (OR), and the roles within a group are
+// separated by "," (AND) — e.g. "admin,ops|superadmin" allows when the
+// active role set contains (admin AND ops), or contains (superadmin).
+type rolePredicate struct {
+	groups [][]string
+}
+
+// parseRolePredicate parses tag into a rolePredicate, or returns nil if tag
+// is empty, "-", or otherwise carries no role group — meaning "always
+// applies", independent of the active role set.
+func parseRolePredicate(tag string) *rolePredicate {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag == "-" {
+		return nil
+	}
+	var rp rolePredicate
+	for _, g := range strings.Split(tag, "|") {
+		var group []string
+		for _, r := range strings.Split(g, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				group = append(group, r)
+			}
+		}
+		if len(group) > 0 {
+			rp.groups = append(rp.groups, group)
+		}
+	}
+	if len(rp.groups) == 0 {
+		return nil
+	}
+	return &rp
+}
+
+// allows reports whether active satisfies rp: at least one group whose every
+// role is present in active. A nil rp (no predicate) always allows.
+func (rp *rolePredicate) allows(active map[string]struct{}) bool {
+	if rp == nil {
+		return true
+	}
+	for _, group := range rp.groups {
+		satisfied := true
+		for _, r := range group {
+			if _, ok := active[r]; !ok {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+var errRequiredForTest = errors.New("required")
+
+func TestRolePredicate_allows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		tag    string
+		active map[string]struct{}
+		want   bool
+	}{
+		{name: "no predicate always allows", tag: "", active: nil, want: true},
+		{name: "dash always allows", tag: "-", active: nil, want: true},
+		{name: "single role satisfied", tag: "admin", active: map[string]struct{}{"admin": {}}, want: true},
+		{name: "single role unsatisfied", tag: "admin", active: map[string]struct{}{"ops": {}}, want: false},
+		{
+			name:   "AND group requires every role",
+			tag:    "admin,ops",
+			active: map[string]struct{}{"admin": {}},
+			want:   false,
+		},
+		{
+			name:   "AND group satisfied",
+			tag:    "admin,ops",
+			active: map[string]struct{}{"admin": {}, "ops": {}},
+			want:   true,
+		},
+		{
+			name:   "OR across groups",
+			tag:    "admin,ops|superadmin",
+			active: map[string]struct{}{"superadmin": {}},
+			want:   true,
+		},
+		{
+			name:   "OR across groups, neither satisfied",
+			tag:    "admin,ops|superadmin",
+			active: map[string]struct{}{"admin": {}},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rp := parseRolePredicate(tt.tag)
+			if got := rp.allows(tt.active); got != tt.want {
+				t.Fatalf("allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_WithActiveRoles_gatesDefaults(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Port string `default:"8080" defaultRole:"admin"`
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	if err := s.SetDefaultsStruct(reflect.ValueOf(&obj).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj.Port != "" {
+		t.Fatalf("expected the admin-gated default to stay unset without active roles, got %q", obj.Port)
+	}
+
+	scoped := s.WithActiveRoles("admin")
+	obj2 := config{}
+	if err := scoped.SetDefaultsStruct(reflect.ValueOf(&obj2).Elem()); err != nil {
+		t.Fatalf("SetDefaultsStruct: %v", err)
+	}
+	if obj2.Port != "8080" {
+		t.Fatalf("expected the admin-gated default to apply once scoped to admin, got %q", obj2.Port)
+	}
+}
+
+func TestService_WithActiveRoles_gatesValidation(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Name string `validate:"required" validateRole:"admin,ops"`
+	}
+
+	obj := config{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errRequiredForTest
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(&obj).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected the admin,ops-gated rule to be skipped without active roles, got %v", ve)
+	}
+
+	scoped := s.WithActiveRoles("admin", "ops")
+	ve2 := &validation.Error{}
+	if err := scoped.ValidateStruct(context.Background(), reflect.ValueOf(&obj).Elem(), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve2.Empty() {
+		t.Fatalf("expected the gated rule to apply and fail once scoped to admin+ops")
+	}
+}
+
+func TestService_WithActiveRoles_sharesRegistrationsWithOriginal(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code string `validate:"upper"`
+	}
+
+	s := newTestService(t, reflect.TypeOf(holder{}))
+	scoped := s.WithActiveRoles("admin")
+
+	mustAddRule[string](t, s, "upper", func(v string, _ ...string) error {
+		if v != "OK" {
+			return errRequiredForTest
+		}
+		return nil
+	})
+
+	// The rule was registered on s, but scoped shares the same underlying
+	// registry, so it sees the rule too.
+	obj := holder{Code: "nope"}
+	ve := &validation.Error{}
+	if err := scoped.ValidateStruct(context.Background(), reflect.ValueOf(&obj).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Empty() {
+		t.Fatalf("expected scoped Service to see rules registered on the original after WithActiveRoles")
+	}
+}
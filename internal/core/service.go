@@ -1,24 +1,195 @@
 package core
 
 import (
+	"context"
 	"reflect"
+	"sync"
+	"time"
 
 	"github.com/ygrebnov/model/validation"
 )
 
-type Service struct {
+// structRuleFunc is a type-erased struct-level validation hook, keyed by the
+// reflect.Type it was registered for. path is the hook's dotted location
+// within the document being validated ("" at the root).
+type structRuleFunc func(ctx context.Context, v reflect.Value, path string) error
+
+// coreState holds everything a Service needs that is shared across every
+// role-scoped view of it (see WithActiveRoles): the type it was built for,
+// its registry/mapping, and its mutex-guarded extension points. It is held
+// by pointer so WithActiveRoles can produce a new Service sharing this state
+// without copying its locks.
+type coreState struct {
 	// reflectType is the underlying struct type this service was initialized for.
 	reflectType   reflect.Type
 	rulesRegistry validation.RulesRegistry
 	rulesMapping  validation.RulesMapping
+
+	structRulesMu sync.RWMutex
+	structRules   map[reflect.Type][]structRuleFunc
+
+	// structLevelRules holds AddStructLevelRule's hooks, a richer alternative
+	// to structRules that receives a StructLevel (see struct_level.go)
+	// instead of a bare reflect.Value.
+	structLevelRulesMu sync.RWMutex
+	structLevelRules   map[reflect.Type][]structLevelRuleFunc
+
+	// aliases maps an alias name to the rule-list it expands to, applied when
+	// parsing a field's validate tag. Registered via AddAlias / Binding.RegisterAlias.
+	aliasesMu sync.RWMutex
+	aliases   map[string]string
+
+	// nameTag is the struct tag ValidateStruct reads alternate field names
+	// from when composing FieldError.NamePath. Defaults to defaultNameTag;
+	// configurable via SetNameTag.
+	nameTagMu sync.RWMutex
+	nameTag   string
+
+	// resolvers maps a default-tag alternative's prefix (e.g. "$ENV:") to the
+	// resolver that produces its value. Pre-populated with the built-in
+	// resolvers; extended via RegisterDefaultResolver.
+	resolversMu sync.RWMutex
+	resolvers   map[string]DefaultResolverFunc
+
+	// typeFuncs maps a reflect.Type to the extractor RegisterTypeFunc
+	// registered for it, keyed directly by reflect.Type for an O(1) lookup
+	// on applyRule/applyFieldRule's hot path; a sync.Map rather than the
+	// mutex-guarded maps above since it is read on every rule application
+	// and written only a handful of times at setup.
+	typeFuncs sync.Map // map[reflect.Type]func(reflect.Value) reflect.Value
+
+	// resolvedFieldRules caches processValidateTag's rule-overload
+	// resolution per (struct field, rule name), keyed by resolvedFieldRuleKey
+	// — see resolvedFieldRule for why this must stay keyed per field rather
+	// than per type. A sync.Map for the same reason as typeFuncs: read on
+	// every plain validate-tag rule application, written once per distinct
+	// field/rule pair.
+	resolvedFieldRules sync.Map // map[resolvedFieldRuleKey]validation.Rule
+}
+
+type Service struct {
+	*coreState
+
+	// activeRoles is the role set WithActiveRoles scoped this Service to.
+	// Fixed at construction and never mutated afterwards, so it needs no
+	// lock. A nil/empty set (the zero value returned by NewService) means
+	// this Service has no active roles: role-gated default/validate tags
+	// (see defaultRole/validateRole) never apply, while ungated ones always
+	// do, exactly as for any other unsatisfied role predicate.
+	activeRoles map[string]struct{}
+
+	// validationMode is the ValidationMode WithValidationMode scoped this
+	// Service to. Fixed at construction and never mutated afterwards. The
+	// zero value, ModeCollectAll, is ValidateStruct's default behavior.
+	validationMode ValidationMode
+
+	// validationTimeout is the per-call deadline WithValidationTimeout
+	// scoped this Service to. Fixed at construction and never mutated
+	// afterwards. The zero value disables the timeout, ValidateStruct's
+	// default behavior.
+	validationTimeout time.Duration
+
+	// validationParallelism is the worker-pool width WithValidationParallelism
+	// scoped this Service to. Fixed at construction and never mutated
+	// afterwards. Values <= 1 (the zero value included) keep ValidateStruct's
+	// default sequential field loop.
+	validationParallelism int
+}
+
+// scopeOf copies every With*-scoped field (activeRoles, validationMode,
+// validationTimeout, validationParallelism) from s into a new Service
+// sharing s's coreState, so WithActiveRoles/WithValidationMode/
+// WithValidationTimeout/WithValidationParallelism compose regardless of the
+// order they're chained in.
+func (s *Service) scopeOf() *Service {
+	return &Service{
+		coreState:             s.coreState,
+		activeRoles:           s.activeRoles,
+		validationMode:        s.validationMode,
+		validationTimeout:     s.validationTimeout,
+		validationParallelism: s.validationParallelism,
+	}
 }
 
 // NewService creates a Service for the given struct type using the
 // provided RulesRegistry and RulesMapping instances.
 func NewService(t reflect.Type, r validation.RulesRegistry, m validation.RulesMapping) (*Service, error) {
 	return &Service{
-		reflectType:   t,
-		rulesRegistry: r,
-		rulesMapping:  m,
+		coreState: &coreState{
+			reflectType:      t,
+			rulesRegistry:    r,
+			rulesMapping:     m,
+			structRules:      make(map[reflect.Type][]structRuleFunc),
+			structLevelRules: make(map[reflect.Type][]structLevelRuleFunc),
+			nameTag:          defaultNameTag,
+			resolvers:        builtinDefaultResolvers(),
+		},
 	}, nil
 }
+
+// WithActiveRoles returns a Service scoped to the given active role set: its
+// SetDefaultsStruct and ValidateStruct apply a field's default/validate tag
+// only when the field's defaultRole/validateRole predicate is satisfied by
+// roles (OR across comma-free "|"-separated groups, AND within a group), or
+// when the field carries no such predicate at all. The returned Service
+// shares this Service's registry, mapping, and every other extension point;
+// only the active role set differs, so registering an alias or a rule on
+// either Service is visible from both.
+func (s *Service) WithActiveRoles(roles ...string) *Service {
+	active := make(map[string]struct{}, len(roles))
+	for _, r := range roles {
+		active[r] = struct{}{}
+	}
+	scoped := s.scopeOf()
+	scoped.activeRoles = active
+	return scoped
+}
+
+// SetNameTag configures the struct tag ValidateStruct and validateElements
+// read alternate field names from when composing FieldError.NamePath (e.g.
+// "json", "yaml"), in place of the default "json" — this is the resolver a
+// REST API configures so a validation failure can be reported under its JSON
+// field name (e.g. "user.email_address") rather than its Go name. A field
+// missing the tag, or carrying "-", falls back to its Go field name; a
+// present tag's ",omitempty"-style options are stripped before use (see
+// resolveNameTag). It takes effect on every ValidateStruct call made after
+// it returns.
+func (s *Service) SetNameTag(tag string) {
+	s.nameTagMu.Lock()
+	defer s.nameTagMu.Unlock()
+	s.nameTag = tag
+}
+
+// nameTagSnapshot returns the name tag currently configured on s.
+func (s *Service) nameTagSnapshot() string {
+	s.nameTagMu.RLock()
+	defer s.nameTagMu.RUnlock()
+	return s.nameTag
+}
+
+// Type returns the struct type this Service was constructed for.
+func (s *Service) Type() reflect.Type {
+	return s.reflectType
+}
+
+// Registry returns the rules registry backing this Service, so callers
+// (e.g. schema generation) can resolve rule names to their Rule.
+func (s *Service) Registry() validation.RulesRegistry {
+	return s.rulesRegistry
+}
+
+// AddStructRule registers a struct-level validation hook for typ. Hooks run
+// after all tagged fields of a value of typ have been validated, at every
+// depth the traversal reaches that type.
+func (s *Service) AddStructRule(typ reflect.Type, fn func(ctx context.Context, v reflect.Value, path string) error) {
+	s.structRulesMu.Lock()
+	defer s.structRulesMu.Unlock()
+	s.structRules[typ] = append(s.structRules[typ], fn)
+}
+
+// structRulesFor returns the struct-level hooks registered for typ, if any.
+func (s *Service) structRulesFor(typ reflect.Type) []structRuleFunc {
+	s.structRulesMu.RLock()
+	defer s.structRulesMu.RUnlock()
+	return s.structRules[typ]
+}
@@ -1,7 +1,10 @@
 package core
 
 import (
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -9,41 +12,100 @@ import (
 
 	"github.com/ygrebnov/errorc"
 	"github.com/ygrebnov/model/errors"
+	"github.com/ygrebnov/model/validation"
 )
 
-// SetDefaultsStruct walks the struct value and applies defaults according to `default` and `defaultElem` tags.
+// SetDefaultsStruct walks the struct value and applies defaults according to
+// `default` and `defaultElem` tags, descending into nested structs, slice/
+// array elements, and map values. Every misconfigured default/defaultElem tag
+// is collected into a *validation.DefaultsError rather than aborting the walk
+// at the first failure, so callers can fix a batch of mistakes in one
+// iteration; each entry carries the full traversal path (and, mirroring
+// ValidateStruct, the NamePath expressed via the Service's configured name
+// tag) rather than just the innermost field name. A field whose defaultRole
+// tag is not satisfied by the active role set (see Service.WithActiveRoles)
+// is skipped entirely, including any defaultElem dive.
 func (s *Service) SetDefaultsStruct(rv reflect.Value) error {
+	de := &validation.DefaultsError{}
+	s.setDefaultsStruct(rv, "", "", de)
+	if de.Empty() {
+		return nil
+	}
+	return de
+}
+
+// setDefaultsStruct is SetDefaultsStruct's workhorse. path and namePath track
+// the traversal so far, extended by one segment per recursive call and
+// implicitly unwound on return, the same way validateStruct tracks its path.
+func (s *Service) setDefaultsStruct(rv reflect.Value, path, namePath string, de *validation.DefaultsError) {
 	typ := rv.Type()
-	for i := 0; i < rv.NumField(); i++ {
-		field := typ.Field(i)
-		// Skip unexported fields
-		if field.PkgPath != "" {
+	plan := planFor(typ, s.nameTagSnapshot())
+	for _, fp := range plan.fields {
+		fv := rv.Field(fp.index)
+
+		fpath := fp.name
+		if path != "" {
+			fpath = path + "." + fp.name
+		}
+		fnamePath := fp.altName
+		if namePath != "" {
+			fnamePath = namePath + "." + fp.altName
+		}
+
+		if !fp.defaultRoles.allows(s.activeRoles) {
 			continue
 		}
-		fv := rv.Field(i)
 
-		// Handle default tag
-		if dtag := field.Tag.Get(tagDefault); dtag != "" && dtag != "-" {
-			if err := s.applyDefaultTag(fv, dtag, field.Name); err != nil {
-				return err
+		// defaultIf gates this field's default and defaultFrom tags behind a
+		// sibling-field predicate; no defaultIf tag means "always applies".
+		applyDefault := true
+		if fp.defaultIf != nil {
+			if fp.defaultIf.err != nil {
+				de.Add(validation.FieldError{
+					Path:     fpath,
+					NamePath: fnamePath,
+					Rule:     tagDefaultIf,
+					Err: errorc.With(
+						errors.ErrSetDefault,
+						errorc.String(errors.ErrorFieldFieldName, fpath),
+						errorc.Error(errors.ErrorFieldCause, fp.defaultIf.err),
+					),
+				})
+				applyDefault = false
+			} else {
+				applyDefault = evaluateDefaultIf(rv, *fp.defaultIf)
 			}
 		}
-		// Element defaults for collections
-		if etag := field.Tag.Get(tagDefaultElem); etag != "" && etag != "-" {
-			if err := s.applyDefaultElemTag(fv, etag); err != nil {
-				return err
+
+		if applyDefault {
+			// Handle default tag
+			if fp.defaultTag != "" && fp.defaultTag != "-" {
+				s.applyDefaultTag(fv, fp, fpath, fnamePath, de)
+			}
+			// Handle defaultFrom: copy a sibling field's value if still zero.
+			if fp.defaultFrom != nil {
+				s.applyDefaultFrom(rv, fv, *fp.defaultFrom, fpath, fnamePath, de)
 			}
 		}
+		// Element defaults for collections
+		if fp.defaultElemTag != "" && fp.defaultElemTag != "-" {
+			s.applyDefaultElemTag(fv, fp.defaultElemTag, fpath, fnamePath, de)
+		}
 	}
-	return nil
 }
 
-// applyDefaultTag applies the `default` tag semantics to a single field value.
-// Supported values: "dive", "alloc", or a literal (delegated to setLiteralDefault).
-func (s *Service) applyDefaultTag(fv reflect.Value, tag, fieldName string) error {
+// applyDefaultTag applies the `default` tag semantics to a single field
+// value, per fp (the field's cached plan, carrying its raw tag and, when
+// eligible, its preparsed literal — see fieldPlan.literalPlan). Supported
+// tag values: "dive", "alloc", or a pipe-separated chain of alternatives
+// (delegated to resolveDefaultTag, then setLiteralDefault). Failures are
+// added to de rather than returned, so a later field's default still gets a
+// chance to apply.
+func (s *Service) applyDefaultTag(fv reflect.Value, fp fieldPlan, fieldPath, fieldNamePath string, de *validation.DefaultsError) {
+	tag := fp.defaultTag
 	switch tag {
 	case tagDive:
-		return s.diveDefaultsIntoValue(fv)
+		s.diveDefaultsIntoValue(fv, fieldPath, fieldNamePath, de)
 	case tagAlloc:
 		// Allocate empty slice/map if nil
 		if fv.Kind() == reflect.Slice && fv.IsNil() {
@@ -51,47 +113,143 @@ func (s *Service) applyDefaultTag(fv reflect.Value, tag, fieldName string) error
 		} else if fv.Kind() == reflect.Map && fv.IsNil() {
 			fv.Set(reflect.MakeMap(fv.Type()))
 		}
-		return nil
 	default:
-		if err := setLiteralDefault(fv, tag); err != nil {
-			return errorc.With(
+		resolved, ok, err := s.resolveDefaultTag(tag)
+		if err != nil {
+			de.Add(validation.FieldError{
+				Path:     fieldPath,
+				NamePath: fieldNamePath,
+				Rule:     tagDefault,
+				Err: errorc.With(
+					errors.ErrSetDefault,
+					errorc.String(errors.ErrorFieldFieldName, fieldPath),
+					errorc.Error(errors.ErrorFieldCause, err),
+				),
+			})
+			return
+		}
+		if !ok {
+			return
+		}
+		// Fast path: resolveDefaultTag passed the tag through unchanged, so
+		// no resolver substituted it — the literal is exactly the one
+		// buildPlan already parsed into fp.literalPlan, and setting it
+		// directly skips re-running the pointer/TextUnmarshaler dispatch and
+		// string parsing in setLiteralDefault/setScalarLiteral.
+		if fp.literalPlan != nil && resolved == tag {
+			applyPreparsedLiteral(fv, *fp.literalPlan)
+			return
+		}
+		if err := setLiteralDefault(fv, resolved); err != nil {
+			de.Add(validation.FieldError{
+				Path:     fieldPath,
+				NamePath: fieldNamePath,
+				Rule:     tagDefault,
+				Err: errorc.With(
+					errors.ErrSetDefault,
+					errorc.String(errors.ErrorFieldFieldName, fieldPath),
+					errorc.Error(errors.ErrorFieldCause, err),
+				),
+			})
+		}
+	}
+}
+
+// applyDefaultFrom copies the sibling field plan.otherIndex (within rv) into
+// fv when fv is still zero and the sibling's type is assignable to it.
+// Mirrors setLiteralDefault's pointer-allocation so a nil *string field can
+// be defaulted from a sibling string the same way a literal default would.
+func (s *Service) applyDefaultFrom(rv, fv reflect.Value, plan defaultFromPlan, fieldPath, fieldNamePath string, de *validation.DefaultsError) {
+	if plan.err != nil {
+		de.Add(validation.FieldError{
+			Path:     fieldPath,
+			NamePath: fieldNamePath,
+			Rule:     tagDefaultFrom,
+			Err: errorc.With(
 				errors.ErrSetDefault,
-				errorc.String(errors.ErrorFieldFieldName, fieldName),
-				errorc.Error(errors.ErrorFieldCause, err),
-			)
+				errorc.String(errors.ErrorFieldFieldName, fieldPath),
+				errorc.Error(errors.ErrorFieldCause, plan.err),
+			),
+		})
+		return
+	}
+
+	target := fv
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
 		}
-		return nil
+		target = target.Elem()
+	}
+	if !target.CanSet() || !target.IsZero() {
+		return
+	}
+
+	other := rv.Field(plan.otherIndex)
+	if other.IsZero() {
+		return // nothing to copy
+	}
+	if !other.Type().AssignableTo(target.Type()) {
+		de.Add(validation.FieldError{
+			Path:     fieldPath,
+			NamePath: fieldNamePath,
+			Rule:     tagDefaultFrom,
+			Err: errorc.With(
+				errors.ErrSetDefault,
+				errorc.String(errors.ErrorFieldFieldName, fieldPath),
+				errorc.Error(errors.ErrorFieldCause, fmt.Errorf(
+					"defaultFrom: field type %s is not assignable to %s", other.Type(), target.Type(),
+				)),
+			),
+		})
+		return
 	}
+	target.Set(other)
+}
+
+// applyPreparsedLiteral mirrors setLiteralDefault's pointer-allocation and
+// zero-value checks for the fast-path scalar kinds fieldPlan.literalPlan
+// covers, then sets pl directly, skipping the string reparsing
+// setLiteralDefault/setScalarLiteral would otherwise do on every call.
+func applyPreparsedLiteral(fv reflect.Value, pl preparsedLiteral) {
+	target := fv
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	if !target.CanSet() || !target.IsZero() {
+		return // nothing to do, same as setLiteralDefault's no-op
+	}
+	pl.apply(target)
 }
 
 // diveDefaultsIntoValue recurses into a struct or *struct field to apply nested defaults.
 // For nil *struct, it allocates the struct before diving. Non-structs are ignored.
-func (s *Service) diveDefaultsIntoValue(fv reflect.Value) error {
+func (s *Service) diveDefaultsIntoValue(fv reflect.Value, path, namePath string, de *validation.DefaultsError) {
 	switch fv.Kind() {
 	case reflect.Ptr:
 		if fv.IsNil() {
 			if fv.Type().Elem().Kind() == reflect.Struct {
 				fv.Set(reflect.New(fv.Type().Elem()))
 			} else {
-				return nil // ignore dive for non-struct pointers
+				return // ignore dive for non-struct pointers
 			}
 		}
 		if fv.Elem().Kind() == reflect.Struct {
-			return s.SetDefaultsStruct(fv.Elem())
+			s.setDefaultsStruct(fv.Elem(), path, namePath, de)
 		}
-		return nil
 	case reflect.Struct:
-		return s.SetDefaultsStruct(fv)
-	default:
-		return nil
+		s.setDefaultsStruct(fv, path, namePath, de)
 	}
 }
 
 // applyDefaultElemTag applies defaults to elements/values of collections based on `defaultElem`.
 // Currently supports: defaultElem:"dive".
-func (s *Service) applyDefaultElemTag(fv reflect.Value, tag string) error {
+func (s *Service) applyDefaultElemTag(fv reflect.Value, tag, path, namePath string, de *validation.DefaultsError) {
 	if tag != tagDive {
-		return nil
+		return
 	}
 	cont := fv
 	if cont.Kind() == reflect.Ptr && !cont.IsNil() {
@@ -99,20 +257,15 @@ func (s *Service) applyDefaultElemTag(fv reflect.Value, tag string) error {
 	}
 	switch cont.Kind() {
 	case reflect.Slice, reflect.Array:
-		if err := s.setSliceArrayElementsDefaultValues(cont); err != nil {
-			return err
-		}
+		s.setSliceArrayElementsDefaultValues(cont, path, namePath, de)
 	case reflect.Map:
-		if err := s.setMapElementsDefaultValues(cont); err != nil {
-			return err
-		}
+		s.setMapElementsDefaultValues(cont, path, namePath, de)
 	default:
 		// ignore for non-collections
 	}
-	return nil
 }
 
-func (s *Service) setSliceArrayElementsDefaultValues(value reflect.Value) error {
+func (s *Service) setSliceArrayElementsDefaultValues(value reflect.Value, path, namePath string, de *validation.DefaultsError) {
 	l := value.Len()
 	for j := 0; j < l; j++ {
 		ev := value.Index(j)
@@ -123,25 +276,23 @@ func (s *Service) setSliceArrayElementsDefaultValues(value reflect.Value) error
 		}
 
 		if dv.Kind() == reflect.Struct {
-			if err := s.SetDefaultsStruct(dv); err != nil {
-				return err
-			}
+			idxPath := fmt.Sprintf("%s[%d]", path, j)
+			idxNamePath := fmt.Sprintf("%s[%d]", namePath, j)
+			s.setDefaultsStruct(dv, idxPath, idxNamePath, de)
 		}
 	}
-
-	return nil
 }
 
-func (s *Service) setMapElementsDefaultValues(mapValue reflect.Value) error {
+func (s *Service) setMapElementsDefaultValues(mapValue reflect.Value, path, namePath string, de *validation.DefaultsError) {
 	for _, key := range mapValue.MapKeys() {
 		mapElemValue := mapValue.MapIndex(key)
+		keyPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+		keyNamePath := fmt.Sprintf("%s[%v]", namePath, key.Interface())
 
 		// Pointer-to-struct map values: mutate in place
 		if mapElemValue.Kind() == reflect.Ptr {
 			if !mapElemValue.IsNil() && mapElemValue.Elem().Kind() == reflect.Struct {
-				if err := s.SetDefaultsStruct(mapElemValue.Elem()); err != nil {
-					return err
-				}
+				s.setDefaultsStruct(mapElemValue.Elem(), keyPath, keyNamePath, de)
 			}
 			continue
 		}
@@ -150,17 +301,26 @@ func (s *Service) setMapElementsDefaultValues(mapValue reflect.Value) error {
 		if mapElemValue.Kind() == reflect.Struct {
 			structValue := reflect.New(mapElemValue.Type()).Elem()
 			structValue.Set(mapElemValue)
-			if err := s.SetDefaultsStruct(structValue); err != nil {
-				return err
-			}
+			s.setDefaultsStruct(structValue, keyPath, keyNamePath, de)
 			mapValue.SetMapIndex(key, structValue)
 		}
 	}
-
-	return nil
 }
 
 var durationType = reflect.TypeOf(time.Duration(0))
+var timeType = reflect.TypeOf(time.Time{})
+var urlURLType = reflect.TypeOf(url.URL{})
+
+// textUnmarshalerType is used to detect fields whose (pointer) type
+// implements encoding.TextUnmarshaler, so literal defaults can be dispatched
+// through it ahead of the time.Time/time.Duration special cases and the
+// kind switch.
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// jsonUnmarshalerType is used to detect fields whose (pointer) type
+// implements json.Unmarshaler, consulted after encoding.TextUnmarshaler so a
+// type implementing both prefers the plain-text form.
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
 
 // setLiteralDefault sets a literal default value into fv if it is zero.
 // For pointer-to-scalar fields, it allocates and sets the pointed value.
@@ -170,11 +330,17 @@ func setLiteralDefault(fv reflect.Value, lit string) error {
 	target := fv
 	// Allocate for pointer-to-scalar when nil
 	if target.Kind() == reflect.Ptr {
-		// If nil and element is not struct/map/slice, allocate
+		// If nil and element is not struct/map/slice, allocate — unless the
+		// pointed-to struct itself implements encoding.TextUnmarshaler
+		// (e.g. *time.Time, *url.URL), in which case it is allocated so the
+		// unmarshaler dispatch below has somewhere to write.
 		if target.IsNil() {
 			ek := target.Type().Elem().Kind()
-			switch ek {
-			case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+			implementsText := reflect.PointerTo(target.Type().Elem()).Implements(textUnmarshalerType)
+			switch {
+			case implementsText:
+				target.Set(reflect.New(target.Type().Elem()))
+			case ek == reflect.Struct, ek == reflect.Map, ek == reflect.Slice, ek == reflect.Array:
 				// Do not auto-allocate complex types on literal defaults
 			default:
 				target.Set(reflect.New(target.Type().Elem()))
@@ -190,6 +356,86 @@ func setLiteralDefault(fv reflect.Value, lit string) error {
 		return nil
 	}
 
+	// Prefer encoding.TextUnmarshaler over the special cases and kind switch
+	// below, so named types with custom text parsing (enums, net.IP, etc.)
+	// can be defaulted via a plain string literal. Checked on target.Addr()
+	// first (the common pointer-receiver case), then on target itself, for
+	// the rare value-receiver implementation.
+	if target.CanAddr() && target.Addr().Type().Implements(textUnmarshalerType) {
+		tu := target.Addr().Interface().(encoding.TextUnmarshaler)
+		if err := tu.UnmarshalText([]byte(lit)); err != nil {
+			return fmt.Errorf("unmarshal default text %q: %w", lit, err)
+		}
+		return nil
+	}
+	if target.CanInterface() && target.Type().Implements(textUnmarshalerType) {
+		tu := target.Interface().(encoding.TextUnmarshaler)
+		if err := tu.UnmarshalText([]byte(lit)); err != nil {
+			return fmt.Errorf("unmarshal default text %q: %w", lit, err)
+		}
+		return nil
+	}
+
+	// Next, encoding/json.Unmarshaler, for types that only speak JSON. lit is
+	// passed through as-is when it already looks like a JSON value;
+	// otherwise it is treated as a bare string and quoted first.
+	if target.CanAddr() && target.Addr().Type().Implements(jsonUnmarshalerType) {
+		ju := target.Addr().Interface().(json.Unmarshaler)
+		if err := ju.UnmarshalJSON([]byte(jsonLiteralBytes(lit))); err != nil {
+			return fmt.Errorf("unmarshal default json %q: %w", lit, err)
+		}
+		return nil
+	}
+
+	// url.URL implements neither TextUnmarshaler nor json.Unmarshaler, so it
+	// gets its own special case, same treatment as time.Duration/time.Time.
+	if target.Type() == urlURLType {
+		u, err := url.Parse(lit)
+		if err != nil {
+			return fmt.Errorf("parse url: %w", err)
+		}
+		target.Set(reflect.ValueOf(*u))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Slice:
+		return setSliceLiteral(target, lit)
+	case reflect.Map:
+		return setMapLiteral(target, lit)
+	}
+
+	return setScalarLiteral(target, lit)
+}
+
+// jsonLiteralBytes returns lit unchanged if it already looks like a JSON
+// value, or lit wrapped in double quotes (with internal quotes/backslashes
+// escaped) otherwise, so a bare tag literal can feed json.Unmarshaler.
+func jsonLiteralBytes(lit string) string {
+	trimmed := strings.TrimSpace(lit)
+	if trimmed == "" {
+		return `""`
+	}
+	switch trimmed[0] {
+	case '{', '[', '"', '-':
+		return lit
+	}
+	if trimmed == "true" || trimmed == "false" || trimmed == "null" {
+		return lit
+	}
+	if _, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return lit
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(lit)
+	return `"` + escaped + `"`
+}
+
+// setScalarLiteral sets a scalar, time.Duration, or time.Time literal into
+// target, which is assumed already zero and settable. It is shared by
+// setLiteralDefault for a plain field and by setSliceLiteral/setMapLiteral
+// for each collection element, so a []time.Duration or map[string]int
+// default is parsed exactly the same way its scalar counterpart would be.
+func setScalarLiteral(target reflect.Value, lit string) error {
 	// Handle special case: time.Duration typed fields
 	if target.Type() == durationType {
 		d, err := time.ParseDuration(lit)
@@ -200,6 +446,18 @@ func setLiteralDefault(fv reflect.Value, lit string) error {
 		return nil
 	}
 
+	// Handle special case: time.Time typed fields, parsed as RFC3339 (the
+	// format the "now" resolver produces, and the conventional choice for a
+	// literal timestamp default).
+	if target.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, lit)
+		if err != nil {
+			return fmt.Errorf("parse time: %w", err)
+		}
+		target.Set(reflect.ValueOf(t))
+		return nil
+	}
+
 	switch target.Kind() {
 	case reflect.String:
 		target.SetString(lit)
@@ -2,8 +2,11 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/ygrebnov/model/validation"
 )
@@ -14,53 +17,241 @@ func (s *Service) AddRule(r validation.Rule) error {
 
 // ValidateStruct walks a struct value and applies rules on each field according to its `validate` tag.
 // Nested structs and pointers to structs are traversed recursively. The `path` argument tracks the
-// dotted field path for clearer error messages.
+// dotted field path for clearer error messages. A self-referential graph (a pointer field or dive
+// element that, directly or indirectly, points back to a struct already on the current traversal
+// path) is detected and recorded as a single FieldError instead of recursing forever.
+//
+// If this Service was scoped via WithValidationTimeout, ctx is wrapped in a
+// derived context.WithTimeout for the duration of this call; a rule that
+// keeps running past the deadline does not stop, but the walker itself
+// abandons the rest of the traversal and ValidateStruct returns
+// context.DeadlineExceeded.
 func (s *Service) ValidateStruct(
 	ctx context.Context,
 	rv reflect.Value,
 	path string,
 	ve *validation.Error,
+) error {
+	if s.validationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.validationTimeout)
+		defer cancel()
+	}
+	err := s.validateStruct(ctx, rv, rv, path, path, newCycleGuard(), ve)
+	if errors.Is(err, errFailFastStop) {
+		return nil
+	}
+	return err
+}
+
+// validateStruct is ValidateStruct's workhorse. It additionally tracks
+// namePath, the same traversal expressed with each field's configured name
+// tag (see Service.SetNameTag) instead of its Go name, so every FieldError
+// carries both Path and NamePath; root, the top-level struct value passed to
+// ValidateStruct, threaded unchanged through every recursive call so a
+// cross-field or field-level rule (eqfield, required_if, ...) can resolve a
+// sibling field outside its immediate parent; and visiting, the set of
+// pointer addresses currently being recursed into on this path, so a
+// self-referential graph is caught instead of overflowing the stack (see
+// cycleGuard).
+func (s *Service) validateStruct(
+	ctx context.Context,
+	rv, root reflect.Value,
+	path, namePath string,
+	visiting *cycleGuard,
+	ve *validation.Error,
 ) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	typ := rv.Type()
-	for i := 0; i < rv.NumField(); i++ {
+	plan := planFor(typ, s.nameTagSnapshot())
+
+	var err error
+	if s.validationParallelism > 1 {
+		err = s.validateFieldsParallel(ctx, rv, root, path, namePath, plan, visiting, ve)
+	} else {
+		err = s.validateFieldsSequential(ctx, rv, root, path, namePath, plan, visiting, ve)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range s.structRulesFor(typ) {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		field := typ.Field(i)
-		if field.PkgPath != "" { // Skip unexported fields
-			continue
+		if err := fn(ctx, rv, path); err != nil {
+			if err := s.record(ve, validation.FieldError{Path: path, NamePath: namePath, Rule: "struct", Err: err}); err != nil {
+				return err
+			}
 		}
-		fv := rv.Field(i)
+	}
 
-		fpath := field.Name
-		if path != "" {
-			fpath = path + "." + field.Name
+	for _, fn := range s.structLevelRulesFor(typ) {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
-
-		// Recurse into pointers to structs
-		if fv.Kind() == reflect.Ptr && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct {
-			if err := s.ValidateStruct(ctx, fv.Elem(), fpath, ve); err != nil {
+		sl := &structLevel{v: rv, path: path, namePath: namePath, s: s, ve: ve}
+		if err := fn(ctx, sl); err != nil {
+			if err := s.record(ve, validation.FieldError{Path: path, NamePath: namePath, Rule: "struct", Err: err}); err != nil {
 				return err
 			}
 		}
+		if sl.stopped {
+			return errFailFastStop
+		}
+	}
+
+	return nil
+}
 
-		// Recurse into embedded/inline structs
-		if fv.Kind() == reflect.Struct {
-			if err := s.ValidateStruct(ctx, fv, fpath, ve); err != nil {
+// validateFieldsSequential runs validateField for each of plan's fields in
+// order, stopping at the first error (a real failure under ModeFailFast, a
+// ctx cancellation/timeout, or a propagated cycle-detection FieldError).
+// This is ValidateStruct's traversal strategy unless the Service was scoped
+// via WithValidationParallelism.
+func (s *Service) validateFieldsSequential(
+	ctx context.Context,
+	rv, root reflect.Value,
+	path, namePath string,
+	plan *structPlan,
+	visiting *cycleGuard,
+	ve *validation.Error,
+) error {
+	typ := rv.Type()
+	for _, fp := range plan.fields {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.validateField(ctx, rv, root, path, namePath, typ, fp, visiting, ve); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateFieldsParallel is validateFieldsSequential's concurrent
+// counterpart, used when the Service was scoped via
+// WithValidationParallelism(n) with n > 1: it runs each of plan's fields on
+// its own goroutine, at most n in flight at a time. validation.Error.Add,
+// the RulesRegistry, and the RulesMapping are all already safe for
+// concurrent use, and visiting (a *cycleGuard) guards its own state, so the
+// only coordination needed is a bounded semaphore and, under ModeFailFast, a
+// context derived locally for this struct level: the first field to fail
+// cancels it, so siblings still in flight observe ctx.Err() at their next
+// check instead of running rules whose result is already moot.
+func (s *Service) validateFieldsParallel(
+	ctx context.Context,
+	rv, root reflect.Value,
+	path, namePath string,
+	plan *structPlan,
+	visiting *cycleGuard,
+	ve *validation.Error,
+) error {
+	typ := rv.Type()
+	levelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sem := make(chan struct{}, s.validationParallelism)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+
+	for _, fp := range plan.fields {
+		fp := fp
+		if levelCtx.Err() != nil {
+			break
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := s.validateField(levelCtx, rv, root, path, namePath, typ, fp, visiting, ve); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// validateField validates one field of rv described by fp: it recurses into
+// a pointer-to-struct or embedded-struct field, then — if fp.validateRoles
+// allows the Service's active roles — processes the field's validate and
+// validateElem tags. It is the shared body behind both
+// validateFieldsSequential and validateFieldsParallel, so a field validates
+// identically regardless of which strategy is driving the struct level it
+// belongs to.
+func (s *Service) validateField(
+	ctx context.Context,
+	rv, root reflect.Value,
+	path, namePath string,
+	typ reflect.Type,
+	fp fieldPlan,
+	visiting *cycleGuard,
+	ve *validation.Error,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	fv := rv.Field(fp.index)
+
+	fpath := fp.name
+	if path != "" {
+		fpath = path + "." + fp.name
+	}
+	fnamePath := fp.altName
+	if namePath != "" {
+		fnamePath = namePath + "." + fp.altName
+	}
+
+	// Recurse into pointers to structs
+	if fp.recursePtr && !fv.IsNil() {
+		ptr := fv.Pointer()
+		if cyclic, leave := visiting.enter(ptr); cyclic {
+			if err := s.record(ve, validation.FieldError{
+				Path: fpath, NamePath: fnamePath, Rule: tagValidate,
+				Err: fmt.Errorf("core: cycle detected validating %s, skipping to avoid infinite recursion", fpath),
+			}); err != nil {
+				return err
+			}
+		} else {
+			err := s.validateStruct(ctx, fv.Elem(), root, fpath, fnamePath, visiting, ve)
+			leave()
+			if err != nil {
 				return err
 			}
 		}
+	}
 
-		// Process `validate` tag
-		if err := s.processValidateTag(ctx, &field, fpath, fv, typ, i, ve); err != nil {
+	// Recurse into embedded/inline structs
+	if fp.recurseEmbed {
+		if err := s.validateStruct(ctx, fv, root, fpath, fnamePath, visiting, ve); err != nil {
 			return err
 		}
+	}
 
-		// Process `validateElem` tag for slices, arrays, and maps
-		if err := s.processValidateElemTag(ctx, &field, fpath, fv, typ, i, ve); err != nil {
+	if !fp.validateRoles.allows(s.activeRoles) {
+		return nil
+	}
+
+	// Process `validate` tag
+	if fp.hasValidate {
+		if err := s.processValidateTag(ctx, fpath, fnamePath, fv, rv, root, typ, fp.index, ve); err != nil {
+			return err
+		}
+	}
+
+	// Process `validateElem` tag for slices, arrays, and maps
+	if fp.hasValidateElem {
+		if err := s.processValidateElemTag(ctx, fpath, fnamePath, fv, typ, fp.index, visiting, ve); err != nil {
 			return err
 		}
 	}
@@ -70,21 +261,19 @@ func (s *Service) ValidateStruct(
 
 func (s *Service) processValidateTag(
 	ctx context.Context,
-	field *reflect.StructField,
-	fieldPath string,
-	fieldValue reflect.Value,
+	fieldPath, fieldNamePath string,
+	fieldValue, parent, root reflect.Value,
 	structType reflect.Type,
 	fieldIndex int,
 	ve *validation.Error,
 ) error {
-	rawTag := field.Tag.Get(tagValidate)
-	if rawTag == "" || rawTag == "-" {
-		return nil
-	}
-	// Check cache for parsed rules
+	// Check cache for parsed rules; the caller (ValidateStruct) already knows
+	// via fieldPlan.hasValidate that the raw tag is present and not "-", so
+	// the raw tag is only re-read from the struct field on a cache miss.
 	rules, exists := s.rulesMapping.Get(structType, fieldIndex, tagValidate)
 	if !exists {
-		rules = validation.ParseTag(rawTag)
+		rawTag := structType.Field(fieldIndex).Tag.Get(tagValidate)
+		rules = expandAliases(validation.ParseTag(rawTag), s.aliasesSnapshot())
 		s.rulesMapping.Add(structType, fieldIndex, tagValidate, rules)
 	}
 
@@ -92,8 +281,16 @@ func (s *Service) processValidateTag(
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := s.applyRule(r.Name, fieldValue, r.Params...); err != nil {
-			ve.Add(validation.FieldError{Path: fieldPath, Rule: r.Name, Params: r.Params, Err: err})
+		var applyErr error
+		if len(r.Alts) == 0 {
+			applyErr = s.applyFieldRuleCached(structType, fieldIndex, r.Name, fieldValue, parent, root, fieldPath, r.Params...)
+		} else {
+			applyErr = s.applyFieldRuleOrGroup(r, fieldValue, parent, root, fieldPath)
+		}
+		if applyErr != nil {
+			if err := s.record(ve, validation.FieldError{Path: fieldPath, NamePath: fieldNamePath, Rule: r.Name, Alias: r.Alias, Params: r.Params, Err: applyErr}); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -102,26 +299,23 @@ func (s *Service) processValidateTag(
 
 func (s *Service) processValidateElemTag(
 	ctx context.Context,
-	field *reflect.StructField,
-	fieldPath string,
+	fieldPath, fieldNamePath string,
 	fieldValue reflect.Value,
 	structType reflect.Type,
 	fieldIndex int,
+	visiting *cycleGuard,
 	ve *validation.Error,
 ) error {
-	elemRaw := field.Tag.Get(tagValidateElem)
-	if elemRaw == "" || elemRaw == "-" {
-		return nil
-	}
-
-	// Check cache for parsed rules
+	// Check cache for parsed rules; see processValidateTag for why the raw
+	// tag is only re-read on a cache miss.
 	elemRules, exists := s.rulesMapping.Get(structType, fieldIndex, tagValidateElem)
 	if !exists {
+		elemRaw := structType.Field(fieldIndex).Tag.Get(tagValidateElem)
 		elemRules = validation.ParseTag(elemRaw)
 		s.rulesMapping.Add(structType, fieldIndex, tagValidateElem, elemRules)
 	}
 
-	if err := s.validateElements(ctx, fieldValue, fieldPath, elemRules, ve); err != nil {
+	if err := s.validateElements(ctx, fieldValue, fieldPath, fieldNamePath, elemRules, visiting, ve); err != nil {
 		return err
 	}
 
@@ -129,12 +323,36 @@ func (s *Service) processValidateElemTag(
 }
 
 // validateElements applies validation rules to elements of a slice, array, or map
-// using pre-parsed rules (e.g., retrieved from the cache).
+// using pre-parsed rules (e.g., retrieved from the cache). The rules may contain
+// "dive" tokens (optionally followed by "keys,...,endkeys,...") describing
+// multi-level descent into nested collections; see buildElemPlan.
 func (s *Service) validateElements(
 	ctx context.Context,
 	fv reflect.Value,
-	fpath string,
+	fpath, fnamePath string,
 	rules []validation.RuleNameParams,
+	visiting *cycleGuard,
+	ve *validation.Error,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	return s.applyElemPlan(ctx, fv, fpath, fnamePath, buildElemPlan(rules), visiting, ve)
+}
+
+// applyElemPlan executes one level of an elemPlan against container value fv:
+// it runs plan.pre against the container itself, then (if plan.dive) iterates
+// its elements or map entries, applying plan.keys to each map key and
+// descending into plan.next (nested dive) or plan.rules for each element/value.
+func (s *Service) applyElemPlan(
+	ctx context.Context,
+	fv reflect.Value,
+	fpath, fnamePath string,
+	plan *elemPlan,
+	visiting *cycleGuard,
 	ve *validation.Error,
 ) error {
 	if err := ctx.Err(); err != nil {
@@ -144,11 +362,17 @@ func (s *Service) validateElements(
 	if cont.Kind() == reflect.Ptr && !cont.IsNil() {
 		cont = cont.Elem()
 	}
-	if len(rules) == 0 {
+
+	for _, r := range plan.pre {
+		if err := s.applyRuleOrGroup(r, cont); err != nil {
+			if err := s.record(ve, validation.FieldError{Path: fpath, NamePath: fnamePath, Rule: r.Name, Alias: r.Alias, Params: r.Params, Err: err}); err != nil {
+				return err
+			}
+		}
+	}
+	if !plan.dive {
 		return nil
 	}
-	// Special case: validateElem:"dive" means recurse into element structs
-	isDiveOnly := len(rules) == 1 && rules[0].Name == tagDive && len(rules[0].Params) == 0
 
 	switch cont.Kind() {
 	case reflect.Slice, reflect.Array:
@@ -158,7 +382,8 @@ func (s *Service) validateElements(
 			}
 			elem := cont.Index(i)
 			pathIdx := fmt.Sprintf("%s[%d]", fpath, i)
-			if err := s.validateSingleElement(ctx, elem, pathIdx, rules, isDiveOnly, ve); err != nil {
+			namePathIdx := fmt.Sprintf("%s[%d]", fnamePath, i)
+			if err := s.applyElemLevel(ctx, elem, pathIdx, namePathIdx, plan, visiting, ve); err != nil {
 				return err
 			}
 		}
@@ -167,9 +392,20 @@ func (s *Service) validateElements(
 			if err := ctx.Err(); err != nil {
 				return err
 			}
-			elem := cont.MapIndex(key)
 			pathKey := fmt.Sprintf("%s[%v]", fpath, key.Interface())
-			if err := s.validateSingleElement(ctx, elem, pathKey, rules, isDiveOnly, ve); err != nil {
+			namePathKey := fmt.Sprintf("%s[%v]", fnamePath, key.Interface())
+			for _, r := range plan.keys {
+				if err := s.applyRuleOrGroup(r, key); err != nil {
+					// Suffixed with "(key)" so a key-rule failure (e.g. "keys,min(1),endkeys") is
+					// distinguishable by Path from a value-rule failure on the same map entry,
+					// which is recorded at the bare pathKey below.
+					if err := s.record(ve, validation.FieldError{Path: pathKey + "(key)", NamePath: namePathKey + "(key)", Rule: r.Name, Alias: r.Alias, Params: r.Params, Err: err}); err != nil {
+						return err
+					}
+				}
+			}
+			elem := cont.MapIndex(key)
+			if err := s.applyElemLevel(ctx, elem, pathKey, namePathKey, plan, visiting, ve); err != nil {
 				return err
 			}
 		}
@@ -177,54 +413,270 @@ func (s *Service) validateElements(
 	return nil
 }
 
-// validateSingleElement handles validation for a single item from a collection.
-func (s *Service) validateSingleElement(
+// applyElemLevel handles one element reached by a dive: when plan.next is set
+// (multi-level dive) it applies plan.rules to the element and then descends
+// into it as a nested collection; otherwise it recurses into a
+// struct element for a bare trailing "dive", or otherwise applies plan.rules.
+// A pointer element is dereferenced first, so rules and struct recursion both
+// see the pointed-to value; a nil pointer short-circuits plan.rules (mirroring
+// an implicit "omitempty" for the element) instead of running rules against
+// an invalid value. Recursion into a pointer element that is already on the
+// current traversal path is refused and recorded as a cycle, the same as for
+// a self-referential pointer field (see validateStruct).
+func (s *Service) applyElemLevel(
 	ctx context.Context,
 	elem reflect.Value,
-	path string,
-	rules []validation.RuleNameParams,
-	isDiveOnly bool,
+	path, namePath string,
+	plan *elemPlan,
+	visiting *cycleGuard,
 	ve *validation.Error,
 ) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	if isDiveOnly {
-		dv := elem
-		if dv.Kind() == reflect.Ptr && !dv.IsNil() {
+	if plan.next != nil {
+		for _, r := range plan.rules {
+			if err := s.applyRuleOrGroup(r, elem); err != nil {
+				if err := s.record(ve, validation.FieldError{Path: path, NamePath: namePath, Rule: r.Name, Alias: r.Alias, Params: r.Params, Err: err}); err != nil {
+					return err
+				}
+			}
+		}
+		return s.applyElemPlan(ctx, elem, path, namePath, plan.next, visiting, ve)
+	}
+
+	dv, isNilPtr := elem, false
+	if dv.Kind() == reflect.Ptr {
+		if dv.IsNil() {
+			isNilPtr = true
+		} else {
 			dv = dv.Elem()
 		}
-		if dv.Kind() == reflect.Struct {
-			return s.ValidateStruct(ctx, dv, path, ve)
+	}
+
+	if len(plan.rules) == 0 {
+		if dv.Kind() != reflect.Struct {
+			return s.record(
+				ve,
+				validation.FieldError{
+					Path:     path,
+					NamePath: namePath,
+					Rule:     tagDive,
+					Err:      fmt.Errorf("validateElem:\"dive\" requires struct element, got %s", dv.Kind()),
+				},
+			)
+		}
+		if elem.Kind() != reflect.Ptr {
+			return s.validateStruct(ctx, dv, dv, path, namePath, visiting, ve)
 		}
-		ve.Add(
-			validation.FieldError{
-				Path: path,
-				Rule: tagDive,
-				Err:  fmt.Errorf("validateElem:\"dive\" requires struct element, got %s", dv.Kind()),
-			},
-		)
+		ptr := elem.Pointer()
+		cyclic, leave := visiting.enter(ptr)
+		if cyclic {
+			return s.record(ve, validation.FieldError{
+				Path: path, NamePath: namePath, Rule: tagDive,
+				Err: fmt.Errorf("core: cycle detected validating %s, skipping to avoid infinite recursion", path),
+			})
+		}
+		err := s.validateStruct(ctx, dv, dv, path, namePath, visiting, ve)
+		leave()
+		return err
+	}
+
+	if isNilPtr {
 		return nil
 	}
 
-	for _, r := range rules {
+	for _, r := range plan.rules {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := s.applyRule(r.Name, elem, r.Params...); err != nil {
-			ve.Add(validation.FieldError{Path: path, Rule: r.Name, Params: r.Params, Err: err})
+		if err := s.applyRuleOrGroup(r, dv); err != nil {
+			if err := s.record(ve, validation.FieldError{Path: path, NamePath: namePath, Rule: r.Name, Alias: r.Alias, Params: r.Params, Err: err}); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// cycleGuard tracks the set of pointer addresses currently being recursed
+// into on the current traversal path, so a self-referential graph is caught
+// instead of overflowing the stack. Unlike a bare map, it is safe for
+// concurrent use: under WithValidationParallelism, sibling fields recurse on
+// different goroutines and may reach the same pointer address from two
+// different struct fields.
+type cycleGuard struct {
+	mu   sync.Mutex
+	seen map[uintptr]bool
+}
+
+// newCycleGuard returns an empty cycleGuard, ready to track one
+// ValidateStruct traversal.
+func newCycleGuard() *cycleGuard {
+	return &cycleGuard{seen: make(map[uintptr]bool)}
+}
+
+// enter reports whether ptr (a pointer address reached while descending into
+// a struct field or dive element) is already being visited somewhere on the
+// current traversal path — i.e. a self-referential graph has looped back on
+// itself. If not, it marks ptr visited and returns a leave func the caller
+// must call once its recursion into ptr returns, so a pointer reachable via
+// more than one path (a DAG, not a cycle) is still validated each time it's
+// reached.
+func (g *cycleGuard) enter(ptr uintptr) (cyclic bool, leave func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.seen[ptr] {
+		return true, func() {}
+	}
+	g.seen[ptr] = true
+	return false, func() {
+		g.mu.Lock()
+		delete(g.seen, ptr)
+		g.mu.Unlock()
+	}
+}
+
 // applyRule fetches the named rule from the registry and applies it to the given reflect.Value v,
-// passing any additional string parameters.
+// passing any additional string parameters. v is first passed through
+// extractTypeValue, so a type with a RegisterTypeFunc extractor registered
+// resolves its overload and runs against the extracted value rather than v itself.
 // If the rule is not found or fails, an error is returned.
 func (s *Service) applyRule(name string, v reflect.Value, params ...string) error {
+	v = s.extractTypeValue(v)
 	r, err := s.rulesRegistry.Get(name, v)
 	if err != nil {
 		return err
 	}
 	return r.GetValidationFn()(v, params...)
 }
+
+// applyRuleOrGroup runs r against v: a plain rule is applied directly via
+// applyRule, while an OR-group (len(r.Alts) > 0, see
+// validation.RuleNameParams.Alts) tries each alternative in turn and
+// short-circuits on the first one that passes. Only if every alternative
+// fails does it return a non-nil error, joining every alternative's failure
+// with errors.Join so the cause of each branch is preserved.
+func (s *Service) applyRuleOrGroup(r validation.RuleNameParams, v reflect.Value) error {
+	if len(r.Alts) == 0 {
+		return s.applyRule(r.Name, v, r.Params...)
+	}
+	var errs []error
+	for _, alt := range r.Alts {
+		if err := s.applyRule(alt.Name, v, alt.Params...); err == nil {
+			return nil
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyFieldRuleOrGroup is applyRuleOrGroup's field-tag counterpart, trying
+// each alternative via applyFieldRule so cross-field and field-level rules
+// (eqfield, required_if, ...) work as an OR-group alternative the same way
+// they do standalone.
+func (s *Service) applyFieldRuleOrGroup(r validation.RuleNameParams, v, parent, root reflect.Value, fieldPath string) error {
+	if len(r.Alts) == 0 {
+		return s.applyFieldRule(r.Name, v, parent, root, fieldPath, r.Params...)
+	}
+	var errs []error
+	for _, alt := range r.Alts {
+		if err := s.applyFieldRule(alt.Name, v, parent, root, fieldPath, alt.Params...); err == nil {
+			return nil
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyFieldRule is applyRule's field-tag counterpart: besides the plain
+// rules applyRule already handles, it recognizes two kinds of rule that need
+// more than v in isolation. A validation.CrossFieldRule (eqfield, gtfield,
+// ...) has its sibling field parameter resolved against parent — or root,
+// for a cross-struct rule such as eqcsfield — before the comparison runs. A
+// validation.FieldLevelRule (required_if, required_with, ...) receives a
+// validation.FieldLevel built from parent, root, and fieldPath instead of v
+// alone. Every other rule runs exactly as through applyRule.
+func (s *Service) applyFieldRule(name string, v, parent, root reflect.Value, fieldPath string, params ...string) error {
+	v = s.extractTypeValue(v)
+	r, err := s.rulesRegistry.Get(name, v)
+	if err != nil {
+		return err
+	}
+	if cfr, ok := r.(validation.CrossFieldRule); ok {
+		return s.applyCrossFieldRule(cfr, name, v, parent, root, params...)
+	}
+	if flr, ok := r.(validation.FieldLevelRule); ok {
+		fl := validation.NewFieldLevel(root, parent, v, fieldPath)
+		return flr.ValidateFieldLevel(fl, params...)
+	}
+	return r.GetValidationFn()(v, params...)
+}
+
+// applyFieldRuleCached is processValidateTag's entry point for a plain (non
+// OR-group) validate-tag rule: it behaves exactly like applyFieldRule, except
+// the rule's overload is resolved via resolvedFieldRule, which caches it per
+// (structType, fieldIndex, name) instead of re-resolving it against
+// rulesRegistry on every ValidateStruct call. OR-group alternatives still go
+// through applyFieldRuleOrGroup's uncached applyFieldRule, since caching each
+// alternative individually adds complexity for a much colder path.
+func (s *Service) applyFieldRuleCached(structType reflect.Type, fieldIndex int, name string, v, parent, root reflect.Value, fieldPath string, params ...string) error {
+	v = s.extractTypeValue(v)
+	r, err := s.resolvedFieldRule(structType, fieldIndex, name, v)
+	if err != nil {
+		return err
+	}
+	if cfr, ok := r.(validation.CrossFieldRule); ok {
+		return s.applyCrossFieldRule(cfr, name, v, parent, root, params...)
+	}
+	if flr, ok := r.(validation.FieldLevelRule); ok {
+		fl := validation.NewFieldLevel(root, parent, v, fieldPath)
+		return flr.ValidateFieldLevel(fl, params...)
+	}
+	return r.GetValidationFn()(v, params...)
+}
+
+// applyCrossFieldRule resolves cfr's sibling field parameter — relative to
+// parent for an eqfield-style rule, or to root when cfr.CrossStruct()
+// reports a cross-struct rule such as eqcsfield — then runs the comparison.
+func (s *Service) applyCrossFieldRule(cfr validation.CrossFieldRule, name string, v, parent, root reflect.Value, params ...string) error {
+	if len(params) == 0 {
+		return fmt.Errorf("%s requires a sibling field path parameter", name)
+	}
+	base := parent
+	if cfr.CrossStruct() {
+		base = root
+	}
+	other, err := resolveFieldPath(base, params[0])
+	if err != nil {
+		return err
+	}
+	return cfr.ValidateCrossField(v, other, params[0])
+}
+
+// resolveFieldPath navigates a dotted field path ("Address.City") from rv,
+// dereferencing pointers along the way. It is a small private duplicate of
+// validation's own fieldByPath, kept local rather than exported across the
+// package boundary for a single internal call site.
+func resolveFieldPath(rv reflect.Value, path string) (reflect.Value, error) {
+	cur := rv
+	for _, seg := range strings.Split(path, ".") {
+		for cur.Kind() == reflect.Ptr {
+			if cur.IsNil() {
+				return reflect.Value{}, fmt.Errorf("field %q: nil pointer before %q", path, seg)
+			}
+			cur = cur.Elem()
+		}
+		if cur.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("field %q: %q is not a struct field", path, seg)
+		}
+		field := cur.FieldByName(seg)
+		if !field.IsValid() {
+			return reflect.Value{}, fmt.Errorf("field %q: no such field %q", path, seg)
+		}
+		cur = field
+	}
+	return cur, nil
+}
@@ -0,0 +1,388 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func newTestService(t *testing.T, typ reflect.Type) *Service {
+	t.Helper()
+	reg := validation.NewRulesRegistry()
+	mapping := validation.NewMapping()
+	s, err := NewService(typ, reg, mapping)
+	if err != nil {
+		t.Fatalf("NewService error: %v", err)
+	}
+	return s
+}
+
+func mustAddRule[T any](t *testing.T, s *Service, name string, fn func(v T, params ...string) error) {
+	t.Helper()
+	r, err := validation.NewRule[T](name, fn)
+	if err != nil {
+		t.Fatalf("NewRule(%q) error: %v", name, err)
+	}
+	if err := s.AddRule(r); err != nil {
+		t.Fatalf("AddRule(%q) error: %v", name, err)
+	}
+}
+
+type tagsHolder struct {
+	Tags  []string          `validate:"required" validateElem:"dive,min(3)"`
+	Grid  [][]string        `validateElem:"dive,dive,min(2)"`
+	Attrs map[string]string `validateElem:"dive,keys,alphanum,endkeys,min(1)"`
+}
+
+func TestService_ValidateElements_dive(t *testing.T) {
+	t.Parallel()
+
+	obj := tagsHolder{Tags: []string{"ok", "no"}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Empty() {
+		t.Fatalf("expected a min(3) failure on Tags[1]")
+	}
+	fe := ve.ForField("Tags[1]")
+	if len(fe) != 1 || fe[0].Rule != "min" {
+		t.Fatalf("expected Tags[1] min failure, got %+v", fe)
+	}
+	if len(ve.ForField("Tags[0]")) != 0 {
+		t.Fatalf("expected Tags[0] to pass min(3)")
+	}
+}
+
+func TestService_ValidateElements_nestedDive(t *testing.T) {
+	t.Parallel()
+
+	obj := tagsHolder{Grid: [][]string{{"ab", "abc"}}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 2 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve.Empty() {
+		t.Fatalf("expected no failures, got %v", ve)
+	}
+
+	obj2 := tagsHolder{Grid: [][]string{{"a"}}}
+	ve2 := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj2), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve2.ForField("Grid[0][0]")) != 1 {
+		t.Fatalf("expected Grid[0][0] min failure, got %v", ve2)
+	}
+}
+
+func TestService_ValidateElements_keysEndkeys(t *testing.T) {
+	t.Parallel()
+
+	obj := tagsHolder{Attrs: map[string]string{"valid1": "x", "not valid": "y"}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "alphanum", func(v string, _ ...string) error {
+		for _, r := range v {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+				return errNotAlphanum
+			}
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 1 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Attrs[not valid](key)")
+	if len(fe) != 1 || fe[0].Rule != "alphanum" {
+		t.Fatalf("expected key alphanum failure on Attrs[not valid](key), got %+v", fe)
+	}
+	if len(ve.ForField("Attrs[valid1](key)")) != 0 {
+		t.Fatalf("expected Attrs[valid1] key to pass")
+	}
+}
+
+func TestService_ValidateElements_keyAndValueFailuresHaveDistinctPaths(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Attrs map[string]string `validateElem:"dive,keys,alphanum,endkeys,min(3)"`
+	}
+	obj := holder{Attrs: map[string]string{"bad key": "ok"}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "alphanum", func(v string, _ ...string) error {
+		for _, r := range v {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+				return errNotAlphanum
+			}
+		}
+		return nil
+	})
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Attrs[bad key](key)")) != 1 {
+		t.Fatalf("expected a key failure at the (key)-suffixed path, got %v", ve)
+	}
+	if len(ve.ForField("Attrs[bad key]")) != 1 {
+		t.Fatalf("expected a value failure at the bare path, got %v", ve)
+	}
+}
+
+func TestService_ValidateElements_tripleDive(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Cube [][][]string `validateElem:"dive,dive,dive,min(2)"`
+	}
+	obj := holder{Cube: [][][]string{{{"ab", "a"}}}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 2 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Cube[0][0][0]")) != 0 {
+		t.Fatalf("expected Cube[0][0][0] to pass min(2)")
+	}
+	if len(ve.ForField("Cube[0][0][1]")) != 1 {
+		t.Fatalf("expected Cube[0][0][1] min(2) failure, got %v", ve)
+	}
+}
+
+var (
+	errTooShort    = strErr("too short")
+	errNotAlphanum = strErr("not alphanumeric")
+)
+
+type strErr string
+
+func (e strErr) Error() string { return string(e) }
+
+func TestService_ValidateElements_diveStruct(t *testing.T) {
+	t.Parallel()
+
+	type inner struct {
+		Name string `validate:"required"`
+	}
+	type outer struct {
+		Items []inner `validateElem:"dive"`
+	}
+
+	obj := outer{Items: []inner{{Name: "ok"}, {Name: ""}}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("Items[1].Name")
+	if len(fe) != 1 {
+		t.Fatalf("expected Items[1].Name required failure, got %v", ve)
+	}
+	if !strings.Contains(ve.Error(), "Items[1].Name") {
+		t.Fatalf("expected error message to reference indexed path, got %v", ve.Error())
+	}
+}
+
+func TestService_ValidateElements_divePointerElem(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Names []*string `validateElem:"dive,min(3)"`
+	}
+
+	ok, short := "abcd", "ab"
+	obj := holder{Names: []*string{&ok, nil, &short}}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Names[0]")) != 0 {
+		t.Fatalf("expected Names[0] to pass min(3) after deref, got %v", ve)
+	}
+	if len(ve.ForField("Names[1]")) != 0 {
+		t.Fatalf("expected nil Names[1] to be skipped rather than erroring, got %v", ve)
+	}
+	if fe := ve.ForField("Names[2]"); len(fe) != 1 || fe[0].Rule != "min" {
+		t.Fatalf("expected Names[2] min failure, got %+v", fe)
+	}
+}
+
+func TestService_ValidateStruct_failFastStopsAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+	}
+
+	obj := holder{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	fs := s.WithValidationMode(ModeFailFast)
+
+	ve := &validation.Error{}
+	if err := fs.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("expected fail-fast to stop after the first failure, got %v", ve)
+	}
+}
+
+func TestService_ValidateStruct_collectAllGathersEveryFailure(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+	}
+
+	obj := holder{}
+	s := newTestService(t, reflect.TypeOf(obj))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	// ModeCollectAll is the zero value, so s itself already behaves this way;
+	// scoping through it explicitly exercises WithValidationMode's other branch.
+	ca := s.WithValidationMode(ModeCollectAll)
+
+	ve := &validation.Error{}
+	if err := ca.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Len() != 2 {
+		t.Fatalf("expected both A and B failures collected, got %v", ve)
+	}
+}
+
+func TestService_ValidateStruct_cyclePtrField(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		Name string `validate:"required"`
+		Next *node
+	}
+
+	a := &node{Name: "a"}
+	b := &node{Name: "b"}
+	a.Next = b
+	b.Next = a // self-referential cycle
+
+	s := newTestService(t, reflect.TypeOf(node{}))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(a).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Next.Next.Next")) != 1 {
+		t.Fatalf("expected a single cycle error recorded at Next.Next.Next, got %v", ve)
+	}
+}
+
+func TestService_ValidateElements_diveCyclePtr(t *testing.T) {
+	t.Parallel()
+
+	type tree struct {
+		Name     string  `validate:"required"`
+		Children []*tree `validateElem:"dive"`
+	}
+
+	root := &tree{Name: "root"}
+	child := &tree{Name: "child"}
+	root.Children = []*tree{child}
+	child.Children = []*tree{root} // self-referential cycle via dive
+
+	s := newTestService(t, reflect.TypeOf(tree{}))
+	mustAddRule[string](t, s, "required", func(v string, _ ...string) error {
+		if v == "" {
+			return errTooShort
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(root).Elem(), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Children[0].Children[0].Children[0]")) != 1 {
+		t.Fatalf("expected a single cycle error recorded at Children[0].Children[0].Children[0], got %v", ve)
+	}
+}
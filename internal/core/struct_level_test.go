@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+func TestService_AddStructLevelRule_crossFieldCheck(t *testing.T) {
+	t.Parallel()
+
+	type passwords struct {
+		Password        string
+		PasswordConfirm string
+	}
+
+	typ := reflect.TypeOf(passwords{})
+	s := newTestService(t, typ)
+	s.AddStructLevelRule(typ, func(_ context.Context, sl StructLevel) error {
+		pw := sl.Field("Password").String()
+		confirm := sl.Field("PasswordConfirm").String()
+		if pw != confirm {
+			sl.ReportError("PasswordConfirm", "eqfield", fmt.Errorf("must match Password"))
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	obj := passwords{Password: "secret", PasswordConfirm: "other"}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	fe := ve.ForField("PasswordConfirm")
+	if len(fe) != 1 || fe[0].Rule != "eqfield" {
+		t.Fatalf("expected a single PasswordConfirm eqfield failure, got %+v", fe)
+	}
+
+	ve2 := &validation.Error{}
+	obj2 := passwords{Password: "secret", PasswordConfirm: "secret"}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj2), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve2.Empty() {
+		t.Fatalf("expected no failures when passwords match, got %v", ve2)
+	}
+}
+
+func TestService_AddStructLevelRule_reportsMultipleIndependentFailures(t *testing.T) {
+	t.Parallel()
+
+	type window struct {
+		Start, End int
+		A, B, C    bool
+	}
+
+	typ := reflect.TypeOf(window{})
+	s := newTestService(t, typ)
+	s.AddStructLevelRule(typ, func(_ context.Context, sl StructLevel) error {
+		start := sl.Field("Start").Int()
+		end := sl.Field("End").Int()
+		if start >= end {
+			sl.ReportError("End", "gtfield", errors.New("End must be after Start"))
+		}
+
+		set := 0
+		for _, name := range []string{"A", "B", "C"} {
+			if sl.Field(name).Bool() {
+				set++
+			}
+		}
+		if set != 1 {
+			sl.ReportError("", "exactlyone", fmt.Errorf("exactly one of A/B/C must be set, got %d", set))
+		}
+		return nil
+	})
+
+	ve := &validation.Error{}
+	obj := window{Start: 10, End: 5, A: true, B: true}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Len() != 2 {
+		t.Fatalf("expected two independent failures, got %v", ve)
+	}
+	if len(ve.ForField("End")) != 1 {
+		t.Fatalf("expected End failure, got %v", ve)
+	}
+	if len(ve.ForField("")) != 1 {
+		t.Fatalf("expected a struct-level (empty-path) failure, got %v", ve)
+	}
+}
+
+func TestService_AddStructLevelRule_runsForEmbeddedAndDivedStructs(t *testing.T) {
+	t.Parallel()
+
+	type rangeFields struct {
+		Start, End int
+	}
+	// An exported name for the embedded field: buildPlan skips unexported
+	// fields, an embedded unexported type included.
+	type RangeFields = rangeFields
+	type withEmbedded struct {
+		RangeFields
+	}
+	type holder struct {
+		Ranges []rangeFields `validateElem:"dive"`
+	}
+
+	rangeTyp := reflect.TypeOf(rangeFields{})
+
+	t.Run("embedded", func(t *testing.T) {
+		typ := reflect.TypeOf(withEmbedded{})
+		s := newTestService(t, typ)
+		s.AddStructLevelRule(rangeTyp, func(_ context.Context, sl StructLevel) error {
+			if sl.Field("Start").Int() >= sl.Field("End").Int() {
+				sl.ReportError("End", "gtfield", errors.New("End must be after Start"))
+			}
+			return nil
+		})
+
+		ve := &validation.Error{}
+		obj := withEmbedded{RangeFields{Start: 5, End: 1}}
+		if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+			t.Fatalf("ValidateStruct: %v", err)
+		}
+		if len(ve.ForField("RangeFields.End")) != 1 {
+			t.Fatalf("expected the embedded struct's hook to run, got %v", ve)
+		}
+	})
+
+	t.Run("dive", func(t *testing.T) {
+		typ := reflect.TypeOf(holder{})
+		s := newTestService(t, typ)
+		s.AddStructLevelRule(rangeTyp, func(_ context.Context, sl StructLevel) error {
+			if sl.Field("Start").Int() >= sl.Field("End").Int() {
+				sl.ReportError("End", "gtfield", errors.New("End must be after Start"))
+			}
+			return nil
+		})
+
+		ve := &validation.Error{}
+		obj := holder{Ranges: []rangeFields{{Start: 1, End: 5}, {Start: 9, End: 2}}}
+		if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+			t.Fatalf("ValidateStruct: %v", err)
+		}
+		if len(ve.ForField("Ranges[0].End")) != 0 {
+			t.Fatalf("expected Ranges[0] to pass, got %v", ve)
+		}
+		if len(ve.ForField("Ranges[1].End")) != 1 {
+			t.Fatalf("expected the dive'd hook to run for Ranges[1], got %v", ve)
+		}
+	})
+}
+
+func TestService_AddStructLevelRule_failFastStopsFurtherReports(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		A, B, C bool
+	}
+	typ := reflect.TypeOf(holder{})
+	s := newTestService(t, typ)
+	reportCount := 0
+	s.AddStructLevelRule(typ, func(_ context.Context, sl StructLevel) error {
+		sl.ReportError("A", "bad", errors.New("first"))
+		reportCount++
+		sl.ReportError("B", "bad", errors.New("second"))
+		reportCount++
+		return nil
+	})
+
+	fs := s.WithValidationMode(ModeFailFast)
+	ve := &validation.Error{}
+	if err := fs.ValidateStruct(context.Background(), reflect.ValueOf(holder{}), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("expected fail-fast to keep only the first report, got %v", ve)
+	}
+	if reportCount != 2 {
+		t.Fatalf("expected the hook body to still run to completion, got %d calls", reportCount)
+	}
+}
@@ -0,0 +1,38 @@
+package core
+
+// Struct tag names recognized by Service when walking a struct value.
+const (
+	tagValidate     = "validate"     // per-field validation rules
+	tagValidateElem = "validateElem" // per-element validation rules for slices, arrays and maps
+	tagDefault      = "default"      // per-field default literal
+	tagDefaultElem  = "defaultElem"  // per-element default recursion for slices, arrays and maps
+
+	// tagDefaultRole and tagValidateRole gate a field's default/validate (and
+	// defaultElem/validateElem) tags behind an active-role predicate; see
+	// rolePredicate and Service.WithActiveRoles.
+	tagDefaultRole  = "defaultRole"
+	tagValidateRole = "validateRole"
+
+	// tagDefaultIf gates a field's default and defaultFrom tags behind a
+	// predicate evaluated against a sibling field ("OtherField==value",
+	// "OtherField!=value", "OtherField empty", "OtherField notempty"); see
+	// conditional_defaults.go.
+	tagDefaultIf = "defaultIf"
+	// tagDefaultFrom copies a sibling field's value when the current field is
+	// zero, named by Go field name within the same struct; see
+	// conditional_defaults.go.
+	tagDefaultFrom = "defaultFrom"
+)
+
+// defaultNameTag is the struct tag Service reads alternate field names from
+// for FieldError.NamePath when SetNameTag has not been called.
+const defaultNameTag = "json"
+
+// Tokens with special meaning inside a validateElem (or default/defaultElem)
+// tag's rule list.
+const (
+	tagAlloc   = "alloc"   // allocate an empty slice/map when the field is nil (default tag only)
+	tagDive    = "dive"    // descend into slice/array elements or map values
+	tagKeys    = "keys"    // begin a map-key rule segment (must be followed by endkeys)
+	tagEndKeys = "endkeys" // end a map-key rule segment; rules after it apply to values
+)
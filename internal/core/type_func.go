@@ -0,0 +1,44 @@
+package core
+
+import "reflect"
+
+// RegisterTypeFunc registers fn as the extractor applyRule/applyFieldRule
+// use to unwrap a field of one of types into the primitive reflect.Value
+// rule overload resolution and invocation should actually see, e.g. reducing
+// a sql.NullString to its String field, or a uuid.UUID to its string form.
+// fn is consulted for both rule overload lookup (rulesRegistry.Get) and the
+// value passed to the resolved rule, so a single "min=3" rule can run
+// uniformly on a string field and on a wrapper type registered here. If fn
+// returns an invalid reflect.Value (its zero value) for a given v, the
+// original v is used instead rather than the field being skipped.
+func (s *Service) RegisterTypeFunc(fn func(reflect.Value) reflect.Value, types ...reflect.Type) {
+	for _, t := range types {
+		s.typeFuncs.Store(t, fn)
+	}
+}
+
+// typeFuncFor returns the extractor registered for t via RegisterTypeFunc, if any.
+func (s *Service) typeFuncFor(t reflect.Type) (func(reflect.Value) reflect.Value, bool) {
+	fn, ok := s.typeFuncs.Load(t)
+	if !ok {
+		return nil, false
+	}
+	return fn.(func(reflect.Value) reflect.Value), true
+}
+
+// extractTypeValue returns the value a rule should resolve its overload
+// against and run on: v itself, unless a RegisterTypeFunc extractor is
+// registered for v.Type() and returns a valid reflect.Value.
+func (s *Service) extractTypeValue(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	fn, ok := s.typeFuncFor(v.Type())
+	if !ok {
+		return v
+	}
+	if out := fn(v); out.IsValid() {
+		return out
+	}
+	return v
+}
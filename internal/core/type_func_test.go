@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// wrappedString mimics a database/sql/driver.Valuer-like wrapper type, e.g. sql.NullString.
+type wrappedString struct {
+	Valid  bool
+	String string
+}
+
+func TestService_RegisterTypeFunc_unwrapsBeforeRuleResolution(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code wrappedString `validate:"min(3)"`
+	}
+	typ := reflect.TypeOf(holder{})
+	s := newTestService(t, typ)
+	mustAddRule[string](t, s, "min", func(v string, params ...string) error {
+		if len(params) == 1 && len(v) < 3 {
+			return errTooShort
+		}
+		return nil
+	})
+	s.RegisterTypeFunc(func(v reflect.Value) reflect.Value {
+		ws := v.Interface().(wrappedString)
+		if !ws.Valid {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(ws.String)
+	}, reflect.TypeOf(wrappedString{}))
+
+	ve := &validation.Error{}
+	obj := holder{Code: wrappedString{Valid: true, String: "ab"}}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if len(ve.ForField("Code")) != 1 {
+		t.Fatalf("expected the unwrapped string to fail min(3), got %v", ve)
+	}
+
+	ve2 := &validation.Error{}
+	obj2 := holder{Code: wrappedString{Valid: true, String: "abcd"}}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj2), "", ve2); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if !ve2.Empty() {
+		t.Fatalf("expected the unwrapped string to pass min(3), got %v", ve2)
+	}
+}
+
+func TestService_RegisterTypeFunc_invalidResultFallsBackToOriginalValue(t *testing.T) {
+	t.Parallel()
+
+	type holder struct {
+		Code wrappedString `validate:"required"`
+	}
+	typ := reflect.TypeOf(holder{})
+	s := newTestService(t, typ)
+	var seen reflect.Type
+	mustAddRule[wrappedString](t, s, "required", func(v wrappedString, _ ...string) error {
+		seen = reflect.TypeOf(v)
+		if !v.Valid {
+			return errTooShort
+		}
+		return nil
+	})
+	s.RegisterTypeFunc(func(v reflect.Value) reflect.Value {
+		return reflect.Value{} // always invalid, so the original v should be used
+	}, reflect.TypeOf(wrappedString{}))
+
+	ve := &validation.Error{}
+	obj := holder{Code: wrappedString{Valid: false}}
+	if err := s.ValidateStruct(context.Background(), reflect.ValueOf(obj), "", ve); err != nil {
+		t.Fatalf("ValidateStruct: %v", err)
+	}
+	if seen != reflect.TypeOf(wrappedString{}) {
+		t.Fatalf("expected the rule to still see the original wrappedString type, got %v", seen)
+	}
+	if len(ve.ForField("Code")) != 1 {
+		t.Fatalf("expected the required rule to run against the original value, got %v", ve)
+	}
+}
@@ -0,0 +1,19 @@
+package core
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newUUIDv4 generates a random (version 4, variant 1) UUID per RFC 4122,
+// for the "uuid" built-in default resolver. It only fails if the system
+// entropy source is unavailable.
+func newUUIDv4() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generate uuid: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// ValidationMode controls how Service.ValidateStruct reacts to a failing
+// rule, mirroring the top-level model package's ValidationMode/
+// WithValidationMode (see Binding.WithValidationMode, which converts between
+// the two at the package boundary).
+type ValidationMode int
+
+const (
+	// ModeCollectAll runs every declared rule against every field, struct,
+	// and element, accumulating every failure into the returned
+	// *validation.Error. This is the default.
+	ModeCollectAll ValidationMode = iota
+	// ModeFailFast stops at the first failing rule, returning a
+	// *validation.Error holding that single FieldError.
+	ModeFailFast
+)
+
+// WithValidationMode returns a Service scoped to mode. Like WithActiveRoles,
+// the returned Service shares this Service's registry, mapping, and every
+// other extension point; only the validation mode differs.
+func (s *Service) WithValidationMode(mode ValidationMode) *Service {
+	scoped := s.scopeOf()
+	scoped.validationMode = mode
+	return scoped
+}
+
+// errFailFastStop is an internal sentinel returned by Service.record to
+// unwind validateStruct/applyElemPlan/applyElemLevel as soon as a rule fails
+// under ModeFailFast. It is never exposed to callers: ValidateStruct
+// recognizes it and returns ve (already holding the single recorded
+// FieldError) instead of propagating it as a real error.
+var errFailFastStop = errors.New("core: fail-fast stop")
+
+// record adds fe to ve and, under ModeFailFast, returns errFailFastStop so
+// the caller unwinds immediately instead of evaluating further rules,
+// fields, or elements. Under ModeCollectAll (the default) it always returns
+// nil, matching the historical behavior of a bare ve.Add.
+func (s *Service) record(ve *validation.Error, fe validation.FieldError) error {
+	ve.Add(fe)
+	if s.validationMode == ModeFailFast {
+		return errFailFastStop
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package core
+
+// WithValidationParallelism returns a Service scoped to validate the direct
+// fields of each struct level concurrently, on a worker pool bounded to n
+// goroutines at a time, instead of ValidateStruct's default sequential field
+// loop. This only helps when at least one registered rule genuinely blocks
+// (e.g. a user-supplied WithRule that hits a database to check uniqueness);
+// for the built-in in-memory rules it mostly adds goroutine overhead.
+//
+// Results are merged into the same *validation.Error regardless of which
+// goroutine produced them, since validation.Error.Add is already safe for
+// concurrent use; so are the RulesRegistry and RulesMapping lookups every
+// field validation goes through. Under ModeFailFast, the first field to fail
+// at a given struct level cancels its siblings still in flight there via a
+// context derived locally for that level, so a long-running rule doesn't
+// keep running after the result is already decided; under the default
+// ModeCollectAll every field still runs to completion.
+//
+// n <= 1 disables parallelism (the default, and the previous behavior). Like
+// WithValidationMode, the returned Service shares this Service's registry,
+// mapping, and every other extension point; only the parallelism differs.
+func (s *Service) WithValidationParallelism(n int) *Service {
+	scoped := s.scopeOf()
+	scoped.validationParallelism = n
+	return scoped
+}
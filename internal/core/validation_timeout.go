@@ -0,0 +1,21 @@
+package core
+
+import "time"
+
+// WithValidationTimeout returns a Service scoped to a per-call validation
+// deadline: ValidateStruct wraps the context it is given in
+// context.WithTimeout(ctx, d) before walking, so a rule that never returns
+// (e.g. a user-supplied rule that hits a database to check uniqueness)
+// cannot hang a validation run forever. The walker already checks ctx.Err()
+// between every field, element, and rule (see validateStruct), so the
+// resulting context.DeadlineExceeded propagates out of ValidateStruct like
+// any caller-supplied cancellation would.
+//
+// Like WithValidationMode, the returned Service shares this Service's
+// registry, mapping, and every other extension point; only the timeout
+// differs. d <= 0 disables the timeout, ValidateStruct's default behavior.
+func (s *Service) WithValidationTimeout(d time.Duration) *Service {
+	scoped := s.scopeOf()
+	scoped.validationTimeout = d
+	return scoped
+}
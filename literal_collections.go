@@ -0,0 +1,141 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// setSliceLiteral parses lit as a comma-separated list — optionally wrapped
+// in "[...]" — and sets target (a zero, settable slice) to the result. Each
+// token is parsed through setScalarLiteral against target's element type, so
+// a []time.Duration, []net.IP, or []time.Time field is parsed the same way
+// its scalar counterpart would be.
+func setSliceLiteral(target reflect.Value, lit string) error {
+	tokens := tokenizeList(lit)
+	elemType := target.Type().Elem()
+	out := reflect.MakeSlice(target.Type(), len(tokens), len(tokens))
+	for i, tok := range tokens {
+		ev := reflect.New(elemType).Elem()
+		if err := setScalarLiteral(ev, tok); err != nil {
+			return fmt.Errorf("element %d (%q): %w", i, tok, err)
+		}
+		out.Index(i).Set(ev)
+	}
+	target.Set(out)
+	return nil
+}
+
+// setMapLiteral parses lit as a "{k1:v1,k2:v2}" literal and sets target (a
+// zero, settable map) to the result. Each key and value is parsed through
+// setScalarLiteral against target's key/element type respectively.
+func setMapLiteral(target reflect.Value, lit string) error {
+	body := strings.TrimSpace(lit)
+	body = strings.TrimPrefix(body, "{")
+	body = strings.TrimSuffix(body, "}")
+
+	keyType := target.Type().Key()
+	valType := target.Type().Elem()
+
+	pairs := tokenizeList(body)
+	out := reflect.MakeMapWithSize(target.Type(), len(pairs))
+	for _, pair := range pairs {
+		if pair == "" {
+			continue
+		}
+		k, v, ok := splitMapPair(pair)
+		if !ok {
+			return fmt.Errorf("invalid map entry %q: missing ':'", pair)
+		}
+		kv := reflect.New(keyType).Elem()
+		if err := setScalarLiteral(kv, k); err != nil {
+			return fmt.Errorf("map key %q: %w", k, err)
+		}
+		vv := reflect.New(valType).Elem()
+		if err := setScalarLiteral(vv, v); err != nil {
+			return fmt.Errorf("map value %q: %w", v, err)
+		}
+		out.SetMapIndex(kv, vv)
+	}
+	target.Set(out)
+	return nil
+}
+
+// tokenizeList splits s on top-level commas, honoring single- and
+// double-quoted spans and backslash-escaped characters so a quoted or
+// escaped comma does not end a token. A leading "[" and trailing "]" are
+// stripped first, so both "[a,b,c]" and "a,b,c" are accepted. An empty (or
+// whitespace-only, post-bracket-stripping) input yields no tokens, so
+// `default:"[]"` produces an empty slice rather than a slice with one empty
+// element.
+func tokenizeList(s string) []string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "[")
+	s = strings.TrimSuffix(s, "]")
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	var tokens []string
+	var cur strings.Builder
+	var inQuote byte
+	escaped := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case escaped:
+			cur.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case inQuote != 0:
+			if c == inQuote {
+				inQuote = 0
+			} else {
+				cur.WriteByte(c)
+			}
+		case c == '"' || c == '\'':
+			inQuote = c
+		case c == ',':
+			tokens = append(tokens, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	tokens = append(tokens, strings.TrimSpace(cur.String()))
+	return tokens
+}
+
+// splitMapPair splits a single "{...}" entry on its first top-level colon
+// (honoring quotes and escapes the same way tokenizeList does), returning
+// ok=false if pair carries none.
+func splitMapPair(pair string) (key, value string, ok bool) {
+	var cur strings.Builder
+	var inQuote byte
+	escaped := false
+	for i := 0; i < len(pair); i++ {
+		c := pair[i]
+		switch {
+		case escaped:
+			cur.WriteByte(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case inQuote != 0:
+			if c == inQuote {
+				inQuote = 0
+			} else {
+				cur.WriteByte(c)
+			}
+		case c == '"' || c == '\'':
+			inQuote = c
+		case c == ':':
+			return strings.TrimSpace(cur.String()), strings.TrimSpace(pair[i+1:]), true
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type literalCollectionsHolder struct {
+	Tags    []string
+	Nums    []int
+	Waits   []time.Duration
+	Escaped []string
+
+	Labels map[string]string
+	Counts map[string]int
+
+	Endpoint url.URL
+	Host     net.IP
+
+	JSONDoc jsonDefaultDoc
+}
+
+type jsonDefaultDoc struct {
+	raw string
+}
+
+func (d *jsonDefaultDoc) UnmarshalJSON(b []byte) error {
+	d.raw = string(b)
+	return nil
+}
+
+func literalField(h *literalCollectionsHolder, name string) reflect.Value {
+	return reflect.ValueOf(h).Elem().FieldByName(name)
+}
+
+func TestSetLiteralDefault_sliceLiteral(t *testing.T) {
+	h := &literalCollectionsHolder{}
+	if err := setLiteralDefault(literalField(h, "Tags"), "[a,b,c]", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.Tags) != 3 || h.Tags[0] != "a" || h.Tags[1] != "b" || h.Tags[2] != "c" {
+		t.Fatalf("expected [a b c], got %v", h.Tags)
+	}
+
+	if err := setLiteralDefault(literalField(h, "Nums"), "1,2,3", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.Nums) != 3 || h.Nums[0] != 1 || h.Nums[1] != 2 || h.Nums[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", h.Nums)
+	}
+
+	if err := setLiteralDefault(literalField(h, "Waits"), "[1s,2s]", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.Waits) != 2 || h.Waits[0] != time.Second || h.Waits[1] != 2*time.Second {
+		t.Fatalf("expected [1s 2s], got %v", h.Waits)
+	}
+
+	if err := setLiteralDefault(literalField(h, "Escaped"), `[a\,b,c]`, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(h.Escaped) != 2 || h.Escaped[0] != "a,b" || h.Escaped[1] != "c" {
+		t.Fatalf("expected an escaped comma to stay inside its token, got %v", h.Escaped)
+	}
+}
+
+func TestSetLiteralDefault_mapLiteral(t *testing.T) {
+	h := &literalCollectionsHolder{}
+	if err := setLiteralDefault(literalField(h, "Labels"), "{env:prod,region:eu-west-1}", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Labels["env"] != "prod" || h.Labels["region"] != "eu-west-1" {
+		t.Fatalf("expected map literal to populate both entries, got %v", h.Labels)
+	}
+
+	if err := setLiteralDefault(literalField(h, "Counts"), "{a:1,b:2}", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Counts["a"] != 1 || h.Counts["b"] != 2 {
+		t.Fatalf("expected int-valued map literal, got %v", h.Counts)
+	}
+}
+
+func TestSetLiteralDefault_urlAndIP(t *testing.T) {
+	h := &literalCollectionsHolder{}
+	if err := setLiteralDefault(literalField(h, "Endpoint"), "https://example.com/path", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Endpoint.Host != "example.com" || h.Endpoint.Path != "/path" {
+		t.Fatalf("expected parsed url.URL, got %+v", h.Endpoint)
+	}
+
+	if err := setLiteralDefault(literalField(h, "Host"), "127.0.0.1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.Host.String() != "127.0.0.1" {
+		t.Fatalf("expected net.IP parsed via TextUnmarshaler, got %v", h.Host)
+	}
+}
+
+func TestSetLiteralDefault_jsonUnmarshaler(t *testing.T) {
+	h := &literalCollectionsHolder{}
+	if err := setLiteralDefault(literalField(h, "JSONDoc"), `{"a":1}`, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h.JSONDoc.raw != `{"a":1}` {
+		t.Fatalf("expected raw JSON passed through unchanged, got %q", h.JSONDoc.raw)
+	}
+}
+
+func TestTokenizeList(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"[]", nil},
+		{"a,b,c", []string{"a", "b", "c"}},
+		{"[a,b,c]", []string{"a", "b", "c"}},
+		{`"a,b",c`, []string{"a,b", "c"}},
+		{`a\,b,c`, []string{"a,b", "c"}},
+	}
+	for _, tc := range cases {
+		got := tokenizeList(tc.in)
+		if len(got) != len(tc.want) {
+			t.Fatalf("tokenizeList(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("tokenizeList(%q) = %v, want %v", tc.in, got, tc.want)
+			}
+		}
+	}
+}
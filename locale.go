@@ -0,0 +1,119 @@
+package model
+
+import "sync"
+
+// localeCatalogsMu guards localeCatalogs against concurrent reads (from
+// NewLocaleTranslator) and writes (from RegisterLocale).
+var localeCatalogsMu sync.RWMutex
+
+// localeCatalogs holds, per locale, a message template for each built-in rule
+// understood by NewLocaleTranslator. Templates use the same "{field}"/
+// "{param0}", "{param1}", ... placeholders as RenderMessageTemplate. Only the
+// rules with the widest everyday use (nonempty, oneof, positive, nonzero,
+// min, max, email, uuid) are covered; everything else falls back through the
+// chain described on NewLocaleTranslator.
+//
+// The fr/nl/ja/zh catalogs are intentionally small starter translations, not
+// a claim of native-speaker-reviewed copy; contributions filling these out
+// are welcome.
+var localeCatalogs = map[string]map[string]string{
+	"en": {
+		"nonempty": "{field} must not be empty",
+		"oneof":    "{field} must be one of the allowed values",
+		"positive": "{field} must be positive",
+		"nonzero":  "{field} must not be zero",
+		"min":      "{field} must be at least {param0}",
+		"max":      "{field} must be at most {param0}",
+		"email":    "{field} must be a valid email address",
+		"uuid":     "{field} must be a valid UUID",
+	},
+	"fr": {
+		"nonempty": "{field} ne doit pas être vide",
+		"oneof":    "{field} doit être une des valeurs autorisées",
+		"positive": "{field} doit être positif",
+		"nonzero":  "{field} ne doit pas être zéro",
+		"min":      "{field} doit être au moins {param0}",
+		"max":      "{field} doit être au plus {param0}",
+		"email":    "{field} doit être une adresse e-mail valide",
+		"uuid":     "{field} doit être un UUID valide",
+	},
+	"nl": {
+		"nonempty": "{field} mag niet leeg zijn",
+		"oneof":    "{field} moet een van de toegestane waarden zijn",
+		"positive": "{field} moet positief zijn",
+		"nonzero":  "{field} mag niet nul zijn",
+		"min":      "{field} moet minstens {param0} zijn",
+		"max":      "{field} mag hoogstens {param0} zijn",
+		"email":    "{field} moet een geldig e-mailadres zijn",
+		"uuid":     "{field} moet een geldige UUID zijn",
+	},
+	"ja": {
+		"nonempty": "{field}は空にできません",
+		"oneof":    "{field}は許可された値のいずれかである必要があります",
+		"positive": "{field}は正の値である必要があります",
+		"nonzero":  "{field}はゼロであってはいけません",
+		"min":      "{field}は{param0}以上である必要があります",
+		"max":      "{field}は{param0}以下である必要があります",
+		"email":    "{field}は有効なメールアドレスである必要があります",
+		"uuid":     "{field}は有効なUUIDである必要があります",
+	},
+	"zh": {
+		"nonempty": "{field}不能为空",
+		"oneof":    "{field}必须是允许值之一",
+		"positive": "{field}必须为正数",
+		"nonzero":  "{field}不能为零",
+		"min":      "{field}必须至少为{param0}",
+		"max":      "{field}必须至多为{param0}",
+		"email":    "{field}必须是有效的电子邮件地址",
+		"uuid":     "{field}必须是有效的UUID",
+	},
+}
+
+// RegisterLocale adds templates to the message catalog for locale, consulted
+// by NewLocaleTranslator. A call for a locale that already exists (including
+// a built-in one) merges templates into it rather than replacing it, so
+// callers can ship a project-specific catalog (e.g. a "de" translation, or
+// additional rule names for an existing locale) without forking the
+// package. templates uses the same "{field}"/"{param0}", "{param1}", ...
+// placeholders as RenderMessageTemplate.
+func RegisterLocale(locale string, templates map[string]string) {
+	localeCatalogsMu.Lock()
+	defer localeCatalogsMu.Unlock()
+	catalog, ok := localeCatalogs[locale]
+	if !ok {
+		catalog = make(map[string]string, len(templates))
+		localeCatalogs[locale] = catalog
+	}
+	for rule, tpl := range templates {
+		catalog[rule] = tpl
+	}
+}
+
+// RegisterLocaleRule adds or overrides a single rule's template within
+// locale's catalog, without disturbing its other entries. It is a thin
+// convenience over RegisterLocale for the common case of adding/overriding
+// one rule at a time (e.g. a project-specific rule name the built-in
+// catalogs don't cover).
+func RegisterLocaleRule(locale, rule, template string) {
+	RegisterLocale(locale, map[string]string{rule: template})
+}
+
+// NewLocaleTranslator returns a Translator backed by the built-in message
+// catalog for locale (e.g. "en", "fr", "nl", "ja", "zh"), plus anything
+// registered for it via RegisterLocale. For a FieldError whose Rule or whose
+// locale has no catalog entry, it falls back to the "en" catalog, and
+// finally to fe.Error() if even "en" has no entry for that rule. Attach it
+// with WithTranslator, or wrap it to add project-specific rules.
+func NewLocaleTranslator(locale string) Translator {
+	return TranslatorFunc(func(fe FieldError) string {
+		localeCatalogsMu.RLock()
+		defer localeCatalogsMu.RUnlock()
+		if tpl, ok := localeCatalogs[locale][fe.Rule]; ok {
+			return RenderMessageTemplate(tpl, fe)
+		}
+		if tpl, ok := localeCatalogs["en"][fe.Rule]; ok {
+			return RenderMessageTemplate(tpl, fe)
+		}
+		return fe.Error()
+	})
+}
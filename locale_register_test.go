@@ -0,0 +1,53 @@
+package model
+
+import "testing"
+
+func TestRegisterLocale(t *testing.T) {
+	t.Parallel()
+
+	RegisterLocale("de", map[string]string{
+		"nonempty": "{field} darf nicht leer sein",
+	})
+
+	de := NewLocaleTranslator("de")
+	if got := de.Translate(FieldError{Path: "Name", Rule: "nonempty"}); got != "Name darf nicht leer sein" {
+		t.Fatalf("unexpected de translation: %q", got)
+	}
+
+	// Rules not covered by the registered catalog still fall back to "en".
+	if got := de.Translate(FieldError{Path: "Color", Rule: "oneof"}); got != "Color must be one of the allowed values" {
+		t.Fatalf("unexpected de fallback translation: %q", got)
+	}
+
+	// Registering again for the same locale merges, it does not replace.
+	RegisterLocale("de", map[string]string{
+		"positive": "{field} muss positiv sein",
+	})
+	if got := de.Translate(FieldError{Path: "Name", Rule: "nonempty"}); got != "Name darf nicht leer sein" {
+		t.Fatalf("earlier registration for locale was lost: %q", got)
+	}
+	if got := de.Translate(FieldError{Path: "Age", Rule: "positive"}); got != "Age muss positiv sein" {
+		t.Fatalf("unexpected merged de translation: %q", got)
+	}
+}
+
+func TestRegisterLocaleRule(t *testing.T) {
+	t.Parallel()
+
+	RegisterLocaleRule("it", "nonempty", "{field} non deve essere vuoto")
+
+	it := NewLocaleTranslator("it")
+	if got := it.Translate(FieldError{Path: "Name", Rule: "nonempty"}); got != "Name non deve essere vuoto" {
+		t.Fatalf("unexpected it translation: %q", got)
+	}
+
+	// A single rule registered this way doesn't disturb other rules already
+	// registered for the same locale.
+	RegisterLocaleRule("it", "positive", "{field} deve essere positivo")
+	if got := it.Translate(FieldError{Path: "Name", Rule: "nonempty"}); got != "Name non deve essere vuoto" {
+		t.Fatalf("earlier rule registration for locale was lost: %q", got)
+	}
+	if got := it.Translate(FieldError{Path: "Age", Rule: "positive"}); got != "Age deve essere positivo" {
+		t.Fatalf("unexpected added it translation: %q", got)
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewLocaleTranslator(t *testing.T) {
+	t.Parallel()
+
+	fe := FieldError{Path: "Name", Rule: "nonempty"}
+
+	fr := NewLocaleTranslator("fr")
+	if got := fr.Translate(fe); got != "Name ne doit pas être vide" {
+		t.Fatalf("unexpected fr translation: %q", got)
+	}
+
+	// Unknown locale falls back to "en".
+	unknown := NewLocaleTranslator("xx")
+	if got := unknown.Translate(fe); got != "Name must not be empty" {
+		t.Fatalf("unexpected fallback translation: %q", got)
+	}
+
+	// Unknown rule falls back to fe.Error().
+	feUnknownRule := FieldError{Path: "Name", Rule: "someCustomRule", Err: errStringer("custom failed")}
+	if got := fr.Translate(feUnknownRule); got != feUnknownRule.Error() {
+		t.Fatalf("expected fe.Error() fallback, got %q", got)
+	}
+}
+
+func TestNewLocaleTranslator_EmailAndUUID(t *testing.T) {
+	t.Parallel()
+
+	en := NewLocaleTranslator("en")
+	if got := en.Translate(FieldError{Path: "Email", Rule: "email"}); got != "Email must be a valid email address" {
+		t.Fatalf("unexpected en email translation: %q", got)
+	}
+	if got := en.Translate(FieldError{Path: "ID", Rule: "uuid"}); got != "ID must be a valid UUID" {
+		t.Fatalf("unexpected en uuid translation: %q", got)
+	}
+}
+
+type errStringer string
+
+func (e errStringer) Error() string { return string(e) }
+
+func TestValidationError_Localized(t *testing.T) {
+	t.Parallel()
+
+	ve := &ValidationError{}
+	ve.Add(FieldError{Path: "Name", Rule: "nonempty"})
+	ve.Add(FieldError{Path: "Age", Rule: "positive"})
+
+	got := ve.Localized("nl")
+	if !strings.Contains(got, "Name mag niet leeg zijn") || !strings.Contains(got, "Age moet positief zijn") {
+		t.Fatalf("unexpected localized message: %q", got)
+	}
+
+	empty := &ValidationError{}
+	if got := empty.Localized("en"); got != "" {
+		t.Fatalf("expected empty string for no issues, got %q", got)
+	}
+}
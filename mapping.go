@@ -47,17 +47,21 @@ func (c *mapping) add(parent reflect.Type, fieldIndex int, tagName string, parse
 }
 
 // ruleNameParams holds the name and params of a single validation validationRule.
+// When alts is non-empty, this represents an OR-chain ("a|b|c"): name holds the
+// pipe-joined alternative names for error reporting, and params is unused.
 type ruleNameParams struct {
 	name   string
 	params []string
+	alts   []ruleNameParams
 }
 
-// parseTag tokenizes a raw tag string (e.g., "required,min(5),max(10)") into rules.
-// Behavior:
+// parseTag tokenizes a raw tag string (e.g., "required,min(5),max(10)" or the
+// equivalent "required,min=5,max=10") into rules. Behavior:
 //   - Splits on top-level commas only (commas inside parentheses do not split tokens).
 //   - Trims whitespace around tokens and parameters.
 //   - Empty tokens (from leading/trailing commas) are skipped.
-//   - Parameters are split by commas; nested parentheses inside parameters are not parsed specially.
+//   - A token may carry its parameters in parens ("name(p1,p2)", comma-separated)
+//     or after a top-level '=' ("name=p1 p2", space-separated); see parseRuleToken.
 //   - Does not support quotes or escaping inside parameters.
 func parseTag(tag string) []ruleNameParams {
 	var rules []ruleNameParams
@@ -92,24 +96,78 @@ func parseTag(tag string) []ruleNameParams {
 		if tok == "" {
 			continue
 		}
-		name := tok
-		var params []string
-		if idx := strings.IndexRune(tok, '('); idx != -1 && strings.HasSuffix(tok, ")") {
-			name = strings.TrimSpace(tok[:idx])
-			inner := strings.TrimSpace(tok[idx+1 : len(tok)-1])
-			if inner != "" {
-				parts := strings.Split(inner, ",")
-				for _, p := range parts {
-					p = strings.TrimSpace(p)
-					if p != "" {
-						params = append(params, p)
-					}
+		rules = append(rules, parseRuleToken(tok))
+	}
+	return rules
+}
+
+// parseRuleToken parses a single comma-slot token. A token containing one or
+// more top-level '|' characters (outside parentheses) is an OR-chain, e.g.
+// "hexcolor|rgb|rgba": it parses to a ruleNameParams whose name is the
+// pipe-joined alternative names and whose alts holds each alternative parsed
+// individually; validateStruct/validateElements short-circuit on the first
+// alternative that passes. A plain token parses to "name", "name(p1,p2)", or
+// the playground/validator-style "name=p1 p2" (params space-separated after a
+// single top-level '='), e.g. "min=8" or "required_if=Kind admin". The
+// parenthesized form takes precedence when a token happens to contain both.
+func parseRuleToken(tok string) ruleNameParams {
+	if alts := splitTopLevel(tok, '|'); len(alts) > 1 {
+		parsed := make([]ruleNameParams, 0, len(alts))
+		names := make([]string, 0, len(alts))
+		for _, alt := range alts {
+			p := parseRuleToken(alt)
+			parsed = append(parsed, p)
+			names = append(names, p.name)
+		}
+		return ruleNameParams{name: strings.Join(names, "|"), alts: parsed}
+	}
+
+	name := tok
+	var params []string
+	switch {
+	case strings.IndexRune(tok, '(') != -1 && strings.HasSuffix(tok, ")"):
+		idx := strings.IndexRune(tok, '(')
+		name = strings.TrimSpace(tok[:idx])
+		inner := strings.TrimSpace(tok[idx+1 : len(tok)-1])
+		if inner != "" {
+			for _, p := range strings.Split(inner, ",") {
+				p = strings.TrimSpace(p)
+				if p != "" {
+					params = append(params, p)
 				}
 			}
 		}
-		if name != "" {
-			rules = append(rules, ruleNameParams{name: name, params: params})
+	case strings.IndexRune(tok, '=') != -1:
+		idx := strings.IndexRune(tok, '=')
+		name = strings.TrimSpace(tok[:idx])
+		rest := strings.TrimSpace(tok[idx+1:])
+		if rest != "" {
+			params = strings.Fields(rest)
 		}
 	}
-	return rules
+	return ruleNameParams{name: strings.TrimSpace(name), params: params}
+}
+
+// splitTopLevel splits s on sep, ignoring occurrences of sep inside parentheses.
+func splitTopLevel(s string, sep rune) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case sep:
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, strings.TrimSpace(s[start:]))
+	return parts
 }
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"sync"
 
@@ -11,6 +12,10 @@ import (
 type rulesRegistry interface {
 	add(r Rule) error
 	get(name string, v reflect.Value) (Rule, error)
+	// version returns a counter incremented every time add() succeeds,
+	// letting callers cache resolved rules and cheaply detect when that
+	// cache has gone stale.
+	version() uint64
 }
 
 type rulesMapping interface {
@@ -33,6 +38,19 @@ type Model[TObject any] struct {
 	obj                *TObject
 	binding            *typeBinding
 	ctx                context.Context // used only for validation during New when WithValidation(ctx) is provided
+
+	tagName          string                     // overrides the default "validate" struct tag name, if set
+	elemTagName      string                     // overrides the default "validateElem" struct tag name, if set
+	defaultTagName   string                     // overrides the default "default" struct tag name, if set
+	aliases          map[string]string          // alias name -> expansion rule list, applied at binding build time
+	fieldNameTag     string                     // struct tag (e.g. "json") used for FieldError.Path instead of Go field names, if set
+	defaultFuncs     map[string]DefaultFunc     // name -> func, consulted by `default:"func:name"`, set via WithDefaultFuncs
+	defaultProviders map[string]DefaultProvider // name -> provider, consulted by `default:"name:arg"`, set via RegisterDefaultProvider
+	emptyFunc        EmptyFunc                  // overrides the default "is this field empty" predicate for omitempty, if set
+	validationMode   ValidationMode             // ModeCollectAll (default) or ModeFailFast, set via WithValidationMode
+
+	translator   Translator             // optional; renders localized messages for DetailedIssue, set via WithTranslator
+	ruleMessages map[string]ruleMessage // rule name -> default message template/code, set via WithRuleMessage
 }
 
 // New constructs a new Model for the given object pointer, applying any provided options.
@@ -186,6 +204,35 @@ func (m *Model[TObject]) ensureBinding() error {
 	if err != nil {
 		return err
 	}
+	if m.tagName != "" {
+		tb.tagName = m.tagName
+	}
+	if m.elemTagName != "" {
+		tb.elemTagName = m.elemTagName
+	}
+	if m.defaultTagName != "" {
+		tb.defaultTagName = m.defaultTagName
+	}
+	tb.aliases = make(map[string]string, len(defaultAliases)+len(m.aliases))
+	for k, v := range defaultAliases {
+		tb.aliases[k] = v
+	}
+	for k, v := range m.aliases {
+		tb.aliases[k] = v
+	}
+	if m.fieldNameTag != "" {
+		tb.fieldNameTag = m.fieldNameTag
+	}
+	if len(m.defaultFuncs) > 0 {
+		tb.defaultFuncs = m.defaultFuncs
+	}
+	if len(m.defaultProviders) > 0 {
+		tb.defaultProviders = m.defaultProviders
+	}
+	if m.emptyFunc != nil {
+		tb.emptyFunc = m.emptyFunc
+	}
+	tb.validationMode = m.validationMode
 	m.binding = tb
 	return nil
 }
@@ -233,9 +280,9 @@ func (m *Model[TObject]) validate(ctx context.Context) (err error) {
 	if rv, err = m.rootStructValue("Validate"); err != nil {
 		return err
 	}
-	ve := &ValidationError{}
+	ve := &ValidationError{translator: m.translator}
 	// Delegate traversal to typeBinding to keep logic centralized.
-	if err := m.binding.validateStruct(ctx, rv, "", ve); err != nil {
+	if err := m.binding.validateStruct(ctx, rv, "", ve); err != nil && !errors.Is(err, errFailFastStop) {
 		return err
 	}
 	if ve.Empty() {
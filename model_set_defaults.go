@@ -1,7 +1,10 @@
 package model
 
 import (
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
@@ -76,18 +79,48 @@ func (m *Model[TObject]) applyDefaultElemTag(fv reflect.Value, tag string) error
 }
 
 var durationType = reflect.TypeOf(time.Duration(0))
+var urlURLType = reflect.TypeOf(url.URL{})
+
+// textUnmarshalerType is used to detect fields whose (pointer) type implements
+// encoding.TextUnmarshaler, so literal defaults can be dispatched through it.
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// jsonUnmarshalerType is used to detect fields whose (pointer) type
+// implements json.Unmarshaler, consulted after encoding.TextUnmarshaler so a
+// type implementing both prefers the plain-text form.
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
 
 // setLiteralDefault sets a literal default value into fv if it is zero.
 // For pointer-to-scalar fields, it allocates and sets the pointed value.
-func setLiteralDefault(fv reflect.Value, lit string) error {
+// convs may be nil; when non-nil, it is consulted for a registered converter
+// before the built-in TextUnmarshaler/kind-switch handling. funcs is optional
+// (pass none, or a single map) and is consulted when lit carries an
+// "env:"/"envOr:"/"func:" prefix; see resolveDefaultLiteral.
+func setLiteralDefault(fv reflect.Value, lit string, convs *converterRegistry, funcs ...map[string]DefaultFunc) error {
+	var funcsReg map[string]DefaultFunc
+	if len(funcs) > 0 {
+		funcsReg = funcs[0]
+	}
+	resolved, typed, hasTyped, err := resolveDefaultLiteral(lit, funcsReg)
+	if err != nil {
+		return err
+	}
+	if hasTyped {
+		return setTypedDefault(fv, typed)
+	}
+	lit = resolved
+
 	target := fv
 	// Allocate for pointer-to-scalar when nil
 	if target.Kind() == reflect.Ptr {
 		// If nil and element is not struct/map/slice, allocate
 		if target.IsNil() {
 			ek := target.Type().Elem().Kind()
-			switch ek {
-			case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+			implementsText := reflect.PointerTo(target.Type().Elem()).Implements(textUnmarshalerType)
+			switch {
+			case implementsText:
+				target.Set(reflect.New(target.Type().Elem()))
+			case ek == reflect.Struct, ek == reflect.Map, ek == reflect.Slice, ek == reflect.Array:
 				// Do not auto-allocate complex types on literal defaults
 			default:
 				target.Set(reflect.New(target.Type().Elem()))
@@ -103,6 +136,139 @@ func setLiteralDefault(fv reflect.Value, lit string) error {
 		return nil
 	}
 
+	// Prefer a user-registered converter, then encoding.TextUnmarshaler, over
+	// the built-in kind switch.
+	if convs != nil {
+		if conv, ok, err := convs.get(target.Type()); err != nil {
+			return err
+		} else if ok {
+			v, err := conv(lit)
+			if err != nil {
+				return fmt.Errorf("convert default %q: %w", lit, err)
+			}
+			target.Set(reflect.ValueOf(v))
+			return nil
+		}
+	}
+
+	return setNonScalarOrScalarLiteral(target, lit)
+}
+
+// setLiteralDefaultWithProviders is setLiteralDefault plus one more optional
+// source: providers, consulted (via resolveDefaultProvider) for a
+// `default:"name:arg"` literal whose name isn't one of the fixed
+// env:/envOr:/func:/now/uuid/hostname prefixes resolveDefaultLiteral already
+// understands. It is kept as a separate function, rather than folded into
+// setLiteralDefault's own signature, so every existing setLiteralDefault call
+// site (in defaults_source_test.go and set_literal_default_test.go) keeps
+// working unchanged; the two share all of their literal-resolution logic.
+func setLiteralDefaultWithProviders(fv reflect.Value, lit string, convs *converterRegistry, funcs map[string]DefaultFunc, providers map[string]DefaultProvider) error {
+	resolved, typed, hasTyped, err := resolveDefaultLiteral(lit, funcs)
+	if err != nil {
+		return err
+	}
+	if hasTyped {
+		return setTypedDefault(fv, typed)
+	}
+	if v, matched, err := resolveDefaultProvider(resolved, providers, fv.Type()); err != nil {
+		return err
+	} else if matched {
+		return setTypedDefault(fv, v)
+	}
+	return setLiteralDefault(fv, resolved, convs)
+}
+
+// setNonScalarOrScalarLiteral dispatches target's remaining strategies, tried
+// in order: encoding.TextUnmarshaler (on target.Addr(), then target itself,
+// for the rare value-receiver implementation), encoding/json.Unmarshaler,
+// url.URL (which implements neither), slice/map collection literals, and
+// finally setScalarLiteral's time.Duration/kind-switch handling.
+// ErrDefaultLiteralUnsupportedKind is returned only once every strategy has
+// declined.
+func setNonScalarOrScalarLiteral(target reflect.Value, lit string) error {
+	// Prefer encoding.TextUnmarshaler over the built-in kind switch, so named
+	// types with custom text parsing (enums, time.Time via RFC3339, net.IP,
+	// etc.) can be defaulted via a plain string literal.
+	if target.CanAddr() && target.Addr().Type().Implements(textUnmarshalerType) {
+		tu := target.Addr().Interface().(encoding.TextUnmarshaler)
+		if err := tu.UnmarshalText([]byte(lit)); err != nil {
+			return fmt.Errorf("unmarshal default text %q: %w", lit, err)
+		}
+		return nil
+	}
+	if target.CanInterface() && target.Type().Implements(textUnmarshalerType) {
+		tu := target.Interface().(encoding.TextUnmarshaler)
+		if err := tu.UnmarshalText([]byte(lit)); err != nil {
+			return fmt.Errorf("unmarshal default text %q: %w", lit, err)
+		}
+		return nil
+	}
+
+	// Next, encoding/json.Unmarshaler, for types that only speak JSON. lit is
+	// passed through as-is when it already looks like a JSON value (starts
+	// with '{', '[', '"' or '-', is one of true/false/null, or parses as a
+	// number);
+	// otherwise it is treated as a bare string and quoted first, so a tag
+	// like `default:"active"` works without the author having to spell out
+	// `"active"` themselves.
+	if target.CanAddr() && target.Addr().Type().Implements(jsonUnmarshalerType) {
+		ju := target.Addr().Interface().(json.Unmarshaler)
+		if err := ju.UnmarshalJSON([]byte(jsonLiteralBytes(lit))); err != nil {
+			return fmt.Errorf("unmarshal default json %q: %w", lit, err)
+		}
+		return nil
+	}
+
+	// url.URL implements neither TextUnmarshaler nor json.Unmarshaler, so it
+	// gets its own special case, same treatment as time.Duration below.
+	if target.Type() == urlURLType {
+		u, err := url.Parse(lit)
+		if err != nil {
+			return fmt.Errorf("parse url: %w", err)
+		}
+		target.Set(reflect.ValueOf(*u))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Slice:
+		return setSliceLiteral(target, lit)
+	case reflect.Map:
+		return setMapLiteral(target, lit)
+	}
+
+	return setScalarLiteral(target, lit)
+}
+
+// jsonLiteralBytes returns lit unchanged if it already looks like a JSON
+// value, or lit wrapped in double quotes (with internal quotes/backslashes
+// escaped) otherwise, so a bare tag literal can feed json.Unmarshaler.
+func jsonLiteralBytes(lit string) string {
+	trimmed := strings.TrimSpace(lit)
+	if trimmed == "" {
+		return `""`
+	}
+	switch trimmed[0] {
+	case '{', '[', '"', '-':
+		return lit
+	}
+	if trimmed == "true" || trimmed == "false" || trimmed == "null" {
+		return lit
+	}
+	if _, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return lit
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(lit)
+	return `"` + escaped + `"`
+}
+
+// setScalarLiteral sets a scalar (or time.Duration) literal into target,
+// which is assumed already zero and settable. It is shared by
+// setNonScalarOrScalarLiteral for a plain field and by setSliceLiteral/
+// setMapLiteral for each collection element, so a []time.Duration or
+// map[string]int default is parsed exactly the same way its scalar
+// counterpart would be.
+func setScalarLiteral(target reflect.Value, lit string) error {
 	// Handle special case: time.Duration typed fields
 	if target.Type() == durationType {
 		d, err := time.ParseDuration(lit)
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+type upperEnum string
+
+func (e *upperEnum) UnmarshalText(b []byte) error {
+	*e = upperEnum(b)
+	return nil
+}
+
+type textDefaultDoc struct {
+	Mode upperEnum  `default:"Yup"`
+	Ptr  *upperEnum `default:"Nope"`
+}
+
+func TestSetLiteralDefault_textUnmarshaler(t *testing.T) {
+	t.Parallel()
+
+	var d textDefaultDoc
+	fv := reflect.ValueOf(&d).Elem()
+
+	if err := setLiteralDefault(fv.Field(0), "Yup", nil); err != nil {
+		t.Fatalf("setLiteralDefault: %v", err)
+	}
+	if d.Mode != "Yup" {
+		t.Fatalf("got Mode=%q, want Yup", d.Mode)
+	}
+
+	if err := setLiteralDefault(fv.Field(1), "Nope", nil); err != nil {
+		t.Fatalf("setLiteralDefault: %v", err)
+	}
+	if d.Ptr == nil || *d.Ptr != "Nope" {
+		t.Fatalf("got Ptr=%v, want *Nope", d.Ptr)
+	}
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"context"
+	"sync"
+)
+
+// validationErrorPool recycles *ValidationError values across ValidateAll
+// calls, so a high-QPS server validating the same T repeatedly doesn't pay
+// for a fresh issues slice on every request. Acquire via
+// newPooledValidationError; return via ValidationError.Release once the
+// caller is done reading it.
+var validationErrorPool = sync.Pool{
+	New: func() any { return &ValidationError{} },
+}
+
+// newPooledValidationError returns a *ValidationError drawn from
+// validationErrorPool, reset to empty and ready to accumulate a fresh set of
+// issues.
+func newPooledValidationError() *ValidationError {
+	ve := validationErrorPool.Get().(*ValidationError)
+	ve.reset()
+	return ve
+}
+
+// reset clears ve's accumulated issues, retaining the underlying slice's
+// capacity, along with any attached translator or mode override, without
+// returning ve to the pool itself.
+func (ve *ValidationError) reset() {
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	ve.issues = ve.issues[:0]
+	ve.translator = nil
+	ve.modeOverride = nil
+	ve.pathFilter = nil
+}
+
+// Release clears ve and returns it to validationErrorPool, so its backing
+// issues slice is reused by a later ValidateAll call instead of left for the
+// garbage collector. It is an optional optimization: an un-Released
+// ValidationError is simply collected as normal. Calling Release more than
+// once is harmless; reading ve after calling Release is not safe, since a
+// later ValidateAll call may concurrently reuse and overwrite it.
+func (ve *ValidationError) Release() {
+	if ve == nil {
+		return
+	}
+	ve.reset()
+	validationErrorPool.Put(ve)
+}
+
+// ValidateAll runs the same `validate`/`validateElem` tag walk as Validate,
+// but always collects every failing rule into the returned *ValidationError
+// instead of stopping at the first one, regardless of the Model's configured
+// ValidationMode (see WithValidationMode) — and draws that ValidationError
+// from validationErrorPool rather than allocating a fresh one, so a server
+// calling ValidateAll repeatedly for the same T reuses the same backing
+// issues slice across requests. Call Release on the returned error once done
+// reading it to return it to the pool; Validate remains the fail-fast-aware
+// entry point for callers who configured WithValidationMode(ModeFailFast) and
+// want that behavior honored.
+//
+// ValidateAll forces collect-all semantics via ve.modeOverride rather than by
+// mutating the Model's shared *typeBinding, since the latter would race
+// against a concurrent Validate/ValidateAll call on the same Model.
+func (m *Model[TObject]) ValidateAll(ctx context.Context) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	rv, err := m.rootStructValue("ValidateAll")
+	if err != nil {
+		return err
+	}
+
+	ve := newPooledValidationError()
+	ve.modeOverride = new(ValidationMode) // *ve.modeOverride == ModeCollectAll
+	if err := m.binding.validateStruct(ctx, rv, "", ve); err != nil {
+		ve.Release()
+		return err
+	}
+	if ve.Empty() {
+		ve.Release()
+		return nil
+	}
+	return ve
+}
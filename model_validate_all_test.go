@@ -0,0 +1,93 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type validateAllHolder struct {
+	Name string `validate:"min(1)"`
+	Note string `validate:"min(1)"`
+}
+
+var errValidateAllTooShort = errors.New("too short")
+
+func validateAllMin1(s string, _ ...string) error {
+	if len(s) < 1 {
+		return errValidateAllTooShort
+	}
+	return nil
+}
+
+func newValidateAllModel(t *testing.T, obj *validateAllHolder, mode ValidationMode) *Model[validateAllHolder] {
+	t.Helper()
+	m, err := New(obj, WithValidationMode[validateAllHolder](mode))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	min1, err := NewRule("min", validateAllMin1)
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := m.RegisterRules(min1); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+	return m
+}
+
+func TestModel_ValidateAll_collectsEveryFailureRegardlessOfMode(t *testing.T) {
+	t.Parallel()
+
+	obj := validateAllHolder{} // both fields empty -> both fail min(1)
+	m := newValidateAllModel(t, &obj, ModeFailFast)
+
+	// Validate honors the configured ModeFailFast: only the first failure is recorded.
+	failFastErr := m.validate(context.Background())
+	ve, ok := failFastErr.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate: expected *ValidationError, got %T: %v", failFastErr, failFastErr)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("Validate under ModeFailFast: expected 1 issue, got %d", ve.Len())
+	}
+
+	// ValidateAll ignores the configured mode and collects every failure.
+	allErr := m.ValidateAll(context.Background())
+	all, ok := allErr.(*ValidationError)
+	if !ok {
+		t.Fatalf("ValidateAll: expected *ValidationError, got %T: %v", allErr, allErr)
+	}
+	if all.Len() != 2 {
+		t.Fatalf("ValidateAll: expected 2 issues, got %d: %v", all.Len(), all)
+	}
+	all.Release()
+}
+
+func TestModel_ValidateAll_returnsNilForValidObject(t *testing.T) {
+	t.Parallel()
+
+	obj := validateAllHolder{Name: "a", Note: "b"}
+	m := newValidateAllModel(t, &obj, ModeCollectAll)
+
+	if err := m.ValidateAll(context.Background()); err != nil {
+		t.Fatalf("ValidateAll: expected nil error, got %v", err)
+	}
+}
+
+func TestModel_ValidateAll_releasedErrorIsReusedFromPool(t *testing.T) {
+	// Exercises the sync.Pool wiring directly rather than through Model, so
+	// the reuse isn't obscured by whatever else happens to touch the pool in
+	// parallel tests.
+	first := newPooledValidationError()
+	first.Add(FieldError{Path: "X", Rule: "min"})
+	first.Release()
+
+	second := newPooledValidationError()
+	if second != first {
+		t.Fatalf("expected Release to make the same *ValidationError available for reuse")
+	}
+	if !second.Empty() {
+		t.Fatalf("expected a freshly-acquired pooled ValidationError to be empty, got %v", second)
+	}
+}
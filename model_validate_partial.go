@@ -0,0 +1,62 @@
+package model
+
+import (
+	"context"
+	"errors"
+)
+
+// ValidatePartial runs the same validate/validateElem walk as Validate, but
+// restricts rule evaluation to the given dotted field paths — e.g. "In.A",
+// "MPtr[*].B", "Arr[*].D". Use the literal "[*]" wildcard for a slice, array,
+// or map index/key to mean "whichever index or key is reached during the
+// walk", since a concrete payload doesn't know a collection's length or keys
+// ahead of time. Every struct and collection is still walked so that nested
+// paths can be reached, but rules on any field not named by fields (directly
+// or through one of its ancestors) are skipped.
+//
+// This mirrors go-playground/validator's StructPartial and is meant for
+// PATCH-style APIs, where only a few fields of TObject are present in a
+// given request and running every registered rule would produce spurious
+// errors on the fields the caller never set.
+func (m *Model[TObject]) ValidatePartial(ctx context.Context, fields ...string) error {
+	return m.validateFiltered(ctx, newFieldPathFilter(true, fields))
+}
+
+// ValidateExcept is the inverse of ValidatePartial: it runs every registered
+// rule except those reached through the given dotted field paths, which are
+// pruned from the walk entirely (including any of their own nested fields),
+// mirroring go-playground/validator's StructExcept.
+func (m *Model[TObject]) ValidateExcept(ctx context.Context, fields ...string) error {
+	return m.validateFiltered(ctx, newFieldPathFilter(false, fields))
+}
+
+// validateFiltered is the shared implementation behind ValidatePartial and
+// ValidateExcept: it attaches filter to a fresh *ValidationError, consulted
+// by typeBinding.validateStructOpts and the element-dive helpers in
+// core_validate.go at every field/recursion decision point, then runs the
+// ordinary validateStruct walk honoring the Model's configured
+// ValidationMode (see WithValidationMode), same as Validate.
+func (m *Model[TObject]) validateFiltered(ctx context.Context, filter *fieldPathFilter) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	rv, err := m.rootStructValue("ValidatePartial/ValidateExcept")
+	if err != nil {
+		return err
+	}
+
+	ve := &ValidationError{pathFilter: filter}
+	if err := m.binding.validateStruct(ctx, rv, "", ve); err != nil && !errors.Is(err, errFailFastStop) {
+		return err
+	}
+	if ve.Empty() {
+		return nil
+	}
+	return ve
+}
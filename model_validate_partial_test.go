@@ -0,0 +1,119 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type partialInner struct {
+	A string `validate:"nonempty"`
+	B string `validate:"nonempty"`
+}
+
+type partialElem struct {
+	D string `validate:"nonempty"`
+}
+
+type partialOuter struct {
+	In   partialInner
+	MPtr []*partialInner `validateElem:"dive"`
+	Arr  []partialElem   `validateElem:"dive"`
+	Name string          `validate:"nonempty"`
+}
+
+func newPartialModel(t *testing.T, obj *partialOuter) *Model[partialOuter] {
+	t.Helper()
+	m, err := New(obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	nonempty, err := NewRule("nonempty", ruleNonEmpty)
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := m.RegisterRules(nonempty); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+	return m
+}
+
+func allFailingPartialOuter() *partialOuter {
+	return &partialOuter{
+		In:   partialInner{A: "", B: ""},
+		MPtr: []*partialInner{{A: "", B: ""}},
+		Arr:  []partialElem{{D: ""}},
+		Name: "",
+	}
+}
+
+func TestModel_ValidatePartial_onlyRunsNamedPaths(t *testing.T) {
+	t.Parallel()
+
+	obj := allFailingPartialOuter()
+	m := newPartialModel(t, obj)
+
+	err := m.ValidatePartial(context.Background(), "In.A", "MPtr[*].B", "Arr[*].D")
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+
+	by := ve.ByField()
+	for _, want := range []string{"In.A", "MPtr[0].B", "Arr[0].D"} {
+		if _, ok := by[want]; !ok {
+			t.Errorf("expected an issue at %s, got %v", want, by)
+		}
+	}
+	for _, unwanted := range []string{"In.B", "Name"} {
+		if _, ok := by[unwanted]; ok {
+			t.Errorf("did not expect an issue at %s (not in the partial set), got %v", unwanted, by)
+		}
+	}
+}
+
+func TestModel_ValidatePartial_validObjectReturnsNil(t *testing.T) {
+	t.Parallel()
+
+	obj := &partialOuter{In: partialInner{A: "a", B: ""}, Name: ""}
+	m := newPartialModel(t, obj)
+
+	if err := m.ValidatePartial(context.Background(), "In.A"); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestModel_ValidateExcept_prunesNamedSubtrees(t *testing.T) {
+	t.Parallel()
+
+	obj := allFailingPartialOuter()
+	m := newPartialModel(t, obj)
+
+	err := m.ValidateExcept(context.Background(), "In", "MPtr[*].B")
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+
+	by := ve.ByField()
+	for _, want := range []string{"Arr[0].D", "Name", "MPtr[0].A"} {
+		if _, ok := by[want]; !ok {
+			t.Errorf("expected an issue at %s, got %v", want, by)
+		}
+	}
+	for _, excluded := range []string{"In.A", "In.B", "MPtr[0].B"} {
+		if _, ok := by[excluded]; ok {
+			t.Errorf("did not expect an issue at %s (excluded), got %v", excluded, by)
+		}
+	}
+}
+
+func TestModel_ValidateExcept_validObjectReturnsNil(t *testing.T) {
+	t.Parallel()
+
+	obj := &partialOuter{Name: "ok"}
+	m := newPartialModel(t, obj)
+
+	if err := m.ValidateExcept(context.Background(), "In", "MPtr[*].B", "Arr[*].D"); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
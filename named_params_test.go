@@ -0,0 +1,88 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// equalsParamDoc exercises the "name=value"/"name=v1 v2" tag grammar end to
+// end, alongside the existing "name(v1,v2)" form it is equivalent to.
+type equalsParamDoc struct {
+	Token string `validate:"min=3"`
+	Kind  string
+	Note  string `validate:"required_if=Kind admin"`
+}
+
+func TestModel_Validate_equalsParamGrammar(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     equalsParamDoc
+		wantErr bool
+	}{
+		{"min=3 passes at length 3", equalsParamDoc{Token: "abc", Kind: "user", Note: ""}, false},
+		{"min=3 fails below length", equalsParamDoc{Token: "ab", Kind: "user", Note: ""}, true},
+		{"required_if=Kind admin fails when Note is empty", equalsParamDoc{Token: "abc", Kind: "admin", Note: ""}, true},
+		{"required_if=Kind admin passes when Note is set", equalsParamDoc{Token: "abc", Kind: "admin", Note: "ok"}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(&tt.doc, WithValidation[equalsParamDoc](context.Background()))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// passwordAliasDoc exercises RegisterTagAlias with an expansion written in the
+// "=" grammar, mirroring how a shared password-strength alias would typically
+// be expressed.
+type passwordAliasDoc struct {
+	Password string `validate:"password"`
+}
+
+func TestModel_Validate_aliasExpansion_equalsForm(t *testing.T) {
+	requiredString, err := NewRule[string]("required", func(s string, _ ...string) error {
+		if s == "" {
+			return errors.New("required")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{"too short fails", "short", true},
+		{"long enough passes", "longenoughpassword", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := New(&passwordAliasDoc{Password: tt.password})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			if err := m.RegisterTagAlias("password", "required,min=8"); err != nil {
+				t.Fatalf("RegisterTagAlias: %v", err)
+			}
+			// "required" is not a built-in rule; registering it builds the
+			// binding, so it comes after the alias.
+			if err := m.RegisterRules(requiredString); err != nil {
+				t.Fatalf("RegisterRules: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// namedStructRuleRefsCache caches, per (struct type, tag name), the
+// "structrule(...)" references declared on that type's blank "_" field (if
+// any), so the reflect.Type.FieldByName("_") lookup and tag parse happen once
+// per type instead of once per Validate call. See buildNamedStructRuleRefs.
+var namedStructRuleRefsCache sync.Map // map[structPlanKey][]ruleNameParams
+
+// namedStructRuleRefsFor returns the structrule(...) references declared on
+// typ's blank "_" field under tagName, building and caching them on first use.
+func namedStructRuleRefsFor(typ reflect.Type, tagName string) []ruleNameParams {
+	key := structPlanKey{typ: typ, fieldNameTag: tagName}
+	if v, ok := namedStructRuleRefsCache.Load(key); ok {
+		return v.([]ruleNameParams)
+	}
+	refs := buildNamedStructRuleRefs(typ, tagName)
+	actual, _ := namedStructRuleRefsCache.LoadOrStore(key, refs)
+	return actual.([]ruleNameParams)
+}
+
+// buildNamedStructRuleRefs looks for a blank "_" field on typ (the
+// conventional anchor for struct-wide tag tokens, since no ordinary field
+// "owns" the struct itself) and returns the "structrule" tokens from its tag
+// under tagName, e.g. a field declared as:
+//
+//	_ struct{} `validate:"structrule(passwordsMatch)"`
+func buildNamedStructRuleRefs(typ reflect.Type, tagName string) []ruleNameParams {
+	field, ok := typ.FieldByName("_")
+	if !ok {
+		return nil
+	}
+	raw := field.Tag.Get(tagName)
+	if raw == "" || raw == "-" {
+		return nil
+	}
+	var refs []ruleNameParams
+	for _, r := range parseTag(raw) {
+		if r.name == tagStructRule {
+			refs = append(refs, r)
+		}
+	}
+	return refs
+}
+
+// namedStructRules holds struct-level validation hooks registered by name
+// (via RegisterNamedStructRule), referenced from a blank "_" field's tag as
+// `validate:"structrule(name)"` rather than running unconditionally for
+// every occurrence of the type, unlike RegisterStructRule/WithStructRules.
+type namedStructRules struct {
+	mu     sync.RWMutex
+	byName map[string]structRuleFunc
+}
+
+func newNamedStructRules() *namedStructRules {
+	return &namedStructRules{byName: make(map[string]structRuleFunc)}
+}
+
+func (n *namedStructRules) add(name string, fn structRuleFunc) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.byName[name] = fn
+}
+
+func (n *namedStructRules) get(name string) (structRuleFunc, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	fn, ok := n.byName[name]
+	return fn, ok
+}
+
+// RegisterNamedStructRule registers a struct-level validation function for
+// TStruct under name on m, for selective use via a blank "_" field's tag
+// (`validate:"structrule(name)"`) instead of running for every occurrence of
+// TStruct the way RegisterStructRule does. Use this for invariants that only
+// apply to specific structs sharing a type, e.g.:
+//
+//	model.RegisterNamedStructRule(m, "passwordsMatch", func(s SignupForm) []model.FieldError {
+//		if s.Password != s.PasswordConfirm {
+//			return []model.FieldError{{Path: "PasswordConfirm", Rule: "structrule", Err: errors.New("must match Password")}}
+//		}
+//		return nil
+//	})
+func RegisterNamedStructRule[TObject any, TStruct any](m *Model[TObject], name string, fn func(s TStruct) []FieldError) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	m.binding.namedStructRules().add(name, func(_ context.Context, v reflect.Value, _ string) []FieldError {
+		s, ok := v.Interface().(TStruct)
+		if !ok {
+			return nil
+		}
+		return fn(s)
+	})
+	return nil
+}
+
+// WithNamedStructRule registers one named struct-level validation hook for
+// TStruct as a Model construction Option, analogous to WithStructRules but
+// selectable per-occurrence via a `structrule(name)` tag token.
+func WithNamedStructRule[TObject any, TStruct any](name string, fn func(s TStruct) []FieldError) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return RegisterNamedStructRule(m, name, fn)
+	}
+}
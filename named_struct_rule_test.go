@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type signupForm struct {
+	_               struct{} `validate:"structrule(passwordsMatch)"`
+	Password        string
+	PasswordConfirm string
+}
+
+func TestRegisterNamedStructRule(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&signupForm{Password: "hunter2", PasswordConfirm: "different"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterNamedStructRule(m, "passwordsMatch", func(s signupForm) []FieldError {
+		if s.Password != s.PasswordConfirm {
+			return []FieldError{{Path: "PasswordConfirm", Rule: "structrule", Err: errors.New("must match Password")}}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("RegisterNamedStructRule: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected named struct rule to fail, got nil")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	fes := ve.ForField("PasswordConfirm")
+	if len(fes) != 1 || fes[0].Rule != "structrule" {
+		t.Fatalf("expected one structrule error at path %q, got %+v", "PasswordConfirm", fes)
+	}
+}
+
+func TestRegisterNamedStructRule_passes(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&signupForm{Password: "hunter2", PasswordConfirm: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterNamedStructRule(m, "passwordsMatch", func(s signupForm) []FieldError {
+		if s.Password != s.PasswordConfirm {
+			return []FieldError{{Path: "PasswordConfirm", Rule: "structrule", Err: errors.New("must match Password")}}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("RegisterNamedStructRule: %v", err)
+	}
+
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// unregisteredSignupForm references a structrule name nothing ever registers,
+// which should surface as a FieldError rather than silently passing.
+type unregisteredSignupForm struct {
+	_ struct{} `validate:"structrule(neverRegistered)"`
+	S string
+}
+
+func TestNamedStructRule_unregisteredNameFails(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&unregisteredSignupForm{S: "x"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected error for unregistered structrule name, got nil")
+	}
+}
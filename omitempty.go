@@ -0,0 +1,90 @@
+package model
+
+import (
+	"reflect"
+	"time"
+)
+
+// EmptyFunc reports whether v should be treated as empty for the purposes of
+// an "omitempty" validate tag. Register a custom one via WithEmptyFunc to
+// override the default predicate for types with their own notion of "unset"
+// (e.g. uuid.Nil).
+type EmptyFunc func(v reflect.Value) bool
+
+// WithEmptyFunc overrides the predicate typeBinding uses to decide whether a
+// field is "empty" for the "omitempty" validate tag token. If not set, a
+// built-in predicate is used: nil pointer/interface, zero-length string,
+// slice, array, or map, a zero time.Time, or reflect.Value.IsZero otherwise.
+func WithEmptyFunc[TObject any](fn EmptyFunc) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		m.emptyFunc = fn
+		return nil
+	}
+}
+
+// isEmptyValue is the default EmptyFunc, used when no WithEmptyFunc override
+// is configured.
+func isEmptyValue(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.IsZero()
+	}
+	// database/sql.Null* types (and any other driver.Valuer or
+	// encoding.TextMarshaler) are empty when unwrapKnownWrapper reports them
+	// absent, e.g. sql.NullString{Valid: false}.
+	if _, isAbsent, ok := unwrapKnownWrapper(v); ok {
+		return isAbsent
+	}
+	return v.IsZero()
+}
+
+// isEmptyValue reports whether fv is empty per tb's configured EmptyFunc (see
+// WithEmptyFunc), falling back to the package-level isEmptyValue default. A
+// field whose type has a registered CustomTypeFunc (see RegisterCustomTypeFunc)
+// is checked against the extracted value instead of fv itself, so "omitempty"
+// agrees with the same projection rule dispatch uses via resolveValidationValue.
+func (tb *typeBinding) isEmptyValue(fv reflect.Value) bool {
+	if fn, ok := tb.customTypeFor(fv.Type()); ok {
+		extracted := fn(fv)
+		if extracted == nil {
+			return true
+		}
+		fv = reflect.ValueOf(extracted)
+	}
+	if tb.emptyFunc != nil {
+		return tb.emptyFunc(fv)
+	}
+	return isEmptyValue(fv)
+}
+
+// applyOmitempty returns rules with an "omitempty" token removed and nil
+// returned in its place when fv is empty (short-circuiting every other rule
+// on the field); otherwise it returns rules with "omitempty" stripped out
+// (since it is not itself a registered rule) and every other rule preserved.
+func (tb *typeBinding) applyOmitempty(rules []ruleNameParams, fv reflect.Value) []ruleNameParams {
+	idx := -1
+	for i, r := range rules {
+		if r.name == tagOmitempty {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return rules
+	}
+	if tb.isEmptyValue(fv) {
+		return nil
+	}
+	out := make([]ruleNameParams, 0, len(rules)-1)
+	out = append(out, rules[:idx]...)
+	out = append(out, rules[idx+1:]...)
+	return out
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type omitemptyForm struct {
+	Code string `validate:"omitempty,min(3)"`
+}
+
+func TestModel_Validate_omitempty(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&omitemptyForm{Code: ""})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected omitempty to skip min(3) on empty Code, got %v", err)
+	}
+
+	m2, err := New(&omitemptyForm{Code: "ab"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected min(3) to run and fail on non-empty Code")
+	}
+
+	m3, err := New(&omitemptyForm{Code: "abc"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m3.Validate(context.Background()); err != nil {
+		t.Fatalf("expected min(3) to pass on Code=%q, got %v", "abc", err)
+	}
+}
+
+type nilableID struct {
+	raw string
+}
+
+var zeroNilableID = nilableID{raw: "00000000-0000-0000-0000-000000000000"}
+
+type withEmptyFuncForm struct {
+	ID nilableID `validate:"omitempty,customCheck"`
+}
+
+func TestWithEmptyFunc(t *testing.T) {
+	t.Parallel()
+
+	customCheck, err := NewRule[nilableID]("customCheck", func(nilableID, ...string) error {
+		t.Fatalf("customCheck should not run when ID is treated as empty")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	obj := withEmptyFuncForm{ID: zeroNilableID}
+	m, err := New(
+		&obj,
+		WithRules[withEmptyFuncForm](customCheck),
+		WithEmptyFunc[withEmptyFuncForm](func(v reflect.Value) bool {
+			id, ok := v.Interface().(nilableID)
+			return ok && id == zeroNilableID
+		}),
+	)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected omitempty to skip customCheck for the zero-ish ID, got %v", err)
+	}
+}
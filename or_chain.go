@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// applyOrChain evaluates an OR-chain rule (r.alts, produced from a "a|b|c"
+// token) against v, succeeding as soon as any alternative passes. Context
+// cancellation is checked between alternatives so a long chain cannot run past
+// a caller-imposed deadline. If every alternative fails, the returned error
+// summarizes each alternative's failure.
+func (tb *typeBinding) applyOrChain(ctx context.Context, r ruleNameParams, v reflect.Value) error {
+	var failures []string
+	for _, alt := range r.alts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := tb.applyRule(alt.name, v, alt.params...); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", alt.name, err))
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("none of %s passed (%s)", r.name, strings.Join(failures, "; "))
+}
+
+// applyRuleOrChain runs r against v: a context-aware rule registered under
+// r.name via RegisterRuleCtx takes precedence (so it can observe ctx.Done()
+// itself, e.g. mid-way through a slow per-element check), otherwise it
+// dispatches to applyOrChain when r is an OR-chain (r.alts set) and to the
+// plain registry rule otherwise. Shared by validateStructWithRoot and the
+// validateElem plan-based element traversal so ctx-aware rules and OR-chains
+// ("a|b|c") work identically in both validate and validateElem tags.
+func (tb *typeBinding) applyRuleOrChain(ctx context.Context, r ruleNameParams, v reflect.Value) error {
+	if fn, ok := tb.ctxRules().get(r.name); ok {
+		return fn(ctx, v, r.params...)
+	}
+	if len(r.alts) > 0 {
+		return tb.applyOrChain(ctx, r, v)
+	}
+	return tb.applyRule(r.name, v, r.params...)
+}
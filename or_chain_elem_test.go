@@ -0,0 +1,31 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type orChainElemDoc struct {
+	Scores []int `validateElem:"dive,positive|nonzero"`
+}
+
+func TestModel_Validate_orChain_inValidateElem(t *testing.T) {
+	t.Parallel()
+
+	// -1 fails "positive" but passes "nonzero": the OR-chain should pass overall.
+	m, err := New(&orChainElemDoc{Scores: []int{1, -1, 2}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected OR-chain to pass per element, got %v", err)
+	}
+
+	m2, err := New(&orChainElemDoc{Scores: []int{1, 0}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected OR-chain to fail when every alternative fails for an element")
+	}
+}
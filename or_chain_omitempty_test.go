@@ -0,0 +1,93 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type orChainOmitemptyForm struct {
+	Accent string `validate:"omitempty,hexcolor|rgb"`
+}
+
+func TestModel_Validate_orChain_withOmitempty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		accent  string
+		wantErr bool
+	}{
+		{"zero value skipped by omitempty", "", false},
+		{"first alternative matches", "#abcdef", false},
+		{"second alternative matches", "rgb(0,0,0)", false},
+		{"neither alternative matches", "not-a-color", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&orChainOmitemptyForm{Accent: tt.accent})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRulesMapping_caches_orChainParse verifies that parseTag for a tag
+// mixing omitempty with an OR-chain is only performed once per (type,
+// fieldIndex, tagName): a second Model.Validate call against a different
+// instance of the same type reuses the cached []ruleNameParams rather than
+// re-parsing the raw tag.
+func TestRulesMapping_caches_orChainParse(t *testing.T) {
+	t.Parallel()
+
+	typ := reflect.TypeOf(orChainOmitemptyForm{})
+	fieldIndex := 0
+
+	rm := newRulesMapping()
+	if _, exists := rm.get(typ, fieldIndex, "validate"); exists {
+		t.Fatalf("expected no cached entry before first parse")
+	}
+
+	parsed := parseTag("omitempty,hexcolor|rgb")
+	rm.add(typ, fieldIndex, "validate", parsed)
+
+	got, exists := rm.get(typ, fieldIndex, "validate")
+	if !exists {
+		t.Fatalf("expected cached entry after add")
+	}
+	if len(got) != len(parsed) {
+		t.Fatalf("cached rules length = %d, want %d", len(got), len(parsed))
+	}
+	for i, r := range got {
+		if r.name != parsed[i].name {
+			t.Fatalf("cached rule[%d].name = %q, want %q", i, r.name, parsed[i].name)
+		}
+	}
+
+	// Two independent objects of the same type both validate correctly
+	// against the shared cache entry.
+	m1, err := New(&orChainOmitemptyForm{Accent: "#123456"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m1.Validate(context.Background()); err != nil {
+		t.Fatalf("Validate m1: %v", err)
+	}
+
+	m2, err := New(&orChainOmitemptyForm{Accent: "nope"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected Validate m2 to fail")
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type orChainDoc struct {
+	Value int `validate:"positive|nonzero"`
+}
+
+func TestModel_Validate_orChain(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(&orChainDoc{Value: 5}); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	m, err := New(&orChainDoc{Value: -1})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	// -1 fails "positive" but passes "nonzero": the OR-chain should pass overall.
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected OR-chain to pass when one alternative succeeds, got %v", err)
+	}
+
+	m2, err := New(&orChainDoc{Value: 0})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m2.Validate(context.Background()); err == nil {
+		t.Fatalf("expected OR-chain to fail when every alternative fails")
+	}
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+var errOutOfRange = errors.New("value out of range")
+
+type rangeDoc struct {
+	Score int `validate:"range(min:1,max:10)"`
+}
+
+func newRangeModel(t *testing.T, obj *rangeDoc) *Model[rangeDoc] {
+	t.Helper()
+
+	r, err := NewParamRule[int]("range", RuleParams{
+		"min": IntParam(Required),
+		"max": IntParam(Default(100)),
+	}, func(v int, p Params) error {
+		if v < p.Int("min") || v > p.Int("max") {
+			return errOutOfRange
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewParamRule: %v", err)
+	}
+
+	m, err := New(obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.RegisterRules(r); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+	return m
+}
+
+func TestNewParamRule_withinRangePasses(t *testing.T) {
+	t.Parallel()
+
+	m := newRangeModel(t, &rangeDoc{Score: 5})
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewParamRule_outOfRangeFails(t *testing.T) {
+	t.Parallel()
+
+	m := newRangeModel(t, &rangeDoc{Score: 15})
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected out-of-range value to fail")
+	}
+}
+
+func TestNewParamRule_missingRequiredParamFails(t *testing.T) {
+	t.Parallel()
+
+	type missingReqDoc struct {
+		Score int `validate:"range(max:10)"`
+	}
+
+	r, err := NewParamRule[int]("range", RuleParams{
+		"min": IntParam(Required),
+		"max": IntParam(Default(100)),
+	}, func(v int, p Params) error { return nil })
+	if err != nil {
+		t.Fatalf("NewParamRule: %v", err)
+	}
+
+	m, err := New(&missingReqDoc{Score: 5})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.RegisterRules(r); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected missing required parameter to fail")
+	}
+}
+
+func TestParseParams_typedAccessors(t *testing.T) {
+	t.Parallel()
+
+	schema := RuleParams{
+		"min":    IntParam(Required),
+		"max":    IntParam(Default(10)),
+		"label":  StringParam(Default("n/a")),
+		"strict": BoolParam(Default(false)),
+	}
+
+	p, err := parseParams(schema, []string{"min:1", "strict:true"})
+	if err != nil {
+		t.Fatalf("parseParams: %v", err)
+	}
+	if got := p.Int("min"); got != 1 {
+		t.Errorf("Int(min) = %d, want 1", got)
+	}
+	if got := p.Int("max"); got != 10 {
+		t.Errorf("Int(max) = %d, want 10 (default)", got)
+	}
+	if got := p.String("label"); got != "n/a" {
+		t.Errorf("String(label) = %q, want default", got)
+	}
+	if got := p.Bool("strict"); got != true {
+		t.Errorf("Bool(strict) = %v, want true", got)
+	}
+}
+
+func TestParseParams_unknownParamFails(t *testing.T) {
+	t.Parallel()
+
+	schema := RuleParams{"min": IntParam(Required)}
+	if _, err := parseParams(schema, []string{"min:1", "bogus:1"}); err == nil {
+		t.Fatalf("expected unknown parameter to fail")
+	}
+}
@@ -42,6 +42,21 @@ func TestParseTag(t *testing.T) {
 			in:   "a,,b",
 			want: []ruleNameParams{{name: "a"}, {name: "b"}},
 		},
+		{
+			name: "equals form is equivalent to single-parameter parens",
+			in:   "min=8",
+			want: []ruleNameParams{{name: "min", params: []string{"8"}}},
+		},
+		{
+			name: "equals form splits multiple params on whitespace",
+			in:   "required_if=Kind admin",
+			want: []ruleNameParams{{name: "required_if", params: []string{"Kind", "admin"}}},
+		},
+		{
+			name: "equals form with no value has no params",
+			in:   "foo=",
+			want: []ruleNameParams{{name: "foo"}},
+		},
 	}
 
 	for _, tc := range tests {
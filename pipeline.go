@@ -0,0 +1,129 @@
+package model
+
+import (
+	"context"
+	"reflect"
+)
+
+// fieldValidator is the type-erased interface FieldPipeline[T, F] implements
+// so field pipelines with different F can be composed into a single
+// Pipeline[T].
+type fieldValidator[T any] interface {
+	validateField(ctx context.Context, obj *T, ve *ValidationError) error
+}
+
+// FieldPipeline validates one field of T, selected by get, against a
+// sequence of Rules. Every builder method (Rules, When, Cascade) returns a
+// new FieldPipeline and leaves the receiver untouched, so a FieldPipeline
+// can be built once, shared, and reused concurrently across many
+// validations, in the spirit of go-playground/govy's immutable pipelines.
+type FieldPipeline[T any, F any] struct {
+	name    string
+	get     func(*T) F
+	rules   []Rule
+	when    func(*T) bool
+	cascade bool
+}
+
+// For starts a FieldPipeline for the field named name, read from a T value
+// via get. name is used as the Path on any FieldError the pipeline produces.
+func For[T any, F any](name string, get func(*T) F) *FieldPipeline[T, F] {
+	return &FieldPipeline[T, F]{name: name, get: get}
+}
+
+// Rules returns a new FieldPipeline that additionally runs the given rules,
+// in order, against the selected field.
+func (fp *FieldPipeline[T, F]) Rules(rules ...Rule) *FieldPipeline[T, F] {
+	next := *fp
+	next.rules = append(append([]Rule{}, fp.rules...), rules...)
+	return &next
+}
+
+// When returns a new FieldPipeline that only runs (and therefore can only
+// fail) when pred(obj) is true, for rules that apply conditionally (e.g. a
+// field required only when another field has a certain value).
+func (fp *FieldPipeline[T, F]) When(pred func(*T) bool) *FieldPipeline[T, F] {
+	next := *fp
+	next.when = pred
+	return &next
+}
+
+// Cascade returns a new FieldPipeline that stops at the first failing rule
+// instead of collecting every rule's failure, for rule sequences where a
+// later rule assumes an earlier one already passed.
+func (fp *FieldPipeline[T, F]) Cascade() *FieldPipeline[T, F] {
+	next := *fp
+	next.cascade = true
+	return &next
+}
+
+func (fp *FieldPipeline[T, F]) validateField(ctx context.Context, obj *T, ve *ValidationError) error {
+	if fp.when != nil && !fp.when(obj) {
+		return nil
+	}
+	v := reflect.ValueOf(fp.get(obj))
+	for _, r := range fp.rules {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := r.getValidationFn()(v); err != nil {
+			ve.Add(newFieldError(fp.name, r.getName(), nil, v, err))
+			if fp.cascade {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// Pipeline composes many FieldPipelines for T into a single reusable,
+// immutable validator: a code-first alternative to struct tags for cases
+// tags handle poorly (cross-field predicates, conditional rules, rule sets
+// shared across types). It shares Rule values, the ValidationError type, and
+// context cancellation semantics with the tag-based Model[T] path, and is
+// lazily evaluated: building a Pipeline does no work until Validate runs it.
+type Pipeline[T any] struct {
+	fields []fieldValidator[T]
+}
+
+// NewPipeline builds a Pipeline from one or more field pipelines (as
+// returned by For(...).Rules(...)).
+func NewPipeline[T any](fields ...fieldValidator[T]) *Pipeline[T] {
+	return &Pipeline[T]{fields: append([]fieldValidator[T]{}, fields...)}
+}
+
+// Include returns a new Pipeline that runs p's fields followed by sub's,
+// for composing a reusable sub-pipeline (e.g. shared address validation)
+// into a larger one without mutating either.
+func (p *Pipeline[T]) Include(sub *Pipeline[T]) *Pipeline[T] {
+	merged := append([]fieldValidator[T]{}, p.fields...)
+	merged = append(merged, sub.fields...)
+	return &Pipeline[T]{fields: merged}
+}
+
+// Validate runs every field pipeline against obj, accumulating failures into
+// a ValidationError exactly as Model[T].Validate does. If ctx is canceled or
+// its deadline exceeded, validation stops early and ctx.Err() is returned.
+// Pipelines hold no per-validation state, so the same Pipeline can be reused
+// across many Validate calls and goroutines without reallocation.
+func (p *Pipeline[T]) Validate(ctx context.Context, obj *T) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	ve := &ValidationError{}
+	for _, f := range p.fields {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := f.validateField(ctx, obj, ve); err != nil {
+			return err
+		}
+	}
+	if ve.Empty() {
+		return nil
+	}
+	return ve
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type pipelineUser struct {
+	Name    string
+	Email   string
+	Country string
+	ZIP     string
+}
+
+func TestPipeline_Validate(t *testing.T) {
+	t.Parallel()
+
+	nonempty, err := NewRule[string]("nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule nonempty: %v", err)
+	}
+	hasAt, err := NewRule[string]("hasAt", func(s string, _ ...string) error {
+		for _, c := range s {
+			if c == '@' {
+				return nil
+			}
+		}
+		return fmt.Errorf("must contain '@'")
+	})
+	if err != nil {
+		t.Fatalf("NewRule hasAt: %v", err)
+	}
+
+	p := NewPipeline[pipelineUser](
+		For[pipelineUser]("Name", func(u *pipelineUser) string { return u.Name }).Rules(nonempty),
+		For[pipelineUser]("Email", func(u *pipelineUser) string { return u.Email }).Rules(nonempty, hasAt).Cascade(),
+		For[pipelineUser]("ZIP", func(u *pipelineUser) string { return u.ZIP }).
+			Rules(nonempty).
+			When(func(u *pipelineUser) bool { return u.Country == "US" }),
+	)
+
+	tests := []struct {
+		name    string
+		u       pipelineUser
+		wantErr bool
+	}{
+		{"all valid", pipelineUser{Name: "Ada", Email: "ada@example.com"}, false},
+		{"missing name", pipelineUser{Email: "ada@example.com"}, true},
+		{"empty email cascades to one error", pipelineUser{Name: "Ada", Email: ""}, true},
+		{"zip required only for US", pipelineUser{Name: "Ada", Email: "a@b.com", Country: "US"}, true},
+		{"zip not required outside US", pipelineUser{Name: "Ada", Email: "a@b.com", Country: "FR"}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := p.Validate(context.Background(), &tt.u)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPipeline_Validate_cascadeStopsAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	alwaysFail, err := NewRule[string]("alwaysFail", func(_ string, _ ...string) error {
+		return fmt.Errorf("fail")
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	p := NewPipeline[pipelineUser](
+		For[pipelineUser]("Name", func(u *pipelineUser) string { return u.Name }).Rules(alwaysFail, alwaysFail).Cascade(),
+	)
+
+	err = p.Validate(context.Background(), &pipelineUser{})
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("expected cascade to stop after the first failure, got %d errors", ve.Len())
+	}
+}
+
+func TestPipeline_Include(t *testing.T) {
+	t.Parallel()
+
+	nonempty, err := NewRule[string]("nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	addressPipeline := NewPipeline[pipelineUser](
+		For[pipelineUser]("Country", func(u *pipelineUser) string { return u.Country }).Rules(nonempty),
+	)
+	userPipeline := NewPipeline[pipelineUser](
+		For[pipelineUser]("Name", func(u *pipelineUser) string { return u.Name }).Rules(nonempty),
+	).Include(addressPipeline)
+
+	err = userPipeline.Validate(context.Background(), &pipelineUser{})
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(ve.Fields()) != 2 {
+		t.Fatalf("expected errors for both Name and Country, got %v", ve.Fields())
+	}
+}
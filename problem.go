@@ -0,0 +1,65 @@
+package model
+
+import "encoding/json"
+
+// ProblemDetails is the RFC 7807 (application/problem+json) representation
+// of a ValidationError. Type, Title, Status, and Detail are the standard
+// members; InvalidParams carries one entry per failed rule, named after the
+// "invalid-params" extension member RFC 7807 itself suggests for validation
+// errors, so an HTTP handler can return it directly without bespoke mapping
+// code.
+type ProblemDetails struct {
+	Type          string                `json:"type"`
+	Title         string                `json:"title"`
+	Status        int                   `json:"status"`
+	Detail        string                `json:"detail,omitempty"`
+	InvalidParams []ProblemInvalidParam `json:"invalid-params,omitempty"`
+}
+
+// ProblemInvalidParam is one element of ProblemDetails.InvalidParams,
+// derived from a single FieldError.
+type ProblemInvalidParam struct {
+	Name   string   `json:"name"`
+	Reason string   `json:"reason"`
+	Rule   string   `json:"rule,omitempty"`
+	Params []string `json:"params,omitempty"`
+}
+
+// AsProblem renders ve's accumulated issues as a ProblemDetails value.
+// typeURI and title populate RFC 7807's "type" and "title" members, and
+// status populates "status". Detail is the fixed summary "validation failed"
+// whenever ve holds at least one issue; use LocalizeAll or a custom Reason-rendering loop if a
+// localized Detail is needed instead.
+func (ve *ValidationError) AsProblem(typeURI, title string, status int) ProblemDetails {
+	pd := ProblemDetails{Type: typeURI, Title: title, Status: status}
+	if ve == nil {
+		return pd
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	if len(ve.issues) == 0 {
+		return pd
+	}
+	pd.Detail = "validation failed"
+	pd.InvalidParams = make([]ProblemInvalidParam, 0, len(ve.issues))
+	for _, fe := range ve.issues {
+		reason := ""
+		if fe.Err != nil {
+			reason = fe.Err.Error()
+		}
+		pd.InvalidParams = append(pd.InvalidParams, ProblemInvalidParam{
+			Name:   fe.Path,
+			Reason: reason,
+			Rule:   fe.Rule,
+			Params: fe.Params,
+		})
+	}
+	return pd
+}
+
+// MarshalProblem renders ve as a ProblemDetails value via AsProblem and
+// marshals it to JSON in one call, for an HTTP handler that wants to write
+// an application/problem+json response body directly from validator output.
+func (ve *ValidationError) MarshalProblem(typeURI, title string, status int) ([]byte, error) {
+	return json.Marshal(ve.AsProblem(typeURI, title, status))
+}
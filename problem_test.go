@@ -0,0 +1,68 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidationError_AsProblem(t *testing.T) {
+	t.Parallel()
+
+	ve := &ValidationError{}
+	ve.Add(fe("Name", "nonempty", "must not be empty"))
+	ve.Add(fe("Age", "positive", "must be positive"))
+
+	pd := ve.AsProblem("https://example.com/probs/validation", "Validation failed", 422)
+	if pd.Type != "https://example.com/probs/validation" || pd.Title != "Validation failed" || pd.Status != 422 {
+		t.Fatalf("unexpected top-level problem fields: %+v", pd)
+	}
+	if len(pd.InvalidParams) != 2 {
+		t.Fatalf("expected 2 invalid-params entries, got %d", len(pd.InvalidParams))
+	}
+	if pd.InvalidParams[0].Name != "Name" || pd.InvalidParams[0].Rule != "nonempty" || pd.InvalidParams[0].Reason != "must not be empty" {
+		t.Fatalf("unexpected first invalid-param: %+v", pd.InvalidParams[0])
+	}
+}
+
+func TestValidationError_AsProblem_NoIssues(t *testing.T) {
+	t.Parallel()
+
+	ve := &ValidationError{}
+	pd := ve.AsProblem("about:blank", "OK", 200)
+	if pd.Detail != "" || pd.InvalidParams != nil {
+		t.Fatalf("expected no detail/invalid-params for an empty ValidationError, got %+v", pd)
+	}
+}
+
+func TestValidationError_AsProblem_NilReceiver(t *testing.T) {
+	t.Parallel()
+
+	var ve *ValidationError
+	pd := ve.AsProblem("about:blank", "OK", 200)
+	if pd.Status != 200 || pd.InvalidParams != nil {
+		t.Fatalf("unexpected problem for nil receiver: %+v", pd)
+	}
+}
+
+func TestValidationError_MarshalProblem(t *testing.T) {
+	t.Parallel()
+
+	ve := &ValidationError{}
+	ve.Add(fe("Email", "email", "must be a valid email address"))
+
+	data, err := ve.MarshalProblem("https://example.com/probs/validation", "Validation failed", 422)
+	if err != nil {
+		t.Fatalf("MarshalProblem error: %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal: %v; raw=%s", err, string(data))
+	}
+	if got["status"].(float64) != 422 {
+		t.Fatalf("unexpected status: %v", got["status"])
+	}
+	params, ok := got["invalid-params"].([]any)
+	if !ok || len(params) != 1 {
+		t.Fatalf("expected 1 invalid-params entry, got %v", got["invalid-params"])
+	}
+}
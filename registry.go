@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ygrebnov/errorc"
 )
@@ -31,6 +32,7 @@ func NewRule[TField any](name string, fn func(v TField, params ...string) error)
 type registry struct {
 	mu    sync.RWMutex
 	rules map[string][]Rule // rule name -> overloads by type
+	gen   atomic.Uint64     // bumped on every successful add, for cache invalidation
 }
 
 func newRegistry() *registry {
@@ -63,9 +65,16 @@ func (r *registry) add(rule Rule) error {
 	}
 
 	r.rules[name] = append(r.rules[name], rule)
+	r.gen.Add(1)
 	return nil
 }
 
+// version returns the number of rules successfully added so far, used by
+// typeBinding's rule-resolution cache to detect staleness.
+func (r *registry) version() uint64 {
+	return r.gen.Load()
+}
+
 // get returns the best-matching overload of rule `name` for the given field value.
 // Selection strategy:
 //  1. Prefer exact type match (v.Type() == fieldType).
@@ -73,6 +82,13 @@ func (r *registry) add(rule Rule) error {
 //  3. Otherwise, if no matches, fetch a built-in rule if available.
 //  4. If no matches, return a descriptive error listing available overload types.
 //  5. If multiple exact matches (shouldn't happen), return an ambiguity error.
+//
+// v has typically already been unwrapped by typeBinding.resolveValidationValue
+// before reaching here, so a field stored as sql.NullString or any other
+// database/sql/driver.Valuer/encoding.TextMarshaler wrapper is looked up by
+// its unwrapped type (e.g. string) rather than the wrapper type — see
+// unwrapKnownWrapper and RegisterCustomTypeFunc for the (overridable) default
+// and per-type escape hatch, respectively.
 func (r *registry) get(name string, v reflect.Value) (Rule, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
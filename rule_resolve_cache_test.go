@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type ruleResolveDoc struct {
+	Name string `validate:"custom"`
+}
+
+func TestModel_applyRule_cacheInvalidatedOnNewRule(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&ruleResolveDoc{Name: "x"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	// No "custom" rule registered yet: Validate should fail to resolve it and
+	// populate (and cache) a not-found error for (custom, string).
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected error for unregistered rule")
+	}
+
+	customRule, err := NewRule[string]("custom", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := m.RegisterRules(customRule); err != nil {
+		t.Fatalf("RegisterRules: %v", err)
+	}
+
+	// The cached not-found resolution must be invalidated by the registry's
+	// version bump, so the newly registered rule is picked up immediately.
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("expected no error once the rule is registered, got %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+// Package schema derives a Draft 2020-12 JSON Schema object from a struct
+// type's `validate` / `default` tags, so a Binding[T] can expose the same
+// constraints it enforces at runtime as a document other tools can consume.
+package schema
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+// SchemaContributor lets a custom validation.Rule contribute additional JSON
+// Schema keywords to the node built for the field(s) it validates, beyond
+// the rule name -> keyword mappings Build already understands (required,
+// min/max, pattern, oneof).
+type SchemaContributor interface {
+	ContributeSchema(node map[string]any, params []string)
+}
+
+var cache sync.Map // reflect.Type -> map[string]any
+
+// Build returns a JSON Schema object describing typ's exported fields,
+// resolving rule names against registry to find SchemaContributor
+// implementations. The result is cached per type; callers must not mutate
+// the returned map.
+func Build(typ reflect.Type, registry validation.RulesRegistry) map[string]any {
+	if cached, ok := cache.Load(typ); ok {
+		return cached.(map[string]any)
+	}
+	node := buildStruct(typ, registry)
+	cache.Store(typ, node)
+	return node
+}
+
+func buildStruct(typ reflect.Type, registry validation.RulesRegistry) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		node, isRequired := buildField(field, registry)
+		properties[name] = node
+		if isRequired {
+			required = append(required, name)
+		}
+	}
+
+	out := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		out["required"] = required
+	}
+	return out
+}
+
+// jsonFieldName derives the JSON property name for field from its `json`
+// tag, falling back to the Go field name. It reports false for fields
+// explicitly excluded via `json:"-"`.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if tag == "" {
+		return field.Name, true
+	}
+	if idx := strings.IndexByte(tag, ','); idx != -1 {
+		tag = tag[:idx]
+	}
+	if tag == "" {
+		return field.Name, true
+	}
+	return tag, true
+}
+
+func buildField(field reflect.StructField, registry validation.RulesRegistry) (map[string]any, bool) {
+	node := typeNode(field.Type, registry)
+
+	required := false
+	if raw := field.Tag.Get("validate"); raw != "" && raw != "-" {
+		for _, r := range validation.ParseTag(raw) {
+			applyRule(node, r, field.Type, registry, &required)
+		}
+	}
+	if def := field.Tag.Get("default"); def != "" {
+		node["default"] = coerceScalar(def, field.Type)
+	}
+	return node, required
+}
+
+// typeNode returns the base JSON Schema node for a Go type, recursing into
+// slice/array element types, map value types, and nested structs.
+func typeNode(t reflect.Type, registry validation.RulesRegistry) map[string]any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": typeNode(t.Elem(), registry)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeNode(t.Elem(), registry)}
+	case reflect.Struct:
+		return buildStruct(t, registry)
+	default:
+		return map[string]any{}
+	}
+}
+
+// applyRule folds a single parsed validate-tag rule into node: the built-in
+// required/min/max/pattern/oneof mappings described on SchemaContributor, or,
+// for any other rule name, a lookup against registry for a Rule implementing
+// SchemaContributor.
+func applyRule(
+	node map[string]any,
+	r validation.RuleNameParams,
+	fieldType reflect.Type,
+	registry validation.RulesRegistry,
+	required *bool,
+) {
+	base := fieldType
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+
+	switch r.Name {
+	case "required":
+		*required = true
+		return
+	case "min", "max":
+		if len(r.Params) == 0 {
+			return
+		}
+		key := "minimum"
+		if base.Kind() == reflect.String {
+			key = "minLength"
+		}
+		if r.Name == "max" {
+			key = strings.Replace(key, "min", "max", 1)
+		}
+		node[key] = numericParam(r.Params[0])
+		return
+	case "pattern":
+		if len(r.Params) > 0 {
+			node["pattern"] = strings.Join(r.Params, ",")
+		}
+		return
+	case "oneof":
+		if len(r.Params) > 0 {
+			node["enum"] = append([]string(nil), r.Params...)
+		}
+		return
+	}
+
+	if registry == nil {
+		return
+	}
+	zero := reflect.New(base).Elem()
+	rule, err := registry.Get(r.Name, zero)
+	if err != nil {
+		return
+	}
+	if contributor, ok := rule.(SchemaContributor); ok {
+		contributor.ContributeSchema(node, r.Params)
+	}
+}
+
+// numericParam parses param as an int when possible, falling back to a
+// float64, so schema output uses the narrowest JSON number representation.
+func numericParam(param string) any {
+	if n, err := strconv.Atoi(param); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(param, 64); err == nil {
+		return f
+	}
+	return param
+}
+
+// coerceScalar parses a `default` tag value according to t's underlying kind
+// so the schema's "default" keyword carries a typed JSON value rather than
+// always a string.
+func coerceScalar(raw string, t reflect.Type) any {
+	base := t
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+	switch base.Kind() {
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(raw); err == nil {
+			return b
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, err := strconv.ParseFloat(raw, 64); err == nil {
+			return f
+		}
+	}
+	return raw
+}
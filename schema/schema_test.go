@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ygrebnov/model/validation"
+)
+
+type address struct {
+	Street string `json:"street" validate:"required"`
+}
+
+type widget struct {
+	ID       string            `json:"id" validate:"required,uuid(v4)"`
+	Name     string            `json:"name" validate:"min(1),max(40)"`
+	Count    int               `json:"count" validate:"min(0),max(10)" default:"1"`
+	Tags     []string          `json:"tags" validate:"oneof(a,b,c)"`
+	Attrs    map[string]string `json:"attrs"`
+	Location address           `json:"location"`
+	hidden   string
+}
+
+func TestBuild_ScalarAndTagMapping(t *testing.T) {
+	t.Parallel()
+
+	registry := validation.NewRulesRegistry()
+	node := Build(reflect.TypeOf(widget{}), registry)
+
+	if node["type"] != "object" {
+		t.Fatalf("type = %v, want object", node["type"])
+	}
+	required, _ := node["required"].([]string)
+	if len(required) != 1 || required[0] != "id" {
+		t.Fatalf("required = %v, want [id]", node["required"])
+	}
+
+	props := node["properties"].(map[string]any)
+
+	name := props["name"].(map[string]any)
+	if name["type"] != "string" || name["minLength"] != 1 || name["maxLength"] != 40 {
+		t.Fatalf("name schema wrong: %+v", name)
+	}
+
+	count := props["count"].(map[string]any)
+	if count["type"] != "integer" || count["minimum"] != 0 || count["maximum"] != 10 {
+		t.Fatalf("count schema wrong: %+v", count)
+	}
+	if count["default"] != int64(1) {
+		t.Fatalf("count default = %v, want 1", count["default"])
+	}
+
+	tags := props["tags"].(map[string]any)
+	if tags["type"] != "array" {
+		t.Fatalf("tags type = %v, want array", tags["type"])
+	}
+	items := tags["items"].(map[string]any)
+	if enum, _ := items["enum"].([]string); len(enum) != 0 {
+		t.Fatalf("oneof should annotate the array node, not items: %+v", items)
+	}
+	enum, _ := tags["enum"].([]string)
+	if len(enum) != 3 || enum[0] != "a" || enum[2] != "c" {
+		t.Fatalf("tags enum wrong: %+v", tags["enum"])
+	}
+
+	attrs := props["attrs"].(map[string]any)
+	if attrs["type"] != "object" {
+		t.Fatalf("attrs type = %v, want object", attrs["type"])
+	}
+
+	location := props["location"].(map[string]any)
+	if location["type"] != "object" {
+		t.Fatalf("location type = %v, want object", location["type"])
+	}
+	locationProps := location["properties"].(map[string]any)
+	if _, ok := locationProps["street"]; !ok {
+		t.Fatalf("nested struct fields missing: %+v", location)
+	}
+
+	if _, ok := props["hidden"]; ok {
+		t.Fatalf("unexported field leaked into schema: %+v", props)
+	}
+}
+
+func TestBuild_SchemaContributor(t *testing.T) {
+	t.Parallel()
+
+	type withFormat struct {
+		Email string `validate:"myformat(email)"`
+	}
+
+	registry := validation.NewRulesRegistry()
+	_, err := validation.RegisterRule[string](registry, "myformat", func(string) error { return nil },
+		validation.RuleSchemaContribution(func(node map[string]any, params []string) {
+			node["format"] = params[0]
+		}),
+	)
+	if err != nil {
+		t.Fatalf("RegisterRule: %v", err)
+	}
+
+	node := Build(reflect.TypeOf(withFormat{}), registry)
+	email := node["properties"].(map[string]any)["Email"].(map[string]any)
+	if email["format"] != "email" {
+		t.Fatalf("SchemaContributor not applied: %+v", email)
+	}
+}
+
+func TestBuild_CachedPerType(t *testing.T) {
+	t.Parallel()
+
+	type cacheMe struct {
+		A string `validate:"required"`
+	}
+
+	registry := validation.NewRulesRegistry()
+	first := Build(reflect.TypeOf(cacheMe{}), registry)
+	second := Build(reflect.TypeOf(cacheMe{}), registry)
+
+	// Build caches by type, so repeated calls return the same map instance.
+	first["sentinel"] = true
+	if _, ok := second["sentinel"]; !ok {
+		t.Fatalf("expected Build to return the cached map on the second call")
+	}
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type innerPart struct {
+	Code string `validate:"min(3)"`
+}
+
+type structOnlyHolder struct {
+	Part innerPart `validate:"structonly"`
+}
+
+type noStructLevelHolder struct {
+	Part innerPart `validate:"nostructlevel"`
+}
+
+func TestModel_Validate_structonly(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&structOnlyHolder{Part: innerPart{Code: "a"}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructCheck(m, func(_ context.Context, p *innerPart) error {
+		return errors.New("struct-level check ran")
+	}); err != nil {
+		t.Fatalf("RegisterStructCheck: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected struct-level rule to still run under structonly")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(ve.ForField("Part.Code")) != 0 {
+		t.Fatalf("expected structonly to skip field-level validation of Part.Code, got %+v", ve)
+	}
+	if len(ve.ForField("Part")) != 1 {
+		t.Fatalf("expected the struct-level check to fire at path %q, got %+v", "Part", ve)
+	}
+}
+
+func TestModel_Validate_nostructlevel(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&noStructLevelHolder{Part: innerPart{Code: "a"}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructCheck(m, func(_ context.Context, p *innerPart) error {
+		return errors.New("struct-level check ran")
+	}); err != nil {
+		t.Fatalf("RegisterStructCheck: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected field-level min(3) to still fail under nostructlevel")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(ve.ForField("Part.Code")) != 1 {
+		t.Fatalf("expected nostructlevel to still run field-level validation of Part.Code, got %+v", ve)
+	}
+	if len(ve.ForField("Part")) != 0 {
+		t.Fatalf("expected nostructlevel to suppress the struct-level check, got %+v", ve)
+	}
+}
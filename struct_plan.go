@@ -0,0 +1,103 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// structFieldPlan precomputes the reflection metadata for one exported field
+// of a struct type that validateStructWithRoot needs on every call, so the
+// per-field reflect.StructField fetch, PkgPath visibility check, and Kind
+// branching for pointer/embedded-struct recursion happen once per type
+// instead of once per field per Validate call.
+type structFieldPlan struct {
+	index        int
+	name         string
+	recursePtr   bool // field is a pointer to struct: recurse via Elem() when non-nil
+	recurseEmbed bool // field is itself a struct: recurse directly
+}
+
+// structPlan is the precomputed, per-type field plan for validateStructWithRoot.
+// fieldNameTag records which struct tag (if any) the plan's names were drawn
+// from ("" means the plain Go field name), so a structPlan built under one
+// fieldNameTag is never reused for another.
+type structPlan struct {
+	fields       []structFieldPlan
+	fieldNameTag string
+}
+
+// structPlanKey distinguishes cached plans by both struct type and the
+// configured field-name tag, since WithFieldNameTag changes the "name" every
+// field plan carries for error-path composition.
+type structPlanKey struct {
+	typ          reflect.Type
+	fieldNameTag string
+}
+
+// structPlanCache caches structPlan by (reflect.Type, fieldNameTag) across
+// all typeBindings in the process, since the plan depends only on the
+// struct's shape and the configured field-name tag, not on any particular
+// binding's registry.
+var structPlanCache sync.Map // map[structPlanKey]*structPlan
+
+// structPlanFor returns the cached structPlan for typ under fieldNameTag,
+// building it on first use. Safe for concurrent use.
+func structPlanFor(typ reflect.Type, fieldNameTag string) *structPlan {
+	key := structPlanKey{typ: typ, fieldNameTag: fieldNameTag}
+	if v, ok := structPlanCache.Load(key); ok {
+		return v.(*structPlan)
+	}
+	plan := buildStructPlan(typ, fieldNameTag)
+	actual, _ := structPlanCache.LoadOrStore(key, plan)
+	return actual.(*structPlan)
+}
+
+// buildStructPlan walks typ's fields once, recording plan data for every
+// exported field and skipping unexported ones entirely. When fieldNameTag is
+// non-empty, each field's plan name is resolved from that struct tag (e.g.
+// "json"), falling back to the Go field name if the tag is absent, honoring
+// "-" to mean "use the Go field name" is NOT special-cased here: a literal
+// "-" tag value means "skip from name resolution", so the Go field name is
+// used as well, matching encoding/json's own treatment of "-" as opt-out
+// rather than a requested name.
+func buildStructPlan(typ reflect.Type, fieldNameTag string) *structPlan {
+	plan := &structPlan{fieldNameTag: fieldNameTag}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+		fp := structFieldPlan{index: i, name: resolveFieldName(field, fieldNameTag)}
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct {
+			fp.recursePtr = true
+		}
+		if ft.Kind() == reflect.Struct {
+			fp.recurseEmbed = true
+		}
+		plan.fields = append(plan.fields, fp)
+	}
+	return plan
+}
+
+// resolveFieldName returns the name to use for field in error paths: the
+// value of the fieldNameTag struct tag (stripped of ",omitempty"-style
+// options after the first comma) when fieldNameTag is non-empty and the tag
+// is present and not "-"; otherwise the Go field name.
+func resolveFieldName(field reflect.StructField, fieldNameTag string) string {
+	if fieldNameTag == "" {
+		return field.Name
+	}
+	raw, ok := field.Tag.Lookup(fieldNameTag)
+	if !ok || raw == "-" {
+		return field.Name
+	}
+	if idx := strings.IndexByte(raw, ','); idx != -1 {
+		raw = raw[:idx]
+	}
+	if raw == "" {
+		return field.Name
+	}
+	return raw
+}
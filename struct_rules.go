@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"reflect"
+)
+
+// RegisterStructRule registers a struct-level validation function for TStruct
+// on m. fn runs after every tagged field of a TStruct value has been validated
+// (at any depth reached during traversal, not only at the root), and returns
+// any FieldErrors to append to the resulting ValidationError. Use this for
+// invariants that span multiple fields, e.g.:
+//
+//	model.RegisterStructRule(m, func(ctx context.Context, s Range) []model.FieldError {
+//		if s.Start.After(s.End) {
+//			return []model.FieldError{{Path: "End", Rule: "struct", Err: errors.New("must be after Start")}}
+//		}
+//		return nil
+//	})
+func RegisterStructRule[TObject any, TStruct any](m *Model[TObject], fn func(ctx context.Context, s TStruct) []FieldError) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	typ := reflect.TypeOf((*TStruct)(nil)).Elem()
+	m.binding.addStructRule(typ, func(ctx context.Context, v reflect.Value, _ string) []FieldError {
+		s, ok := v.Interface().(TStruct)
+		if !ok {
+			return nil
+		}
+		return fn(ctx, s)
+	})
+	return nil
+}
+
+// WithStructRules registers one struct-level validation hook for TStruct as a
+// Model construction Option, analogous to WithRules for field-level rules.
+func WithStructRules[TObject any, TStruct any](fn func(ctx context.Context, s TStruct) []FieldError) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return RegisterStructRule(m, fn)
+	}
+}
+
+// RegisterStructCheck registers a struct-level invariant for TStruct that
+// returns a single plain error instead of building FieldErrors by hand, for
+// the common case of one cross-field invariant per struct, e.g.:
+//
+//	model.RegisterStructCheck(m, func(ctx context.Context, s *Range) error {
+//		if s.Start.After(s.End) {
+//			return errors.New("Start must be before End")
+//		}
+//		return nil
+//	})
+//
+// A non-nil error is folded into the ValidationError as a single FieldError
+// with Rule "struct" and Path set to the struct's own dotted path within the
+// document being validated (e.g. "Booking.Range", or "" at the root). The
+// hook fires for every occurrence of TStruct found during traversal,
+// including nested and embedded structs.
+func RegisterStructCheck[TObject any, TStruct any](m *Model[TObject], fn func(ctx context.Context, s *TStruct) error) error {
+	if err := m.ensureBinding(); err != nil {
+		return err
+	}
+	typ := reflect.TypeOf((*TStruct)(nil)).Elem()
+	m.binding.addStructRule(typ, func(ctx context.Context, v reflect.Value, path string) []FieldError {
+		s, ok := v.Interface().(TStruct)
+		if !ok {
+			return nil
+		}
+		if err := fn(ctx, &s); err != nil {
+			return []FieldError{{Path: path, Rule: "struct", Err: err}}
+		}
+		return nil
+	})
+	return nil
+}
+
+// WithStructCheck registers one struct-level invariant for TStruct as a Model
+// construction Option, analogous to WithStructRules but for RegisterStructCheck.
+func WithStructCheck[TObject any, TStruct any](fn func(ctx context.Context, s *TStruct) error) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		return RegisterStructCheck(m, fn)
+	}
+}
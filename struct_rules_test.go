@@ -0,0 +1,69 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type dateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func TestRegisterStructRule(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&dateRange{Start: time.Unix(10, 0), End: time.Unix(5, 0)})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructRule(m, func(_ context.Context, s dateRange) []FieldError {
+		if s.Start.After(s.End) {
+			return []FieldError{{Path: "End", Rule: "struct", Err: errors.New("must be after Start")}}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("RegisterStructRule: %v", err)
+	}
+
+	if err := m.Validate(context.Background()); err == nil {
+		t.Fatalf("expected struct-level rule to fail, got nil")
+	}
+}
+
+type booking struct {
+	Name  string
+	Range dateRange
+}
+
+func TestRegisterStructCheck(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&booking{Name: "x", Range: dateRange{Start: time.Unix(10, 0), End: time.Unix(5, 0)}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := RegisterStructCheck(m, func(_ context.Context, s *dateRange) error {
+		if s.Start.After(s.End) {
+			return errors.New("Start must be before End")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("RegisterStructCheck: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected struct check to fail for nested struct, got nil")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	fes := ve.ForField("Range")
+	if len(fes) != 1 || fes[0].Rule != "struct" {
+		t.Fatalf("expected one struct-rule error at path %q, got %+v", "Range", fes)
+	}
+}
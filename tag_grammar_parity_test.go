@@ -0,0 +1,89 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+// TestModel_Validate_omitemptyWithOrChain_emailOrURL exercises the exact
+// "omitempty,email|url" combination called out as a target example: the OR-
+// chain and omitempty tokens already cooperate for hexcolor|rgb (see
+// or_chain_omitempty_test.go); this documents the same behavior for the
+// email/url rules.
+func TestModel_Validate_omitemptyWithOrChain_emailOrURL(t *testing.T) {
+	t.Parallel()
+
+	type contactDoc struct {
+		Contact string `validate:"omitempty,email|url"`
+	}
+
+	tests := []struct {
+		name    string
+		contact string
+		wantErr bool
+	}{
+		{"zero value skipped by omitempty", "", false},
+		{"email alternative matches", "a@b.com", false},
+		{"url alternative matches", "https://example.com", false},
+		{"neither alternative matches", "not a contact", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&contactDoc{Contact: tt.contact})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestModel_Validate_requiredIf_withMinChain exercises required_if combined
+// with an ordinary chained rule on the same field, per the target example
+// "required_if=Kind admin,min=8": when Kind is "admin", Token must be present
+// and additionally satisfy min(8). min(8) is unconditional (no omitempty), so
+// it also rejects a non-admin account's empty Token, matching how chained
+// rules already combine elsewhere in this package.
+func TestModel_Validate_requiredIf_withMinChain(t *testing.T) {
+	t.Parallel()
+
+	type accountDoc struct {
+		Kind  string
+		Token string `validate:"required_if(Kind,admin),min(8)"`
+	}
+
+	tests := []struct {
+		name    string
+		kind    string
+		token   string
+		wantErr bool
+	}{
+		{"not admin, token long enough: passes", "user", "longenough", false},
+		{"admin, token missing: required_if fails", "admin", "", true},
+		{"admin, token present but short: min fails", "admin", "short", true},
+		{"admin, token present and long enough", "admin", "longenough", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(&accountDoc{Kind: tt.kind, Token: tt.token})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			err = m.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
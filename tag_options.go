@@ -0,0 +1,120 @@
+package model
+
+// WithTagName configures the struct tag name Model reads per-field validation
+// rules from, in place of the default "validate". It must be set before the
+// model's binding is built (i.e. before the first SetDefaults/Validate call).
+func WithTagName[TObject any](name string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		m.tagName = name
+		return nil
+	}
+}
+
+// WithTagNames configures all three struct tag names Model reads at once:
+// validate for per-field rules, validateElem for per-element rules, and
+// defaultTag for literal defaults. Pass "" for any name to keep its default.
+// This is a convenience over calling WithTagName plus setting the other two
+// directly, useful for integrating into codebases that already use `binding:`,
+// `conform:`, or `schema:` tags for other purposes.
+func WithTagNames[TObject any](validate, validateElem, defaultTag string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if validate != "" {
+			m.tagName = validate
+		}
+		if validateElem != "" {
+			m.elemTagName = validateElem
+		}
+		if defaultTag != "" {
+			m.defaultTagName = defaultTag
+		}
+		return nil
+	}
+}
+
+// WithAlias registers alias as shorthand for the given rule-list expansion, so
+// that a validate tag like `validate:"iscolor"` behaves as if it had been
+// written out as `validate:"hexcolor|rgb|rgba|hsl|hsla"`. Aliases are expanded
+// once, at binding build time, and may themselves reference other aliases (up
+// to a bounded depth). WithAlias returns an error if alias is empty, contains
+// rule-grammar punctuation, collides with a reserved tag token, or collides
+// with a built-in rule name; use WithAliasOverride for the last case.
+func WithAlias[TObject any](alias, expansion string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if err := validateAliasName(alias); err != nil {
+			return err
+		}
+		if err := checkAliasBuiltinCollision(alias); err != nil {
+			return err
+		}
+		return registerAlias(m, alias, expansion)
+	}
+}
+
+// WithAliasOverride is WithAlias without the built-in-collision check, for the
+// rare case where a model intentionally wants its alias to shadow a built-in
+// rule of the same name (e.g. a custom "oneof" expansion).
+func WithAliasOverride[TObject any](alias, expansion string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if err := validateAliasName(alias); err != nil {
+			return err
+		}
+		return registerAlias(m, alias, expansion)
+	}
+}
+
+// registerAlias runs cycle detection and records alias -> expansion on m,
+// shared by WithAlias/WithAliasOverride and their RegisterTagAlias* counterparts.
+func registerAlias[TObject any](m *Model[TObject], alias, expansion string) error {
+	if err := detectAliasCycle(alias, expansion, m.aliases); err != nil {
+		return err
+	}
+	if m.aliases == nil {
+		m.aliases = make(map[string]string)
+	}
+	m.aliases[alias] = expansion
+	return nil
+}
+
+// WithFieldNameTag configures Model to compose FieldError.Path from the given
+// struct tag (e.g. "json") instead of Go field names, at every nesting level.
+// A field missing the tag, or carrying "-", falls back to its Go field name.
+// Tag values are stripped of everything after the first comma (so
+// `json:"user_name,omitempty"` resolves to "user_name"). It must be set
+// before the model's binding is built (i.e. before the first
+// SetDefaults/Validate call).
+func WithFieldNameTag[TObject any](tag string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		m.fieldNameTag = tag
+		return nil
+	}
+}
+
+// RegisterTagAlias registers alias as shorthand for expansion directly on an
+// already-constructed Model, with the same validation and cycle detection as
+// WithAlias. It returns an error if the binding has already been built (i.e.
+// SetDefaults/Validate has already run), since aliases are only consulted
+// while parsing tags during binding construction.
+func (m *Model[TObject]) RegisterTagAlias(alias, expansion string) error {
+	if m.binding != nil {
+		return errAliasAfterBinding
+	}
+	if err := validateAliasName(alias); err != nil {
+		return err
+	}
+	if err := checkAliasBuiltinCollision(alias); err != nil {
+		return err
+	}
+	return registerAlias(m, alias, expansion)
+}
+
+// RegisterTagAliasOverride is RegisterTagAlias without the built-in-collision
+// check; see WithAliasOverride.
+func (m *Model[TObject]) RegisterTagAliasOverride(alias, expansion string) error {
+	if m.binding != nil {
+		return errAliasAfterBinding
+	}
+	if err := validateAliasName(alias); err != nil {
+		return err
+	}
+	return registerAlias(m, alias, expansion)
+}
@@ -0,0 +1,51 @@
+package model
+
+// Struct tag names recognized by typeBinding when building and walking a
+// binding for a type.
+const (
+	tagValidate     = "validate"     // per-field validation rules
+	tagValidateElem = "validateElem" // per-element validation rules for slices, arrays and maps
+	tagValidateKey  = "validateKey"  // per-key validation rules for maps
+	tagDefault      = "default"      // per-field default literal
+)
+
+// Tokens with special meaning inside a validateElem tag's rule list.
+const (
+	tagDive    = "dive"    // descend into slice/array elements or map values
+	tagKeys    = "keys"    // begin a map-key rule segment (must be followed by endkeys)
+	tagEndKeys = "endkeys" // end a map-key rule segment; rules after it apply to values
+)
+
+// tagOmitempty, when present among a field's validate tag rules, skips every
+// other rule on that field when the field's value is empty (see
+// typeBinding.isEmptyValue / WithEmptyFunc). It is consumed by the tag
+// executor itself rather than dispatched as a registered rule.
+const tagOmitempty = "omitempty"
+
+// tagStructOnly and tagNoStructLevel, when present among a struct-typed
+// field's validate tag rules, control how validateStructWithRoot recurses
+// into that field's own struct value:
+//   - tagStructOnly skips per-field validation of the nested struct's fields,
+//     while still running any struct-level rules registered for its type.
+//   - tagNoStructLevel runs per-field validation of the nested struct's
+//     fields as usual, but skips running struct-level rules for that
+//     particular occurrence.
+//
+// Like tagOmitempty, neither is dispatched as a registered rule.
+const (
+	tagStructOnly    = "structonly"
+	tagNoStructLevel = "nostructlevel"
+)
+
+// tagStructRule, when present in a blank "_" field's validate tag (the
+// conventional anchor for tokens describing the struct as a whole, since no
+// ordinary field "owns" it), names a hook registered via
+// RegisterNamedStructRule/WithNamedStructRule to run for that struct type:
+//
+//	_ struct{} `validate:"structrule(passwordsMatch)"`
+//
+// Unlike RegisterStructRule, a named hook only runs for types that reference
+// it this way, so the same type can be shared by several named invariants.
+// Like tagOmitempty, it is consumed by the tag executor itself rather than
+// dispatched as a registered rule.
+const tagStructRule = "structrule"
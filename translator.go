@@ -0,0 +1,108 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Translator renders a localized, human-readable message for a FieldError.
+// Attach one to a Model via WithTranslator to localize messages produced by
+// DetailedIssue/ValidationError.MarshalJSONDetailed.
+type Translator interface {
+	Translate(fe FieldError) string
+}
+
+// TranslatorFunc adapts a plain function to the Translator interface.
+type TranslatorFunc func(fe FieldError) string
+
+// Translate implements Translator.
+func (f TranslatorFunc) Translate(fe FieldError) string { return f(fe) }
+
+// ruleMessage is a per-rule default message template and stable error code,
+// registered via WithRuleMessage.
+type ruleMessage struct {
+	code     string
+	template string
+}
+
+// RenderMessageTemplate substitutes "{field}" and "{param0}", "{param1}", ...
+// placeholders in tpl with values from fe.
+func RenderMessageTemplate(tpl string, fe FieldError) string {
+	out := strings.ReplaceAll(tpl, "{field}", fe.Path)
+	for i, p := range fe.Params {
+		out = strings.ReplaceAll(out, fmt.Sprintf("{param%d}", i), p)
+	}
+	return out
+}
+
+// WithTranslator attaches a Translator used to render localized messages for
+// FieldErrors when building DetailedIssues.
+func WithTranslator[TObject any](t Translator) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		m.translator = t
+		return nil
+	}
+}
+
+// WithRuleMessage registers a default message template and stable error code
+// for rule, consulted for the message only when no Translator is set (see
+// Model.Localize); its code is used either way.
+func WithRuleMessage[TObject any](rule, code, template string) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		if m.ruleMessages == nil {
+			m.ruleMessages = make(map[string]ruleMessage)
+		}
+		m.ruleMessages[rule] = ruleMessage{code: code, template: template}
+		return nil
+	}
+}
+
+// DetailedIssue is the machine-readable, localization-aware view of a
+// FieldError, suitable for JSON API error bodies.
+type DetailedIssue struct {
+	Path    string   `json:"path"`
+	Rule    string   `json:"rule"`
+	Params  []string `json:"params,omitempty"`
+	Message string   `json:"message"`
+	Code    string   `json:"code,omitempty"`
+}
+
+// Localize renders fe as a DetailedIssue: m's Translator, if set, supplies the
+// message; otherwise a registered WithRuleMessage template is rendered; as a
+// last resort fe.Error() is used. Code comes from fe.Code if already set,
+// otherwise from the registered template.
+func (m *Model[TObject]) Localize(fe FieldError) DetailedIssue {
+	code := fe.Code
+	msg := ""
+	rm, hasTemplate := m.ruleMessages[fe.Rule]
+	if code == "" && hasTemplate {
+		code = rm.code
+	}
+	switch {
+	case m.translator != nil:
+		msg = m.translator.Translate(fe)
+	case hasTemplate:
+		msg = RenderMessageTemplate(rm.template, fe)
+	default:
+		msg = fe.Error()
+	}
+	return DetailedIssue{Path: fe.Path, Rule: fe.Rule, Params: fe.Params, Message: msg, Code: code}
+}
+
+// LocalizeAll renders every issue in ve as a DetailedIssue, in the style
+// expected by HTTP APIs that need a consistent, localized JSON error body.
+func (m *Model[TObject]) LocalizeAll(ve *ValidationError) []DetailedIssue {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	issues := make([]FieldError, len(ve.issues))
+	copy(issues, ve.issues)
+	ve.mu.Unlock()
+
+	out := make([]DetailedIssue, 0, len(issues))
+	for _, fe := range issues {
+		out = append(out, m.Localize(fe))
+	}
+	return out
+}
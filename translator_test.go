@@ -0,0 +1,78 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type translatedDoc struct {
+	Name string `validate:"nonempty"`
+}
+
+func TestWithRuleMessage(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&translatedDoc{}, WithRuleMessage[translatedDoc]("nonempty", "FIELD_REQUIRED", "{field} is required"))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+
+	details := m.LocalizeAll(ve)
+	if len(details) != 1 {
+		t.Fatalf("expected 1 detail, got %d", len(details))
+	}
+	if details[0].Code != "FIELD_REQUIRED" {
+		t.Fatalf("expected code FIELD_REQUIRED, got %q", details[0].Code)
+	}
+	if details[0].Message != "Name is required" {
+		t.Fatalf("expected rendered message, got %q", details[0].Message)
+	}
+}
+
+func TestWithTranslator(t *testing.T) {
+	t.Parallel()
+
+	tr := TranslatorFunc(func(fe FieldError) string { return "translated: " + fe.Path })
+
+	m, err := New(&translatedDoc{}, WithTranslator[translatedDoc](tr))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	ve := err.(*ValidationError)
+
+	details := m.LocalizeAll(ve)
+	if len(details) != 1 || details[0].Message != "translated: Name" {
+		t.Fatalf("unexpected details: %+v", details)
+	}
+}
+
+func TestValidationError_Details(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(&translatedDoc{})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	err = m.Validate(context.Background())
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+	ve := err.(*ValidationError)
+
+	details := ve.Details()
+	if len(details) != 1 || details[0].Path != "Name" || details[0].Rule != "nonempty" {
+		t.Fatalf("unexpected details: %+v", details)
+	}
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type validateKeyDoc struct {
+	Scores map[string]int `validateKey:"min(3)"`
+}
+
+func TestModel_Validate_validateKeyTag(t *testing.T) {
+	t.Parallel()
+
+	minLen, err := NewRule("min", func(s string, params ...string) error {
+		if len(params) == 1 && len(s) < 3 {
+			return errValidateAllTooShort
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		scores  map[string]int
+		wantErr bool
+	}{
+		{"all keys long enough", map[string]int{"abc": 1, "abcd": 2}, false},
+		{"short key fails", map[string]int{"ab": 1}, true},
+		{"empty map", map[string]int{}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			obj := &validateKeyDoc{Scores: tt.scores}
+			mm, err := New(obj)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			if err := mm.RegisterRules(minLen); err != nil {
+				t.Fatalf("RegisterRules: %v", err)
+			}
+			err = mm.Validate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// This file adds the min/max/len/gt/gte/lt/lte/eq/ne/between family to the
+// built-ins registered by ensureBuiltIns: length-based comparisons for
+// string (getStrMinRule lives in builtin_rules.go; the rest are here), and
+// value-based comparisons for int/int64/float64 and time.Time. Every
+// failure reports both the offending value and the threshold it was
+// compared against via ErrorFieldRuleValue/ErrorFieldRuleParamValue, so log
+// pipelines can filter on model.rule.name without parsing the message.
+
+// stripBytesModifier reports whether params end with the "bytes" modifier
+// (e.g. validate:"min(3,bytes)"), returning the remaining numeric params and
+// the length function to use: rune count by default, byte count ("bytes")
+// when requested. Multi-byte-safe length counting is the default because a
+// validate tag author thinks in characters, not encoded bytes.
+func stripBytesModifier(params []string) (numeric []string, length func(string) int) {
+	if n := len(params); n > 0 && strings.TrimSpace(params[n-1]) == "bytes" {
+		return params[:n-1], func(s string) int { return len(s) }
+	}
+	return params, utf8.RuneCountInString
+}
+
+// parseStrLenThreshold parses a single-parameter string length rule's
+// threshold (after stripping an optional "bytes" modifier), returning the
+// threshold, the length of s under the requested counting mode, and the raw
+// threshold text for error reporting.
+func parseStrLenThreshold(rule string, s string, params []string) (threshold, actual int, raw string, err error) {
+	numeric, length := stripBytesModifier(params)
+	if len(numeric) != 1 {
+		return 0, 0, "", errorc.With(
+			modelerrors.ErrRuleMissingParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+		)
+	}
+	raw = strings.TrimSpace(numeric[0])
+	n, convErr := strconv.Atoi(raw)
+	if convErr != nil {
+		return 0, 0, raw, errorc.With(
+			modelerrors.ErrRuleInvalidParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+			errorc.String(modelerrors.ErrorFieldRuleParamName, "length"),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+			errorc.Error(modelerrors.ErrorFieldCause, convErr),
+		)
+	}
+	return n, length(s), raw, nil
+}
+
+// strLenViolation builds the ErrRuleConstraintViolated error common to every
+// string length-comparison rule below.
+func strLenViolation(rule string, actual int, raw string) error {
+	return errorc.With(
+		modelerrors.ErrRuleConstraintViolated,
+		errorc.String(modelerrors.ErrorFieldRuleName, rule),
+		errorc.String(modelerrors.ErrorFieldRuleValue, strconv.Itoa(actual)),
+		errorc.String(modelerrors.ErrorFieldRuleParamName, "length"),
+		errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+	)
+}
+
+func getStrMaxRule() (Rule, error) {
+	return NewRule[string]("max", func(s string, params ...string) error {
+		threshold, actual, raw, err := parseStrLenThreshold("max", s, params)
+		if err != nil {
+			return err
+		}
+		if actual > threshold {
+			return strLenViolation("max", actual, raw)
+		}
+		return nil
+	})
+}
+
+func getStrLenRule() (Rule, error) {
+	return NewRule[string]("len", func(s string, params ...string) error {
+		threshold, actual, raw, err := parseStrLenThreshold("len", s, params)
+		if err != nil {
+			return err
+		}
+		if actual != threshold {
+			return strLenViolation("len", actual, raw)
+		}
+		return nil
+	})
+}
+
+func getStrBetweenRule() (Rule, error) {
+	return NewRule[string]("between", func(s string, params ...string) error {
+		numeric, length := stripBytesModifier(params)
+		if len(numeric) != 2 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+			)
+		}
+		lo, raw0, err := parseIntParam("between", numeric[0])
+		if err != nil {
+			return err
+		}
+		hi, raw1, err := parseIntParam("between", numeric[1])
+		if err != nil {
+			return err
+		}
+		actual := length(s)
+		if actual < lo || actual > hi {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, strconv.Itoa(actual)),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "length"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, raw0+","+raw1),
+			)
+		}
+		return nil
+	})
+}
+
+func parseIntParam(rule, raw string) (int, string, error) {
+	raw = strings.TrimSpace(raw)
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, raw, errorc.With(
+			modelerrors.ErrRuleInvalidParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+			errorc.Error(modelerrors.ErrorFieldCause, err),
+		)
+	}
+	return n, raw, nil
+}
+
+// strCompareRule builds a string length comparison rule (gt/gte/lt/lte/eq/ne)
+// sharing parsing and error shape; cmp reports whether actual satisfies the
+// rule against threshold.
+func strCompareRule(name string, cmp func(actual, threshold int) bool) (Rule, error) {
+	return NewRule[string](name, func(s string, params ...string) error {
+		threshold, actual, raw, err := parseStrLenThreshold(name, s, params)
+		if err != nil {
+			return err
+		}
+		if !cmp(actual, threshold) {
+			return strLenViolation(name, actual, raw)
+		}
+		return nil
+	})
+}
+
+func getStrGtRule() (Rule, error) { return strCompareRule("gt", func(a, t int) bool { return a > t }) }
+func getStrGteRule() (Rule, error) {
+	return strCompareRule("gte", func(a, t int) bool { return a >= t })
+}
+func getStrLtRule() (Rule, error) { return strCompareRule("lt", func(a, t int) bool { return a < t }) }
+func getStrLteRule() (Rule, error) {
+	return strCompareRule("lte", func(a, t int) bool { return a <= t })
+}
+func getStrEqRule() (Rule, error) { return strCompareRule("eq", func(a, t int) bool { return a == t }) }
+func getStrNeRule() (Rule, error) { return strCompareRule("ne", func(a, t int) bool { return a != t }) }
+
+// numeric is the set of Go kinds the int/int64/float64 comparison rules
+// below are instantiated for.
+type numeric interface{ ~int | ~int64 | ~float64 }
+
+// parseNumericParam parses a single numeric rule parameter for T.
+func parseNumericParam[T numeric](rule string, raw string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	v, err := parse(strings.TrimSpace(raw))
+	if err != nil {
+		return zero, errorc.With(
+			modelerrors.ErrRuleInvalidParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+			errorc.Error(modelerrors.ErrorFieldCause, err),
+		)
+	}
+	return v, nil
+}
+
+// numericCompareRule builds a value-comparison rule (gt/gte/lt/lte/eq/ne/max)
+// for numeric type T, sharing parameter parsing and error shape across the
+// int/int64/float64 instantiations.
+func numericCompareRule[T numeric](name string, parse func(string) (T, error), cmp func(v, threshold T) bool) (Rule, error) {
+	return NewRule[T](name, func(v T, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+			)
+		}
+		raw := strings.TrimSpace(params[0])
+		threshold, err := parseNumericParam(name, raw, parse)
+		if err != nil {
+			return err
+		}
+		if !cmp(v, threshold) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+				errorc.String(modelerrors.ErrorFieldRuleValue, formatNumeric(v)),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "threshold"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+			)
+		}
+		return nil
+	})
+}
+
+// numericBetweenRule builds the "between(lo,hi)" rule for numeric type T.
+func numericBetweenRule[T numeric](parse func(string) (T, error)) (Rule, error) {
+	return NewRule[T]("between", func(v T, params ...string) error {
+		if len(params) != 2 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+			)
+		}
+		lo, err := parseNumericParam[T]("between", params[0], parse)
+		if err != nil {
+			return err
+		}
+		hi, err := parseNumericParam[T]("between", params[1], parse)
+		if err != nil {
+			return err
+		}
+		if v < lo || v > hi {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, formatNumeric(v)),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "threshold"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, strings.TrimSpace(params[0])+","+strings.TrimSpace(params[1])),
+			)
+		}
+		return nil
+	})
+}
+
+func formatNumeric[T numeric](v T) string {
+	if f, ok := any(v).(float64); ok {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return fmt.Sprintf("%d", v)
+}
+
+func parseIntValue(s string) (int, error) {
+	v, err := strconv.ParseInt(s, 10, 0)
+	return int(v), err
+}
+
+func parseInt64Value(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }
+
+func parseFloat64Value(s string) (float64, error) { return strconv.ParseFloat(s, 64) }
+
+func getIntMaxRule() (Rule, error) {
+	return numericCompareRule("max", parseIntValue, func(v, t int) bool { return v <= t })
+}
+func getIntGtRule() (Rule, error) {
+	return numericCompareRule("gt", parseIntValue, func(v, t int) bool { return v > t })
+}
+func getIntGteRule() (Rule, error) {
+	return numericCompareRule("gte", parseIntValue, func(v, t int) bool { return v >= t })
+}
+func getIntLtRule() (Rule, error) {
+	return numericCompareRule("lt", parseIntValue, func(v, t int) bool { return v < t })
+}
+func getIntLteRule() (Rule, error) {
+	return numericCompareRule("lte", parseIntValue, func(v, t int) bool { return v <= t })
+}
+func getIntEqRule() (Rule, error) {
+	return numericCompareRule("eq", parseIntValue, func(v, t int) bool { return v == t })
+}
+func getIntNeRule() (Rule, error) {
+	return numericCompareRule("ne", parseIntValue, func(v, t int) bool { return v != t })
+}
+func getIntBetweenRule() (Rule, error) { return numericBetweenRule(parseIntValue) }
+
+func getInt64MaxRule() (Rule, error) {
+	return numericCompareRule("max", parseInt64Value, func(v, t int64) bool { return v <= t })
+}
+func getInt64GtRule() (Rule, error) {
+	return numericCompareRule("gt", parseInt64Value, func(v, t int64) bool { return v > t })
+}
+func getInt64GteRule() (Rule, error) {
+	return numericCompareRule("gte", parseInt64Value, func(v, t int64) bool { return v >= t })
+}
+func getInt64LtRule() (Rule, error) {
+	return numericCompareRule("lt", parseInt64Value, func(v, t int64) bool { return v < t })
+}
+func getInt64LteRule() (Rule, error) {
+	return numericCompareRule("lte", parseInt64Value, func(v, t int64) bool { return v <= t })
+}
+func getInt64EqRule() (Rule, error) {
+	return numericCompareRule("eq", parseInt64Value, func(v, t int64) bool { return v == t })
+}
+func getInt64NeRule() (Rule, error) {
+	return numericCompareRule("ne", parseInt64Value, func(v, t int64) bool { return v != t })
+}
+func getInt64BetweenRule() (Rule, error) { return numericBetweenRule(parseInt64Value) }
+
+func getFloat64MaxRule() (Rule, error) {
+	return numericCompareRule("max", parseFloat64Value, func(v, t float64) bool { return v <= t })
+}
+func getFloat64GtRule() (Rule, error) {
+	return numericCompareRule("gt", parseFloat64Value, func(v, t float64) bool { return v > t })
+}
+func getFloat64GteRule() (Rule, error) {
+	return numericCompareRule("gte", parseFloat64Value, func(v, t float64) bool { return v >= t })
+}
+func getFloat64LtRule() (Rule, error) {
+	return numericCompareRule("lt", parseFloat64Value, func(v, t float64) bool { return v < t })
+}
+func getFloat64LteRule() (Rule, error) {
+	return numericCompareRule("lte", parseFloat64Value, func(v, t float64) bool { return v <= t })
+}
+func getFloat64EqRule() (Rule, error) {
+	return numericCompareRule("eq", parseFloat64Value, func(v, t float64) bool { return v == t })
+}
+func getFloat64NeRule() (Rule, error) {
+	return numericCompareRule("ne", parseFloat64Value, func(v, t float64) bool { return v != t })
+}
+func getFloat64BetweenRule() (Rule, error) { return numericBetweenRule(parseFloat64Value) }
+
+// parseTimeThreshold resolves a time.Time rule parameter: the special tokens
+// "now", "utcnow" (both the instant the rule runs, in UTC) and "today" (UTC
+// midnight), or an RFC3339 literal (e.g. "2026-01-01T00:00:00Z").
+func parseTimeThreshold(rule, raw string) (time.Time, error) {
+	switch strings.TrimSpace(raw) {
+	case "now", "utcnow":
+		return time.Now().UTC(), nil
+	case "today":
+		return time.Now().UTC().Truncate(24 * time.Hour), nil
+	}
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(raw))
+	if err != nil {
+		return time.Time{}, errorc.With(
+			modelerrors.ErrRuleInvalidParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+			errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
+			errorc.Error(modelerrors.ErrorFieldCause, err),
+		)
+	}
+	return t, nil
+}
+
+// timeCompareRule builds a time.Time comparison rule (min/max/gt/gte/lt/lte/
+// eq/ne) that resolves its single parameter via parseTimeThreshold.
+func timeCompareRule(name string, cmp func(v, threshold time.Time) bool) (Rule, error) {
+	return NewRule[time.Time](name, func(v time.Time, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+			)
+		}
+		threshold, err := parseTimeThreshold(name, params[0])
+		if err != nil {
+			return err
+		}
+		if !cmp(v, threshold) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, name),
+				errorc.String(modelerrors.ErrorFieldRuleValue, v.Format(time.RFC3339)),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "threshold"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, threshold.Format(time.RFC3339)),
+			)
+		}
+		return nil
+	})
+}
+
+func getTimeMinRule() (Rule, error) {
+	return timeCompareRule("min", func(v, t time.Time) bool { return !v.Before(t) })
+}
+func getTimeMaxRule() (Rule, error) {
+	return timeCompareRule("max", func(v, t time.Time) bool { return !v.After(t) })
+}
+func getTimeGtRule() (Rule, error) {
+	return timeCompareRule("gt", func(v, t time.Time) bool { return v.After(t) })
+}
+func getTimeGteRule() (Rule, error) {
+	return timeCompareRule("gte", func(v, t time.Time) bool { return !v.Before(t) })
+}
+func getTimeLtRule() (Rule, error) {
+	return timeCompareRule("lt", func(v, t time.Time) bool { return v.Before(t) })
+}
+func getTimeLteRule() (Rule, error) {
+	return timeCompareRule("lte", func(v, t time.Time) bool { return !v.After(t) })
+}
+func getTimeEqRule() (Rule, error) {
+	return timeCompareRule("eq", func(v, t time.Time) bool { return v.Equal(t) })
+}
+func getTimeNeRule() (Rule, error) {
+	return timeCompareRule("ne", func(v, t time.Time) bool { return !v.Equal(t) })
+}
+
+func getTimeBetweenRule() (Rule, error) {
+	return NewRule[time.Time]("between", func(v time.Time, params ...string) error {
+		if len(params) != 2 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+			)
+		}
+		lo, err := parseTimeThreshold("between", params[0])
+		if err != nil {
+			return err
+		}
+		hi, err := parseTimeThreshold("between", params[1])
+		if err != nil {
+			return err
+		}
+		if v.Before(lo) || v.After(hi) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "between"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, v.Format(time.RFC3339)),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "threshold"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, lo.Format(time.RFC3339)+","+hi.Format(time.RFC3339)),
+			)
+		}
+		return nil
+	})
+}
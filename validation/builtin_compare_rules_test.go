@@ -0,0 +1,163 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+func TestBuiltinCompareRules_stringFamily(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule    string
+		value   string
+		params  []string
+		wantErr bool
+	}{
+		{"max", "abc", []string{"3"}, false},
+		{"max", "abcd", []string{"3"}, true},
+		{"len", "abc", []string{"3"}, false},
+		{"len", "ab", []string{"3"}, true},
+		{"between", "abc", []string{"2", "4"}, false},
+		{"between", "a", []string{"2", "4"}, true},
+		{"gt", "abc", []string{"2"}, false},
+		{"gt", "ab", []string{"2"}, true},
+		{"gte", "ab", []string{"2"}, false},
+		{"lt", "a", []string{"2"}, false},
+		{"lte", "ab", []string{"2"}, false},
+		{"eq", "ab", []string{"2"}, false},
+		{"eq", "abc", []string{"2"}, true},
+		{"ne", "abc", []string{"2"}, false},
+		// "ñ" is one rune but two UTF-8 bytes: max(1) passes by rune count.
+		{"max", "ñ", []string{"1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule+"/"+tt.value, func(t *testing.T) {
+			r := builtinRuleForTest(t, tt.rule, reflect.TypeOf(""))
+			err := r.GetValidationFn()(reflect.ValueOf(tt.value), tt.params...)
+			if tt.wantErr && err == nil {
+				t.Fatalf("%s(%v) on %q: expected an error, got nil", tt.rule, tt.params, tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("%s(%v) on %q: expected no error, got %v", tt.rule, tt.params, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestBuiltinCompareRules_stringBytesModifier(t *testing.T) {
+	t.Parallel()
+
+	r := builtinRuleForTest(t, "max", reflect.TypeOf(""))
+	// "ñ" is 1 rune but 2 bytes: max(1) passes by rune count, fails with "bytes".
+	if err := r.GetValidationFn()(reflect.ValueOf("ñ"), "1"); err != nil {
+		t.Fatalf("expected max(1) to pass by rune count, got %v", err)
+	}
+	if err := r.GetValidationFn()(reflect.ValueOf("ñ"), "1", "bytes"); err == nil {
+		t.Fatalf("expected max(1,bytes) to fail by byte count")
+	}
+}
+
+func TestBuiltinCompareRules_stringErrorFields(t *testing.T) {
+	t.Parallel()
+
+	r := builtinRuleForTest(t, "max", reflect.TypeOf(""))
+	err := r.GetValidationFn()(reflect.ValueOf("abcd"), "3")
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if !errors.Is(err, modelerrors.ErrRuleConstraintViolated) {
+		t.Fatalf("expected ErrRuleConstraintViolated, got %v", err)
+	}
+}
+
+func TestBuiltinCompareRules_intFamily(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule    string
+		value   int
+		params  []string
+		wantErr bool
+	}{
+		{"max", 3, []string{"5"}, false},
+		{"max", 6, []string{"5"}, true},
+		{"between", 3, []string{"1", "5"}, false},
+		{"between", 6, []string{"1", "5"}, true},
+		{"gt", 3, []string{"2"}, false},
+		{"gte", 2, []string{"2"}, false},
+		{"lt", 1, []string{"2"}, false},
+		{"lte", 2, []string{"2"}, false},
+		{"eq", 2, []string{"2"}, false},
+		{"ne", 3, []string{"2"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r := builtinRuleForTest(t, tt.rule, reflect.TypeOf(0))
+			err := r.GetValidationFn()(reflect.ValueOf(tt.value), tt.params...)
+			if tt.wantErr && err == nil {
+				t.Fatalf("%s(%v) on %d: expected an error, got nil", tt.rule, tt.params, tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("%s(%v) on %d: expected no error, got %v", tt.rule, tt.params, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestBuiltinCompareRules_float64Family(t *testing.T) {
+	t.Parallel()
+
+	r := builtinRuleForTest(t, "between", reflect.TypeOf(float64(0)))
+	if err := r.GetValidationFn()(reflect.ValueOf(2.5), "1.0", "5.0"); err != nil {
+		t.Fatalf("expected 2.5 to be within [1.0,5.0], got %v", err)
+	}
+	if err := r.GetValidationFn()(reflect.ValueOf(5.5), "1.0", "5.0"); err == nil {
+		t.Fatalf("expected 5.5 to be outside [1.0,5.0]")
+	}
+}
+
+func TestBuiltinCompareRules_timeFamily(t *testing.T) {
+	t.Parallel()
+
+	timeType := reflect.TypeOf(time.Time{})
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("min against RFC3339 literal", func(t *testing.T) {
+		r := builtinRuleForTest(t, "min", timeType)
+		if err := r.GetValidationFn()(reflect.ValueOf(future), "2021-01-01T00:00:00Z"); err != nil {
+			t.Fatalf("expected future time to satisfy min, got %v", err)
+		}
+		if err := r.GetValidationFn()(reflect.ValueOf(past), "2021-01-01T00:00:00Z"); err == nil {
+			t.Fatalf("expected past time to fail min")
+		}
+	})
+
+	t.Run("lt against now", func(t *testing.T) {
+		r := builtinRuleForTest(t, "lt", timeType)
+		if err := r.GetValidationFn()(reflect.ValueOf(past), "now"); err != nil {
+			t.Fatalf("expected a past time to be lt now, got %v", err)
+		}
+		if err := r.GetValidationFn()(reflect.ValueOf(future), "now"); err == nil {
+			t.Fatalf("expected a future time to fail lt now")
+		}
+	})
+
+	t.Run("between today and future", func(t *testing.T) {
+		r := builtinRuleForTest(t, "between", timeType)
+		now := time.Now().UTC()
+		if err := r.GetValidationFn()(reflect.ValueOf(now), "today", "utcnow"); err != nil {
+			t.Fatalf("expected now to be within [today,utcnow], got %v", err)
+		}
+		if err := r.GetValidationFn()(reflect.ValueOf(past), "today", "utcnow"); err == nil {
+			t.Fatalf("expected a past time to fail between today and utcnow")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package validation
+
+import (
+	"fmt"
+)
+
+// RegisterBuiltinConstructors adds RuleConstructors for the built-in rules
+// that make sense to configure declaratively (email, nonempty, length,
+// numeric range) to reg, so a policy document's `{"type": "length", ...}`
+// entries can be resolved without the caller hand-writing a constructor.
+func RegisterBuiltinConstructors(reg RulesRegistry) error {
+	ctors := map[string]RuleConstructor{
+		"email":    emailRuleConstructor,
+		"nonempty": nonemptyRuleConstructor,
+		"length":   lengthRuleConstructor,
+		"range":    rangeRuleConstructor,
+	}
+	for name, ctor := range ctors {
+		if err := reg.AddConstructor(name, ctor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func emailRuleConstructor(map[string]any) (Rule, error) {
+	return getStrEmailRule()
+}
+
+func nonemptyRuleConstructor(map[string]any) (Rule, error) {
+	return NewRule[string]("nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return fmt.Errorf("nonempty: value must not be empty")
+		}
+		return nil
+	})
+}
+
+// lengthRuleConstructor builds a "length" rule from a {"min": n, "max": n}
+// parameter bag; either bound may be omitted (0 disables that bound).
+func lengthRuleConstructor(params map[string]any) (Rule, error) {
+	minLen, err := intParam(params, "min")
+	if err != nil {
+		return nil, err
+	}
+	maxLen, err := intParam(params, "max")
+	if err != nil {
+		return nil, err
+	}
+	return NewRule[string]("length", func(s string, _ ...string) error {
+		if minLen > 0 && len(s) < minLen {
+			return fmt.Errorf("length: %q is shorter than the minimum length %d", s, minLen)
+		}
+		if maxLen > 0 && len(s) > maxLen {
+			return fmt.Errorf("length: %q is longer than the maximum length %d", s, maxLen)
+		}
+		return nil
+	})
+}
+
+// rangeRuleConstructor builds a "range" rule for float64 fields from a
+// {"min": n, "max": n} parameter bag; either bound may be omitted.
+func rangeRuleConstructor(params map[string]any) (Rule, error) {
+	minVal, hasMin, err := floatParam(params, "min")
+	if err != nil {
+		return nil, err
+	}
+	maxVal, hasMax, err := floatParam(params, "max")
+	if err != nil {
+		return nil, err
+	}
+	return NewRule[float64]("range", func(n float64, _ ...string) error {
+		if hasMin && n < minVal {
+			return fmt.Errorf("range: %v is below the minimum %v", n, minVal)
+		}
+		if hasMax && n > maxVal {
+			return fmt.Errorf("range: %v is above the maximum %v", n, maxVal)
+		}
+		return nil
+	})
+}
+
+// intParam extracts an int parameter from a decoded JSON-like params map,
+// where numbers typically arrive as float64. Returns 0 if key is absent.
+func intParam(params map[string]any, key string) (int, error) {
+	raw, ok := params[key]
+	if !ok {
+		return 0, nil
+	}
+	switch v := raw.(type) {
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("parameter %q must be a number, got %T", key, raw)
+	}
+}
+
+// floatParam extracts a float64 parameter, reporting whether key was present.
+func floatParam(params map[string]any, key string) (float64, bool, error) {
+	raw, ok := params[key]
+	if !ok {
+		return 0, false, nil
+	}
+	switch v := raw.(type) {
+	case float64:
+		return v, true, nil
+	case int:
+		return float64(v), true, nil
+	default:
+		return 0, false, fmt.Errorf("parameter %q must be a number, got %T", key, raw)
+	}
+}
@@ -0,0 +1,318 @@
+package validation
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// This file adds the string-shape/format rule pack requested alongside
+// email: url/uri, uuid (and its versioned uuid3/uuid4/uuid5 overloads),
+// ipv4/ipv6/cidr, hostname_rfc1123, base64/base64url, json, alpha/alphanum/
+// numeric/ascii/printascii, contains/startswith/endswith, and regex, all
+// registered into builtinStringRules by ensureBuiltIns (see builtin_rules.go).
+
+var (
+	uuidPattern         = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	uuidVersionPatterns = map[string]*regexp.Regexp{
+		"3": regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-3[0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`),
+		"4": regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-4[0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`),
+		"5": regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-5[0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`),
+	}
+	hostnameRFC1123Pattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+	alphaPattern           = regexp.MustCompile(`^[a-zA-Z]+$`)
+	alphanumPattern        = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	numericPattern         = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
+	asciiPattern           = regexp.MustCompile(`^[\x00-\x7F]*$`)
+	printAsciiPattern      = regexp.MustCompile(`^[\x20-\x7E]*$`)
+)
+
+// regexRuleCache memoizes compiled patterns for the "regex" built-in rule, so
+// validating many values against the same validate:"regex(...)" tag compiles
+// the pattern once rather than on every call.
+var regexRuleCache sync.Map // pattern string -> *regexp.Regexp
+
+// compiledPattern returns the cached *regexp.Regexp for pattern, compiling
+// and caching it on first use.
+func compiledPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexRuleCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := regexRuleCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
+
+// shapeViolation builds the ErrRuleConstraintViolated error common to every
+// shape/format rule below that doesn't need a param name/value.
+func shapeViolation(rule, value string) error {
+	return errorc.With(
+		modelerrors.ErrRuleConstraintViolated,
+		errorc.String(modelerrors.ErrorFieldRuleName, rule),
+		errorc.String(modelerrors.ErrorFieldRuleValue, value),
+	)
+}
+
+func getStrURLRule() (Rule, error) {
+	return NewRule[string]("url", func(s string, _ ...string) error {
+		u, err := url.ParseRequestURI(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return shapeViolation("url", s)
+		}
+		return nil
+	})
+}
+
+func getStrURIRule() (Rule, error) {
+	return NewRule[string]("uri", func(s string, _ ...string) error {
+		if _, err := url.ParseRequestURI(s); err != nil {
+			return shapeViolation("uri", s)
+		}
+		return nil
+	})
+}
+
+func getStrUUIDRule() (Rule, error) {
+	return NewRule[string]("uuid", func(s string, _ ...string) error {
+		if !uuidPattern.MatchString(s) {
+			return shapeViolation("uuid", s)
+		}
+		return nil
+	})
+}
+
+// strUUIDVersionRule builds the uuid3/uuid4/uuid5 overloads, each requiring
+// its RFC 4122 version nibble and one of the two RFC-defined variant nibbles.
+func strUUIDVersionRule(version string) (Rule, error) {
+	name := "uuid" + version
+	pattern := uuidVersionPatterns[version]
+	return NewRule[string](name, func(s string, _ ...string) error {
+		if !pattern.MatchString(s) {
+			return shapeViolation(name, s)
+		}
+		return nil
+	})
+}
+
+func getStrUUID3Rule() (Rule, error) { return strUUIDVersionRule("3") }
+func getStrUUID4Rule() (Rule, error) { return strUUIDVersionRule("4") }
+func getStrUUID5Rule() (Rule, error) { return strUUIDVersionRule("5") }
+
+func getStrIPv4Rule() (Rule, error) {
+	return NewRule[string]("ipv4", func(s string, _ ...string) error {
+		if ip := net.ParseIP(s); ip == nil || ip.To4() == nil {
+			return shapeViolation("ipv4", s)
+		}
+		return nil
+	})
+}
+
+func getStrIPv6Rule() (Rule, error) {
+	return NewRule[string]("ipv6", func(s string, _ ...string) error {
+		if ip := net.ParseIP(s); ip == nil || ip.To4() != nil {
+			return shapeViolation("ipv6", s)
+		}
+		return nil
+	})
+}
+
+func getStrCIDRRule() (Rule, error) {
+	return NewRule[string]("cidr", func(s string, _ ...string) error {
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			return shapeViolation("cidr", s)
+		}
+		return nil
+	})
+}
+
+func getStrHostnameRFC1123Rule() (Rule, error) {
+	return NewRule[string]("hostname_rfc1123", func(s string, _ ...string) error {
+		if s == "" || !hostnameRFC1123Pattern.MatchString(s) {
+			return shapeViolation("hostname_rfc1123", s)
+		}
+		return nil
+	})
+}
+
+func getStrBase64Rule() (Rule, error) {
+	return NewRule[string]("base64", func(s string, _ ...string) error {
+		if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+			return shapeViolation("base64", s)
+		}
+		return nil
+	})
+}
+
+func getStrBase64URLRule() (Rule, error) {
+	return NewRule[string]("base64url", func(s string, _ ...string) error {
+		if _, err := base64.URLEncoding.DecodeString(s); err != nil {
+			return shapeViolation("base64url", s)
+		}
+		return nil
+	})
+}
+
+func getStrJSONRule() (Rule, error) {
+	return NewRule[string]("json", func(s string, _ ...string) error {
+		if !json.Valid([]byte(s)) {
+			return shapeViolation("json", s)
+		}
+		return nil
+	})
+}
+
+func getStrAlphaRule() (Rule, error) {
+	return NewRule[string]("alpha", func(s string, _ ...string) error {
+		if !alphaPattern.MatchString(s) {
+			return shapeViolation("alpha", s)
+		}
+		return nil
+	})
+}
+
+func getStrAlphanumRule() (Rule, error) {
+	return NewRule[string]("alphanum", func(s string, _ ...string) error {
+		if !alphanumPattern.MatchString(s) {
+			return shapeViolation("alphanum", s)
+		}
+		return nil
+	})
+}
+
+func getStrNumericRule() (Rule, error) {
+	return NewRule[string]("numeric", func(s string, _ ...string) error {
+		if !numericPattern.MatchString(s) {
+			return shapeViolation("numeric", s)
+		}
+		return nil
+	})
+}
+
+func getStrASCIIRule() (Rule, error) {
+	return NewRule[string]("ascii", func(s string, _ ...string) error {
+		if !asciiPattern.MatchString(s) {
+			return shapeViolation("ascii", s)
+		}
+		return nil
+	})
+}
+
+func getStrPrintASCIIRule() (Rule, error) {
+	return NewRule[string]("printascii", func(s string, _ ...string) error {
+		if !printAsciiPattern.MatchString(s) {
+			return shapeViolation("printascii", s)
+		}
+		return nil
+	})
+}
+
+func getStrContainsRule() (Rule, error) {
+	return NewRule[string]("contains", func(s string, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "contains"),
+			)
+		}
+		if !strings.Contains(s, params[0]) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "contains"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, s),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "substr"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, params[0]),
+			)
+		}
+		return nil
+	})
+}
+
+func getStrStartswithRule() (Rule, error) {
+	return NewRule[string]("startswith", func(s string, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "startswith"),
+			)
+		}
+		if !strings.HasPrefix(s, params[0]) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "startswith"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, s),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "prefix"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, params[0]),
+			)
+		}
+		return nil
+	})
+}
+
+func getStrEndswithRule() (Rule, error) {
+	return NewRule[string]("endswith", func(s string, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "endswith"),
+			)
+		}
+		if !strings.HasSuffix(s, params[0]) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "endswith"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, s),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "suffix"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, params[0]),
+			)
+		}
+		return nil
+	})
+}
+
+// getStrRegexRule builds the "regex" rule. A pattern that fails to compile
+// surfaces as ErrInvalidValue (not ErrRuleInvalidParameter, used for
+// malformed non-pattern parameters elsewhere in this package) tagged with
+// ErrorFieldRuleName="regex", so a misconfigured validate:"regex(...)" tag is
+// reported distinctly from an otherwise-valid-but-failing pattern match, the
+// first time it's evaluated rather than silently matching everything.
+func getStrRegexRule() (Rule, error) {
+	return NewRule[string]("regex", func(s string, params ...string) error {
+		if len(params) != 1 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldRuleName, "regex"),
+			)
+		}
+		re, err := compiledPattern(params[0])
+		if err != nil {
+			return errorc.With(
+				modelerrors.ErrInvalidValue,
+				errorc.String(modelerrors.ErrorFieldRuleName, "regex"),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "pattern"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, params[0]),
+				errorc.Error(modelerrors.ErrorFieldCause, err),
+			)
+		}
+		if !re.MatchString(s) {
+			return errorc.With(
+				modelerrors.ErrRuleConstraintViolated,
+				errorc.String(modelerrors.ErrorFieldRuleName, "regex"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, s),
+				errorc.String(modelerrors.ErrorFieldRuleParamName, "pattern"),
+				errorc.String(modelerrors.ErrorFieldRuleParamValue, params[0]),
+			)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+func TestBuiltinFormatRules_stringFamily(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rule    string
+		value   string
+		params  []string
+		wantErr bool
+	}{
+		{"url", "https://example.com/path", nil, false},
+		{"url", "not a url", nil, true},
+		{"uri", "mailto:a@b.com", nil, false},
+		{"uri", "\x7f", nil, true},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", nil, false},
+		{"uuid", "not-a-uuid", nil, true},
+		{"uuid3", "a3bb189e-8bf9-3888-9912-ace4e6543002", nil, false},
+		{"uuid3", "123e4567-e89b-42d3-a456-426614174000", nil, true},
+		{"uuid4", "123e4567-e89b-42d3-a456-426614174000", nil, false},
+		{"uuid4", "123e4567-e89b-32d3-a456-426614174000", nil, true},
+		{"uuid5", "74738ff5-5367-5958-9aee-98fffdcd1876", nil, false},
+		{"uuid5", "123e4567-e89b-42d3-a456-426614174000", nil, true},
+		{"ipv4", "192.168.1.1", nil, false},
+		{"ipv4", "::1", nil, true},
+		{"ipv6", "::1", nil, false},
+		{"ipv6", "192.168.1.1", nil, true},
+		{"cidr", "192.168.1.0/24", nil, false},
+		{"cidr", "192.168.1.0", nil, true},
+		{"hostname_rfc1123", "example.com", nil, false},
+		{"hostname_rfc1123", "-bad-.com", nil, true},
+		{"base64", "aGVsbG8=", nil, false},
+		{"base64", "not base64!", nil, true},
+		{"base64url", "aGVsbG8=", nil, false},
+		{"base64url", "not base64!", nil, true},
+		{"json", `{"a":1}`, nil, false},
+		{"json", "{not json}", nil, true},
+		{"alpha", "abcXYZ", nil, false},
+		{"alpha", "abc123", nil, true},
+		{"alphanum", "abc123", nil, false},
+		{"alphanum", "abc-123", nil, true},
+		{"numeric", "-12.5", nil, false},
+		{"numeric", "12a", nil, true},
+		{"ascii", "hello", nil, false},
+		{"ascii", "ñ", nil, true},
+		{"printascii", "hello world", nil, false},
+		{"printascii", "hello\tworld", nil, true},
+		{"contains", "hello world", []string{"world"}, false},
+		{"contains", "hello world", []string{"xyz"}, true},
+		{"startswith", "hello world", []string{"hello"}, false},
+		{"startswith", "hello world", []string{"world"}, true},
+		{"endswith", "hello world", []string{"world"}, false},
+		{"endswith", "hello world", []string{"hello"}, true},
+		{"regex", "abc123", []string{`^[a-z]+[0-9]+$`}, false},
+		{"regex", "123abc", []string{`^[a-z]+[0-9]+$`}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule+"/"+tt.value, func(t *testing.T) {
+			r := builtinRuleForTest(t, tt.rule, reflect.TypeOf(""))
+			err := r.GetValidationFn()(reflect.ValueOf(tt.value), tt.params...)
+			if tt.wantErr && err == nil {
+				t.Fatalf("%s(%v) on %q: expected an error, got nil", tt.rule, tt.params, tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("%s(%v) on %q: expected no error, got %v", tt.rule, tt.params, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestBuiltinFormatRules_regexInvalidPatternSurfacesErrInvalidValue(t *testing.T) {
+	t.Parallel()
+
+	r := builtinRuleForTest(t, "regex", reflect.TypeOf(""))
+	err := r.GetValidationFn()(reflect.ValueOf("anything"), "[unterminated")
+	if err == nil {
+		t.Fatalf("expected an error for an invalid pattern")
+	}
+	if !errors.Is(err, modelerrors.ErrInvalidValue) {
+		t.Fatalf("expected ErrInvalidValue, got %v", err)
+	}
+}
+
+func TestBuiltinFormatRules_regexCachesCompiledPattern(t *testing.T) {
+	t.Parallel()
+
+	pattern := `^cache-me$`
+	re1, err := compiledPattern(pattern)
+	if err != nil {
+		t.Fatalf("compiledPattern: %v", err)
+	}
+	re2, err := compiledPattern(pattern)
+	if err != nil {
+		t.Fatalf("compiledPattern: %v", err)
+	}
+	if re1 != re2 {
+		t.Fatalf("expected compiledPattern to return the same cached *regexp.Regexp for the same pattern")
+	}
+}
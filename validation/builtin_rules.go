@@ -27,19 +27,23 @@ var (
 	builtinIntRules     []Rule
 	builtinInt64Rules   []Rule
 	builtinFloat64Rules []Rule
+	builtinTimeRules    []Rule
 )
 
 // string rules
-// min(length): requires one integer parameter. If missing -> error. If <1 -> noop.
+// min(length): requires one integer parameter, counting runes by default
+// (append a "bytes" modifier, e.g. min(3,bytes), to count bytes instead). If
+// missing -> error. If <1 -> noop.
 func getStrMinRule() (Rule, error) {
 	return NewRule[string]("min", func(s string, params ...string) error {
-		if len(params) == 0 {
+		numeric, length := stripBytesModifier(params)
+		if len(numeric) == 0 {
 			return errorc.With(
 				modelerrors.ErrRuleMissingParameter,
 				errorc.String(modelerrors.ErrorFieldRuleName, "min"),
 			)
 		}
-		raw := strings.TrimSpace(params[0])
+		raw := strings.TrimSpace(numeric[0])
 		v, err := strconv.ParseInt(raw, 10, 0)
 		if err != nil {
 			return errorc.With(
@@ -53,10 +57,12 @@ func getStrMinRule() (Rule, error) {
 		if v < 1 { // noop as requested
 			return nil
 		}
-		if int(v) > len(s) { // length too small
+		actual := length(s)
+		if int(v) > actual { // length too small
 			return errorc.With(
 				modelerrors.ErrRuleConstraintViolated,
 				errorc.String(modelerrors.ErrorFieldRuleName, "min"),
+				errorc.String(modelerrors.ErrorFieldRuleValue, strconv.Itoa(actual)),
 				errorc.String(modelerrors.ErrorFieldRuleParamName, "length"),
 				errorc.String(modelerrors.ErrorFieldRuleParamValue, raw),
 			)
@@ -317,27 +323,113 @@ func ensureBuiltIns() {
 
 		// string rules
 		strMin, _ := getStrMinRule()
+		strMax, _ := getStrMaxRule()
+		strLen, _ := getStrLenRule()
+		strBetween, _ := getStrBetweenRule()
+		strGt, _ := getStrGtRule()
+		strGte, _ := getStrGteRule()
+		strLt, _ := getStrLtRule()
+		strLte, _ := getStrLteRule()
+		strEq, _ := getStrEqRule()
+		strNe, _ := getStrNeRule()
 		strEmail, _ := getStrEmailRule()
 		strOneof, _ := getStrOneofRule()
-		builtinStringRules = []Rule{strMin, strEmail, strOneof}
+		strURL, _ := getStrURLRule()
+		strURI, _ := getStrURIRule()
+		strUUID, _ := getStrUUIDRule()
+		strUUID3, _ := getStrUUID3Rule()
+		strUUID4, _ := getStrUUID4Rule()
+		strUUID5, _ := getStrUUID5Rule()
+		strIPv4, _ := getStrIPv4Rule()
+		strIPv6, _ := getStrIPv6Rule()
+		strCIDR, _ := getStrCIDRRule()
+		strHostnameRFC1123, _ := getStrHostnameRFC1123Rule()
+		strBase64, _ := getStrBase64Rule()
+		strBase64URL, _ := getStrBase64URLRule()
+		strJSON, _ := getStrJSONRule()
+		strAlpha, _ := getStrAlphaRule()
+		strAlphanum, _ := getStrAlphanumRule()
+		strNumeric, _ := getStrNumericRule()
+		strASCII, _ := getStrASCIIRule()
+		strPrintASCII, _ := getStrPrintASCIIRule()
+		strContains, _ := getStrContainsRule()
+		strStartswith, _ := getStrStartswithRule()
+		strEndswith, _ := getStrEndswithRule()
+		strRegex, _ := getStrRegexRule()
+		builtinStringRules = []Rule{
+			strMin, strMax, strLen, strBetween, strGt, strGte, strLt, strLte, strEq, strNe,
+			strEmail, strOneof,
+			strURL, strURI, strUUID, strUUID3, strUUID4, strUUID5,
+			strIPv4, strIPv6, strCIDR, strHostnameRFC1123,
+			strBase64, strBase64URL, strJSON,
+			strAlpha, strAlphanum, strNumeric, strASCII, strPrintASCII,
+			strContains, strStartswith, strEndswith, strRegex,
+		}
 
 		// int rules
 		positiveInt, _ := getIntPositiveRule()
 		nonzeroInt, _ := getIntNonzeroRule()
 		oneofInt, _ := getIntOneofRule()
-		builtinIntRules = []Rule{positiveInt, nonzeroInt, oneofInt}
+		maxInt, _ := getIntMaxRule()
+		betweenInt, _ := getIntBetweenRule()
+		gtInt, _ := getIntGtRule()
+		gteInt, _ := getIntGteRule()
+		ltInt, _ := getIntLtRule()
+		lteInt, _ := getIntLteRule()
+		eqInt, _ := getIntEqRule()
+		neInt, _ := getIntNeRule()
+		builtinIntRules = []Rule{
+			positiveInt, nonzeroInt, oneofInt,
+			maxInt, betweenInt, gtInt, gteInt, ltInt, lteInt, eqInt, neInt,
+		}
 
 		// int64 rules
 		positiveInt64, _ := getInt64PositiveRule()
 		nonzeroInt64, _ := getInt64NonzeroRule()
 		oneofInt64, _ := getInt64OneofRule()
-		builtinInt64Rules = []Rule{positiveInt64, nonzeroInt64, oneofInt64}
+		maxInt64, _ := getInt64MaxRule()
+		betweenInt64, _ := getInt64BetweenRule()
+		gtInt64, _ := getInt64GtRule()
+		gteInt64, _ := getInt64GteRule()
+		ltInt64, _ := getInt64LtRule()
+		lteInt64, _ := getInt64LteRule()
+		eqInt64, _ := getInt64EqRule()
+		neInt64, _ := getInt64NeRule()
+		builtinInt64Rules = []Rule{
+			positiveInt64, nonzeroInt64, oneofInt64,
+			maxInt64, betweenInt64, gtInt64, gteInt64, ltInt64, lteInt64, eqInt64, neInt64,
+		}
 
 		// float64 rules
 		positiveFloat64, _ := getFloat64PositiveRule()
 		nonzeroFloat64, _ := getFloat64NonzeroRule()
 		oneofFloat64, _ := getFloat64OneofRule()
-		builtinFloat64Rules = []Rule{positiveFloat64, nonzeroFloat64, oneofFloat64}
+		maxFloat64, _ := getFloat64MaxRule()
+		betweenFloat64, _ := getFloat64BetweenRule()
+		gtFloat64, _ := getFloat64GtRule()
+		gteFloat64, _ := getFloat64GteRule()
+		ltFloat64, _ := getFloat64LtRule()
+		lteFloat64, _ := getFloat64LteRule()
+		eqFloat64, _ := getFloat64EqRule()
+		neFloat64, _ := getFloat64NeRule()
+		builtinFloat64Rules = []Rule{
+			positiveFloat64, nonzeroFloat64, oneofFloat64,
+			maxFloat64, betweenFloat64, gtFloat64, gteFloat64, ltFloat64, lteFloat64, eqFloat64, neFloat64,
+		}
+
+		// time.Time rules
+		minTime, _ := getTimeMinRule()
+		maxTime, _ := getTimeMaxRule()
+		betweenTime, _ := getTimeBetweenRule()
+		gtTime, _ := getTimeGtRule()
+		gteTime, _ := getTimeGteRule()
+		ltTime, _ := getTimeLtRule()
+		lteTime, _ := getTimeLteRule()
+		eqTime, _ := getTimeEqRule()
+		neTime, _ := getTimeNeRule()
+		builtinTimeRules = []Rule{
+			minTime, maxTime, betweenTime, gtTime, gteTime, ltTime, lteTime, eqTime, neTime,
+		}
 
 		// fill map
 		register := func(rs []Rule) {
@@ -349,6 +441,7 @@ func ensureBuiltIns() {
 		register(builtinIntRules)
 		register(builtinInt64Rules)
 		register(builtinFloat64Rules)
+		register(builtinTimeRules)
 	})
 }
 
@@ -0,0 +1,92 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// checkTag is the struct tag Check reads field rule names from. It matches
+// the "validate" tag used throughout the module (see schema.go and the root
+// package's validateStruct) so a single struct definition works against both
+// generations of the validation engine.
+const checkTag = "validate"
+
+// Check statically validates that every checkTag-tagged field of struct type
+// t resolves to a registered rule overload, without needing an instance of t.
+// Each field's declared reflect.Type stands in for a real value, so Get's
+// exact-over-assignable resolution runs exactly as it would during a real
+// Validate call. Check recurses into every struct-typed field, embedded or
+// not, and into the element type of pointers, slices, and arrays, and accumulates every failure
+// (ErrRuleNotFound, ErrRuleOverloadNotFound, ErrAmbiguousRule) via
+// errors.Join instead of stopping at the first one, so
+// reg.Check(reflect.TypeOf(MyModel{})) reports the full picture in one call.
+func (r *rulesRegistry) Check(t reflect.Type) error {
+	return r.check(t, t.Name(), make(map[reflect.Type]bool))
+}
+
+func (r *rulesRegistry) check(t reflect.Type, path string, seen map[reflect.Type]bool) error {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return nil
+	}
+	seen[t] = true
+
+	var errs []error
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldPath := path + "." + field.Name
+
+		if tag := field.Tag.Get(checkTag); tag != "" && tag != "-" {
+			for _, name := range checkTagRuleNames(tag) {
+				if _, err := r.Get(name, reflect.Zero(field.Type)); err != nil {
+					errs = append(errs, fmt.Errorf("field %s: rule %q: %w", fieldPath, name, err))
+				}
+			}
+		}
+
+		if err := r.check(field.Type, fieldPath, seen); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkTagRuleNames splits a checkTag value into its rule names, discarding
+// params (e.g. "min(3),nonempty" -> ["min", "nonempty"]), since Check only
+// needs to resolve each rule's overload, not evaluate it.
+func checkTagRuleNames(tag string) []string {
+	var names []string
+	depth := 0
+	start := 0
+	for i, c := range tag {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				names = append(names, ruleNameOnly(tag[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	names = append(names, ruleNameOnly(tag[start:]))
+	return names
+}
+
+// ruleNameOnly strips any "(params)" suffix and surrounding whitespace from a
+// single rule token.
+func ruleNameOnly(tok string) string {
+	tok = strings.TrimSpace(tok)
+	if idx := strings.IndexByte(tok, '('); idx != -1 {
+		tok = tok[:idx]
+	}
+	return strings.TrimSpace(tok)
+}
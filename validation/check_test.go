@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+)
+
+func TestRulesRegistry_Check_OK(t *testing.T) {
+	t.Parallel()
+
+	type Address struct {
+		Zip string `validate:"email"`
+	}
+	type User struct {
+		Name    string `validate:"email"`
+		Address Address
+	}
+
+	reg := NewRulesRegistry()
+	if err := reg.Check(reflect.TypeOf(User{})); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+}
+
+func TestRulesRegistry_Check_UnknownRule(t *testing.T) {
+	t.Parallel()
+
+	type User struct {
+		Name string `validate:"mystery"`
+	}
+
+	reg := NewRulesRegistry()
+	err := reg.Check(reflect.TypeOf(User{}))
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleNotFound) {
+		t.Fatalf("Check() error = %v, want ErrRuleNotFound", err)
+	}
+	if !strings.Contains(err.Error(), "field User.Name") {
+		t.Fatalf("Check() error = %v, want it to name the failing field", err)
+	}
+}
+
+func TestRulesRegistry_Check_NoOverloadForFieldType(t *testing.T) {
+	t.Parallel()
+
+	positive, err := NewRule[int]("positive", func(v int, _ ...string) error {
+		if v <= 0 {
+			return errors.New("must be positive")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	reg := NewRulesRegistry()
+	if err := reg.Add(positive); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	type User struct {
+		Age int8 `validate:"positive"`
+	}
+
+	err = reg.Check(reflect.TypeOf(User{}))
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleOverloadNotFound) {
+		t.Fatalf("Check() error = %v, want ErrRuleOverloadNotFound", err)
+	}
+	if !strings.Contains(err.Error(), `field User.Age: rule "positive"`) {
+		t.Fatalf("Check() error = %v, want it to name the field and rule", err)
+	}
+}
+
+func TestRulesRegistry_Check_RecursesIntoNestedStructAndAccumulates(t *testing.T) {
+	t.Parallel()
+
+	type Address struct {
+		Zip string `validate:"mystery1"`
+	}
+	type User struct {
+		Name    string `validate:"mystery2"`
+		Address Address
+	}
+
+	reg := NewRulesRegistry()
+	err := reg.Check(reflect.TypeOf(User{}))
+	if err == nil {
+		t.Fatalf("Check() should fail")
+	}
+	if !strings.Contains(err.Error(), "User.Name") || !strings.Contains(err.Error(), "User.Address.Zip") {
+		t.Fatalf("Check() error = %v, want both the top-level and nested field reported", err)
+	}
+}
+
+func TestRulesRegistry_Check_IgnoresCyclicEmbedding(t *testing.T) {
+	t.Parallel()
+
+	type Node struct {
+		Name     string `validate:"email"`
+		Children []*Node
+	}
+
+	reg := NewRulesRegistry()
+	if err := reg.Check(reflect.TypeOf(Node{})); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+}
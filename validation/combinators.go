@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// commonFieldType derives the field type a composite rule should register
+// under from its children's field types. interface{} (any) is the identity
+// element: children typed any are ignored when narrowing. If the remaining
+// children agree on a single concrete type, that type is returned; if they
+// disagree, or none remain, any is returned, since Go has no type that can
+// represent a true intersection or union beyond the empty interface.
+func commonFieldType(rules []Rule) reflect.Type {
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+
+	var common reflect.Type
+	for _, r := range rules {
+		t := r.getFieldType()
+		if t == nil || t == anyType {
+			continue
+		}
+		if common == nil {
+			common = t
+			continue
+		}
+		if common != t {
+			return anyType
+		}
+	}
+	if common == nil {
+		return anyType
+	}
+	return common
+}
+
+// combinatorName joins the names of rules into a single deterministic name
+// for the rule built by the named combinator, e.g. "allOf(nonempty,length)".
+func combinatorName(combinator string, rules ...Rule) string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.GetName()
+	}
+	return combinator + "(" + strings.Join(names, ",") + ")"
+}
+
+// subRuleError wraps a single child rule's failure so callers can see which
+// branch of a combinator failed.
+func subRuleError(sub Rule, cause error) error {
+	return errorc.With(
+		modelerrors.ErrRuleConstraintViolated,
+		errorc.String(modelerrors.ErrorFieldSubRuleName, sub.GetName()),
+		errorc.Error(modelerrors.ErrorFieldCause, cause),
+	)
+}
+
+// AllOf returns a Rule that passes only if every one of rules passes. It
+// runs its children in order and short-circuits on the first failure,
+// wrapping the failing child's error via subRuleError. Its field type is
+// commonFieldType(rules), the intersection of its children's field types.
+func AllOf(rules ...Rule) Rule {
+	fieldType := commonFieldType(rules)
+	return &rule{
+		name:      combinatorName("allOf", rules...),
+		fieldType: fieldType,
+		fn: func(v reflect.Value, params ...string) error {
+			for _, r := range rules {
+				if err := r.GetValidationFn()(v, params...); err != nil {
+					return subRuleError(r, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// AnyOf returns a Rule that passes as soon as one of rules passes. It runs
+// its children in order and short-circuits on the first success; if none
+// succeed, it returns an aggregate of every child's error via subRuleError.
+// Its field type is commonFieldType(rules), the union of its children's
+// field types.
+func AnyOf(rules ...Rule) Rule {
+	fieldType := commonFieldType(rules)
+	return &rule{
+		name:      combinatorName("anyOf", rules...),
+		fieldType: fieldType,
+		fn: func(v reflect.Value, params ...string) error {
+			var errs []error
+			for _, r := range rules {
+				if err := r.GetValidationFn()(v, params...); err == nil {
+					return nil
+				} else {
+					errs = append(errs, subRuleError(r, err))
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+}
+
+// Not returns a Rule that passes if r fails and fails if r passes. It
+// inherits r's field type.
+func Not(r Rule) Rule {
+	return &rule{
+		name:      combinatorName("not", r),
+		fieldType: r.getFieldType(),
+		fn: func(v reflect.Value, params ...string) error {
+			if err := r.GetValidationFn()(v, params...); err == nil {
+				return errorc.With(
+					modelerrors.ErrRuleConstraintViolated,
+					errorc.String(modelerrors.ErrorFieldSubRuleName, r.GetName()),
+					errorc.Error(modelerrors.ErrorFieldCause, fmt.Errorf("%s passed, want failure", r.GetName())),
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// When returns a Rule that only runs r when pred(v) is true; it passes
+// unconditionally otherwise. It inherits r's field type.
+func When(pred func(v reflect.Value) bool, r Rule) Rule {
+	return &rule{
+		name:      combinatorName("when", r),
+		fieldType: r.getFieldType(),
+		fn: func(v reflect.Value, params ...string) error {
+			if !pred(v) {
+				return nil
+			}
+			if err := r.GetValidationFn()(v, params...); err != nil {
+				return subRuleError(r, err)
+			}
+			return nil
+		},
+	}
+}
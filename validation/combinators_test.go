@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+)
+
+func TestCombinators_Registry_AllOf(t *testing.T) {
+	t.Parallel()
+
+	stringRule, err := NewRule[string]("minLen3", func(v string, _ ...string) error {
+		if len(v) < 3 {
+			return errors.New("too short")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	nonempty, err := NewRule[string]("nonempty", func(v string, _ ...string) error {
+		if v == "" {
+			return errors.New("empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	combined := AllOf(stringRule, nonempty)
+
+	reg := NewRulesRegistry()
+	if err := reg.Add(combined); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	got, err := reg.Get(combined.GetName(), reflect.ValueOf("ok"))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != combined {
+		t.Fatalf("Get() returned a different rule than was registered")
+	}
+	if got.getFieldType() != reflect.TypeOf("") {
+		t.Fatalf("getFieldType() = %v, want string", got.getFieldType())
+	}
+}
+
+func TestAllOf_ShortCircuitsOnFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	fails, _ := NewRule[string]("fails", func(string, ...string) error { return errors.New("boom") })
+	tracks, _ := NewRule[string]("tracks", func(string, ...string) error { called = true; return nil })
+
+	err := AllOf(fails, tracks).GetValidationFn()(reflect.ValueOf("x"))
+	if err == nil {
+		t.Fatalf("AllOf should fail when a child fails")
+	}
+	if called {
+		t.Fatalf("AllOf should short-circuit and not run rules after the first failure")
+	}
+}
+
+func TestAnyOf_PassesOnFirstSuccess(t *testing.T) {
+	t.Parallel()
+
+	fails, _ := NewRule[string]("fails", func(string, ...string) error { return errors.New("boom") })
+	passes, _ := NewRule[string]("passes", func(string, ...string) error { return nil })
+
+	if err := AnyOf(fails, passes).GetValidationFn()(reflect.ValueOf("x")); err != nil {
+		t.Fatalf("AnyOf should pass when one child passes, got %v", err)
+	}
+}
+
+func TestAnyOf_AggregatesErrorsWhenAllFail(t *testing.T) {
+	t.Parallel()
+
+	failsA, _ := NewRule[string]("failsA", func(string, ...string) error { return errors.New("a") })
+	failsB, _ := NewRule[string]("failsB", func(string, ...string) error { return errors.New("b") })
+
+	err := AnyOf(failsA, failsB).GetValidationFn()(reflect.ValueOf("x"))
+	if err == nil {
+		t.Fatalf("AnyOf should fail when every child fails")
+	}
+	if !errors.Is(err, errorsPkg.ErrRuleConstraintViolated) {
+		t.Fatalf("AnyOf error = %v, want it to wrap ErrRuleConstraintViolated", err)
+	}
+}
+
+func TestNot_InvertsResult(t *testing.T) {
+	t.Parallel()
+
+	fails, _ := NewRule[string]("fails", func(string, ...string) error { return errors.New("boom") })
+	passes, _ := NewRule[string]("passes", func(string, ...string) error { return nil })
+
+	if err := Not(fails).GetValidationFn()(reflect.ValueOf("x")); err != nil {
+		t.Fatalf("Not(fails) should pass, got %v", err)
+	}
+	if err := Not(passes).GetValidationFn()(reflect.ValueOf("x")); err == nil {
+		t.Fatalf("Not(passes) should fail")
+	}
+}
+
+func TestWhen_OnlyRunsRuleWhenPredicateTrue(t *testing.T) {
+	t.Parallel()
+
+	fails, _ := NewRule[string]("fails", func(string, ...string) error { return errors.New("boom") })
+
+	always := func(reflect.Value) bool { return true }
+	never := func(reflect.Value) bool { return false }
+
+	if err := When(never, fails).GetValidationFn()(reflect.ValueOf("x")); err != nil {
+		t.Fatalf("When(never, fails) should pass unconditionally, got %v", err)
+	}
+	if err := When(always, fails).GetValidationFn()(reflect.ValueOf("x")); err == nil {
+		t.Fatalf("When(always, fails) should run the rule and fail")
+	}
+}
+
+func TestCommonFieldType(t *testing.T) {
+	t.Parallel()
+
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+	stringType := reflect.TypeOf("")
+
+	stringRule, _ := NewRule[string]("s", func(string, ...string) error { return nil })
+	anyRule, _ := NewRule[any]("a", func(any, ...string) error { return nil })
+	intRule, _ := NewRule[int]("i", func(int, ...string) error { return nil })
+
+	if got := commonFieldType([]Rule{stringRule, anyRule}); got != stringType {
+		t.Fatalf("commonFieldType(string, any) = %v, want string (any is the identity element)", got)
+	}
+	if got := commonFieldType([]Rule{stringRule, intRule}); got != anyType {
+		t.Fatalf("commonFieldType(string, int) = %v, want any (disagreeing types fall back to any)", got)
+	}
+	if got := commonFieldType([]Rule{anyRule}); got != anyType {
+		t.Fatalf("commonFieldType(any) = %v, want any", got)
+	}
+}
@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// RegisterConditionalRules registers the built-in conditional-requiredness
+// rules into reg: required_if, required_unless, required_with,
+// required_with_all, required_with_any, required_without,
+// required_without_all, and required_without_any. Like
+// RegisterCrossFieldRules, they are opt-in rather than always-on, since
+// their "any field type" nature would otherwise make them the default
+// resolution for every rule name Get fails to match in the registry.
+//
+// required_with and required_with_any trigger when at least one of the
+// named fields is present (non-zero); required_with_all triggers only when
+// every one is. required_without and required_without_any trigger when at
+// least one of the named fields is absent; required_without_all triggers
+// only when every one is absent.
+func RegisterConditionalRules(reg RulesRegistry) error {
+	rules := []Rule{
+		newFieldLevelRule("required_if", requiredIfCheck(true)),
+		newFieldLevelRule("required_unless", requiredIfCheck(false)),
+		newFieldLevelRule("required_with", requiredPresenceCheck(presenceAny)),
+		newFieldLevelRule("required_with_all", requiredPresenceCheck(presenceAll)),
+		newFieldLevelRule("required_with_any", requiredPresenceCheck(presenceAny)),
+		newFieldLevelRule("required_without", requiredPresenceCheck(absenceAny)),
+		newFieldLevelRule("required_without_all", requiredPresenceCheck(absenceAll)),
+		newFieldLevelRule("required_without_any", requiredPresenceCheck(absenceAny)),
+	}
+	for _, r := range rules {
+		if err := reg.Add(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requiredIfCheck builds the check for required_if (want=true) and
+// required_unless (want=false): field is required once the named sibling's
+// formatted value equality to the given literal matches want.
+func requiredIfCheck(want bool) func(FieldLevel, ...string) error {
+	return func(fl FieldLevel, params ...string) error {
+		other, value, err := requireFieldValuePair(fl, "required_if", params)
+		if err != nil {
+			return err
+		}
+		if (formatFieldValue(other) == value) == want && isZeroValue(fl.Field()) {
+			return errorc.With(
+				modelerrors.ErrRuleConditionallyRequired,
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, params[0]),
+				errorc.String(modelerrors.ErrorFieldOtherFieldValue, value),
+			)
+		}
+		return nil
+	}
+}
+
+// presenceMode names which combination of named sibling fields being
+// present/absent triggers a required_with(_all/_any)/required_without(_all/_any) check.
+type presenceMode int
+
+const (
+	presenceAny presenceMode = iota // required_with, required_with_any: at least one present
+	presenceAll                     // required_with_all: every one present
+	absenceAny                      // required_without, required_without_any: at least one absent
+	absenceAll                      // required_without_all: every one absent
+)
+
+// requiredPresenceCheck builds the check for the required_with(_all/_any)
+// and required_without(_all/_any) families, counting how many of the named
+// sibling fields hold a non-zero value and comparing that count against mode.
+func requiredPresenceCheck(mode presenceMode) func(FieldLevel, ...string) error {
+	return func(fl FieldLevel, params ...string) error {
+		if len(params) == 0 {
+			return errorc.With(
+				modelerrors.ErrRuleMissingParameter,
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, ""),
+			)
+		}
+		present := 0
+		for _, name := range params {
+			other, err := resolveFieldRef(fl, name)
+			if err != nil {
+				return err
+			}
+			if !isZeroValue(other) {
+				present++
+			}
+		}
+		var triggered bool
+		switch mode {
+		case presenceAny:
+			triggered = present > 0
+		case presenceAll:
+			triggered = present == len(params)
+		case absenceAny:
+			triggered = present < len(params)
+		case absenceAll:
+			triggered = present == 0
+		}
+		if triggered && isZeroValue(fl.Field()) {
+			return errorc.With(
+				modelerrors.ErrRuleConditionallyRequired,
+				errorc.String(modelerrors.ErrorFieldOtherFieldPath, strings.Join(params, ", ")),
+			)
+		}
+		return nil
+	}
+}
+
+// requireFieldValuePair resolves the (field, value) parameter pair shared by
+// required_if/required_unless, returning the sibling's current value.
+func requireFieldValuePair(fl FieldLevel, rule string, params []string) (other reflect.Value, value string, err error) {
+	if len(params) < 2 {
+		return reflect.Value{}, "", errorc.With(
+			modelerrors.ErrRuleMissingParameter,
+			errorc.String(modelerrors.ErrorFieldRuleName, rule),
+		)
+	}
+	other, err = resolveFieldRef(fl, params[0])
+	return other, params[1], err
+}
+
+// resolveFieldRef resolves a dotted field path against fl.Parent(), falling
+// back to fl.Top() when not found there; a path prefixed with "." resolves
+// against fl.Top() explicitly, disambiguating the rare case where parent and
+// top both have a field with the same name.
+func resolveFieldRef(fl FieldLevel, path string) (reflect.Value, error) {
+	if strings.HasPrefix(path, ".") {
+		return fieldByPath(fl.Top(), strings.TrimPrefix(path, "."))
+	}
+	if v, err := fieldByPath(fl.Parent(), path); err == nil {
+		return v, nil
+	}
+	return fieldByPath(fl.Top(), path)
+}
+
+// formatFieldValue renders v, dereferencing pointers first, for comparison
+// against a validate tag literal.
+func formatFieldValue(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+// isZeroValue reports whether v holds its type's zero value, treating an
+// invalid (unresolved) Value as zero.
+func isZeroValue(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	return v.IsZero()
+}
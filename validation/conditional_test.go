@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+)
+
+type conditionalAccount struct {
+	Type     string
+	Password string
+	Email    string
+	Phone    string
+	Contact  string
+}
+
+func TestRegisterConditionalRules_RequiredIf(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterConditionalRules(reg); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	v := NewValidator[conditionalAccount](reg).
+		For("Password").Rules("required_if").WithParams("Type", "admin")
+
+	if err := v.Validate(context.Background(), conditionalAccount{Type: "guest"}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	err := v.Validate(context.Background(), conditionalAccount{Type: "admin"})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("Validate() error = %v, want ErrRuleConditionallyRequired", err)
+	}
+
+	if err := v.Validate(context.Background(), conditionalAccount{Type: "admin", Password: "secret"}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+func TestRegisterConditionalRules_RequiredUnless(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterConditionalRules(reg); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	v := NewValidator[conditionalAccount](reg).
+		For("Password").Rules("required_unless").WithParams("Type", "guest")
+
+	if err := v.Validate(context.Background(), conditionalAccount{Type: "guest"}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	err := v.Validate(context.Background(), conditionalAccount{Type: "admin"})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("Validate() error = %v, want ErrRuleConditionallyRequired", err)
+	}
+}
+
+func TestRegisterConditionalRules_RequiredWithAndAll(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterConditionalRules(reg); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	withAny := NewValidator[conditionalAccount](reg).
+		For("Contact").Rules("required_with").WithParams("Email", "Phone")
+
+	if err := withAny.Validate(context.Background(), conditionalAccount{}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	err := withAny.Validate(context.Background(), conditionalAccount{Email: "a@b.com"})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("Validate() error = %v, want ErrRuleConditionallyRequired", err)
+	}
+
+	withAll := NewValidator[conditionalAccount](reg).
+		For("Contact").Rules("required_with_all").WithParams("Email", "Phone")
+
+	if err := withAll.Validate(context.Background(), conditionalAccount{Email: "a@b.com"}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	err = withAll.Validate(context.Background(), conditionalAccount{Email: "a@b.com", Phone: "555"})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("Validate() error = %v, want ErrRuleConditionallyRequired", err)
+	}
+}
+
+func TestRegisterConditionalRules_RequiredWithoutAny(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterConditionalRules(reg); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	// required_without and required_without_any both trigger once at least
+	// one of the named fields is missing.
+	for _, rule := range []string{"required_without", "required_without_any"} {
+		v := NewValidator[conditionalAccount](reg).
+			For("Contact").Rules(rule).WithParams("Email", "Phone")
+
+		if err := v.Validate(context.Background(), conditionalAccount{Email: "a@b.com", Phone: "555"}); err != nil {
+			t.Fatalf("%s: Validate: %v", rule, err)
+		}
+		err := v.Validate(context.Background(), conditionalAccount{Email: "a@b.com"})
+		if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+			t.Fatalf("%s: Validate() error = %v, want ErrRuleConditionallyRequired", rule, err)
+		}
+	}
+}
+
+func TestRegisterConditionalRules_RequiredWithoutAll(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterConditionalRules(reg); err != nil {
+		t.Fatalf("RegisterConditionalRules: %v", err)
+	}
+
+	v := NewValidator[conditionalAccount](reg).
+		For("Contact").Rules("required_without_all").WithParams("Email", "Phone")
+
+	// Only one of the two missing: required_without_all does not trigger.
+	if err := v.Validate(context.Background(), conditionalAccount{Email: "a@b.com"}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	// Both missing: triggers.
+	err := v.Validate(context.Background(), conditionalAccount{})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleConditionallyRequired) {
+		t.Fatalf("Validate() error = %v, want ErrRuleConditionallyRequired", err)
+	}
+}
@@ -0,0 +1,208 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// CrossFieldRule is implemented by a Rule that compares a field against a
+// sibling field instead of checking its own value in isolation (eqfield,
+// gtfield, ...). It is probed via type assertion from Validator.Validate
+// rather than folded into the base Rule interface, so existing Rule
+// implementations are unaffected. CrossStruct reports which struct the
+// sibling path resolves against: false for the immediate parent of the field
+// being checked (eqfield-style — "ConfirmPassword" resolves as a sibling of
+// "Password"), true for the top-level struct passed to Validate
+// (eqcsfield-style — "Address.Zip" resolves from the root).
+type CrossFieldRule interface {
+	Rule
+	CrossStruct() bool
+	ValidateCrossField(field, other reflect.Value, otherPath string) error
+}
+
+// crossFieldRule implements CrossFieldRule by embedding rule for the Rule
+// methods it shares with every other rule (name, messages, doc, param
+// schema), and supplying its own comparison via check. Its GetValidationFn
+// is a stub: a CrossFieldRule is never meant to be invoked through it
+// directly, only through ValidateCrossField once Validator.Validate has
+// resolved the sibling field.
+type crossFieldRule struct {
+	rule
+	crossStruct bool
+	check       func(field, other reflect.Value) error
+}
+
+func (r *crossFieldRule) CrossStruct() bool { return r.crossStruct }
+
+func (r *crossFieldRule) ValidateCrossField(field, other reflect.Value, otherPath string) error {
+	if err := r.check(field, other); err != nil {
+		return errorc.With(
+			modelerrors.ErrRuleCrossFieldMismatch,
+			errorc.String(modelerrors.ErrorFieldRuleName, r.name),
+			errorc.String(modelerrors.ErrorFieldOtherFieldPath, otherPath),
+			errorc.String(modelerrors.ErrorFieldOtherFieldValue, fmt.Sprintf("%v", indirect(other).Interface())),
+			errorc.Error(modelerrors.ErrorFieldCause, err),
+		)
+	}
+	return nil
+}
+
+func newCrossFieldRule(name string, crossStruct bool, check func(field, other reflect.Value) error) Rule {
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+	return &crossFieldRule{
+		rule: rule{
+			name:      name,
+			fieldType: anyType,
+			fn: func(reflect.Value, ...string) error {
+				return fmt.Errorf("validation: %s: cross-field rule, must be invoked through Validator", name)
+			},
+		},
+		crossStruct: crossStruct,
+		check:       check,
+	}
+}
+
+// RegisterCrossFieldRules registers the built-in cross-field and
+// cross-struct comparison rules (eqfield, nefield, gtfield, gtefield,
+// ltfield, ltefield, eqcsfield, nefcsfield) into reg. They are opt-in,
+// analogous to RegisterBuiltinConstructors, rather than always-on like the
+// scalar built-ins in builtin_rules.go, since their "any field type" nature
+// would otherwise make them the default resolution for every rule name
+// Get fails to match in the registry.
+func RegisterCrossFieldRules(reg RulesRegistry) error {
+	rules := []Rule{
+		newCrossFieldRule("eqfield", false, func(field, other reflect.Value) error { return requireEqual(field, other) }),
+		newCrossFieldRule("nefield", false, func(field, other reflect.Value) error { return requireNotEqual(field, other) }),
+		newCrossFieldRule("gtfield", false, requireGreaterThan),
+		newCrossFieldRule("gtefield", false, requireGreaterOrEqual),
+		newCrossFieldRule("ltfield", false, requireLessThan),
+		newCrossFieldRule("ltefield", false, requireLessOrEqual),
+		newCrossFieldRule("eqcsfield", true, func(field, other reflect.Value) error { return requireEqual(field, other) }),
+		newCrossFieldRule("nefcsfield", true, func(field, other reflect.Value) error { return requireNotEqual(field, other) }),
+	}
+	for _, r := range rules {
+		if err := reg.Add(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func requireEqual(field, other reflect.Value) error {
+	if !valuesEqual(field, other) {
+		return fmt.Errorf("must equal the other field")
+	}
+	return nil
+}
+
+func requireNotEqual(field, other reflect.Value) error {
+	if valuesEqual(field, other) {
+		return fmt.Errorf("must not equal the other field")
+	}
+	return nil
+}
+
+func requireGreaterThan(field, other reflect.Value) error {
+	cmp, err := compareOrdered(field, other)
+	if err != nil {
+		return err
+	}
+	if cmp <= 0 {
+		return fmt.Errorf("must be greater than the other field")
+	}
+	return nil
+}
+
+func requireGreaterOrEqual(field, other reflect.Value) error {
+	cmp, err := compareOrdered(field, other)
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		return fmt.Errorf("must be greater than or equal to the other field")
+	}
+	return nil
+}
+
+func requireLessThan(field, other reflect.Value) error {
+	cmp, err := compareOrdered(field, other)
+	if err != nil {
+		return err
+	}
+	if cmp >= 0 {
+		return fmt.Errorf("must be less than the other field")
+	}
+	return nil
+}
+
+func requireLessOrEqual(field, other reflect.Value) error {
+	cmp, err := compareOrdered(field, other)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return fmt.Errorf("must be less than or equal to the other field")
+	}
+	return nil
+}
+
+// indirect dereferences a chain of non-nil pointers.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// valuesEqual reports whether a and b, after dereferencing pointers, hold
+// equal values.
+func valuesEqual(a, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// asNumeric reports v's value as a float64 for ordering, if v's kind is an
+// int, uint, or float kind.
+func asNumeric(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// compareOrdered orders a against b, after dereferencing pointers: -1 if
+// a < b, 0 if equal, 1 if a > b. It supports numeric kinds (compared by
+// value) and strings (compared lexically); any other pairing is an error,
+// since gtfield/ltfield and their variants have no other defined ordering.
+func compareOrdered(a, b reflect.Value) (int, error) {
+	a, b = indirect(a), indirect(b)
+	if a.Kind() == reflect.String && b.Kind() == reflect.String {
+		return strings.Compare(a.String(), b.String()), nil
+	}
+	if af, ok := asNumeric(a); ok {
+		if bf, ok := asNumeric(b); ok {
+			switch {
+			case af < bf:
+				return -1, nil
+			case af > bf:
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("validation: cannot order %s against %s", a.Kind(), b.Kind())
+}
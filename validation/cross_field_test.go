@@ -0,0 +1,137 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+)
+
+type crossFieldSignup struct {
+	Password        string
+	ConfirmPassword string
+	MinAge          int
+	Age             int
+	Address         crossFieldAddress
+}
+
+type crossFieldAddress struct {
+	Zip string
+}
+
+func TestRegisterCrossFieldRules_Eqfield_Pass(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	v := NewValidator[crossFieldSignup](reg).
+		For("ConfirmPassword").Rules("eqfield").WithParams("Password")
+
+	obj := crossFieldSignup{Password: "secret", ConfirmPassword: "secret"}
+	if err := v.Validate(context.Background(), obj); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+func TestRegisterCrossFieldRules_Eqfield_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	v := NewValidator[crossFieldSignup](reg).
+		For("ConfirmPassword").Rules("eqfield").WithParams("Password")
+
+	obj := crossFieldSignup{Password: "secret", ConfirmPassword: "other"}
+	err := v.Validate(context.Background(), obj)
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleCrossFieldMismatch) {
+		t.Fatalf("Validate() error = %v, want ErrRuleCrossFieldMismatch", err)
+	}
+}
+
+func TestRegisterCrossFieldRules_Gtfield(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	v := NewValidator[crossFieldSignup](reg).
+		For("Age").Rules("gtfield").WithParams("MinAge")
+
+	if err := v.Validate(context.Background(), crossFieldSignup{MinAge: 18, Age: 21}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	err := v.Validate(context.Background(), crossFieldSignup{MinAge: 18, Age: 10})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleCrossFieldMismatch) {
+		t.Fatalf("Validate() error = %v, want ErrRuleCrossFieldMismatch", err)
+	}
+}
+
+func TestRegisterCrossFieldRules_Eqcsfield_ResolvesFromTop(t *testing.T) {
+	t.Parallel()
+
+	type form struct {
+		BillingZip  string
+		ShippingZip crossFieldAddress
+	}
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	v := NewValidator[form](reg).
+		For("BillingZip").Rules("eqcsfield").WithParams("ShippingZip.Zip")
+
+	if err := v.Validate(context.Background(), form{BillingZip: "10001", ShippingZip: crossFieldAddress{Zip: "10001"}}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	err := v.Validate(context.Background(), form{BillingZip: "10001", ShippingZip: crossFieldAddress{Zip: "99999"}})
+	if err == nil || !errors.Is(err, errorsPkg.ErrRuleCrossFieldMismatch) {
+		t.Fatalf("Validate() error = %v, want ErrRuleCrossFieldMismatch", err)
+	}
+}
+
+func TestRegisterCrossFieldRules_MissingParam(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	v := NewValidator[crossFieldSignup](reg).For("ConfirmPassword").Rules("eqfield")
+
+	if err := v.Validate(context.Background(), crossFieldSignup{}); err == nil {
+		t.Fatalf("Validate() should fail when eqfield is missing its sibling path param")
+	}
+}
+
+func TestCompareOrdered_Unorderable(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterCrossFieldRules(reg); err != nil {
+		t.Fatalf("RegisterCrossFieldRules: %v", err)
+	}
+
+	type pair struct {
+		A crossFieldAddress
+		B crossFieldAddress
+	}
+	v := NewValidator[pair](reg).For("A").Rules("gtfield").WithParams("B")
+
+	if err := v.Validate(context.Background(), pair{}); err == nil {
+		t.Fatalf("Validate() should fail when the fields aren't orderable")
+	}
+}
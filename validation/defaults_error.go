@@ -0,0 +1,182 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// DefaultsError aggregates the FieldError values produced while applying
+// `default` / `defaultElem` tags to a single struct value. It mirrors Error's
+// accumulation semantics — Add/ForField/ByField/Fields all behave the same
+// way — but is a distinct type so a caller using errors.As can tell a batch
+// of misconfigured default tags apart from a validation failure.
+type DefaultsError struct {
+	mu     sync.Mutex
+	issues []FieldError
+}
+
+// Add appends a FieldError. It is a safe no-op on a nil receiver.
+func (de *DefaultsError) Add(fe FieldError) {
+	if de == nil {
+		return
+	}
+	de.mu.Lock()
+	de.issues = append(de.issues, fe)
+	de.mu.Unlock()
+}
+
+// Len returns the number of accumulated issues.
+func (de *DefaultsError) Len() int {
+	if de == nil {
+		return 0
+	}
+	de.mu.Lock()
+	n := len(de.issues)
+	de.mu.Unlock()
+	return n
+}
+
+// Empty reports whether there are no issues.
+func (de *DefaultsError) Empty() bool { return de.Len() == 0 }
+
+// Error returns a human-readable, multi-line description of all issues.
+func (de *DefaultsError) Error() string {
+	if de == nil {
+		return ""
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	switch len(de.issues) {
+	case 0:
+		return ""
+	case 1:
+		return de.issues[0].Error()
+	default:
+		var b strings.Builder
+		b.WriteString("applying defaults failed (\n")
+		for i, fe := range de.issues {
+			b.WriteString("  ")
+			b.WriteString(fe.Error())
+			if i < len(de.issues)-1 {
+				b.WriteString("\n")
+			}
+		}
+		b.WriteString("\n)")
+		return b.String()
+	}
+}
+
+// Unwrap joins underlying causes so errors.Is/As keep working on the combined error.
+func (de *DefaultsError) Unwrap() error {
+	if de == nil {
+		return nil
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	errs := make([]error, 0, len(de.issues))
+	for _, fe := range de.issues {
+		if fe.Err != nil {
+			errs = append(errs, fe.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ForField returns all issues for a given dotted field path, in the order added.
+func (de *DefaultsError) ForField(path string) []FieldError {
+	if de == nil {
+		return nil
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	var out []FieldError
+	for _, fe := range de.issues {
+		if fe.Path == path {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// ForNamePath returns all issues for a given NamePath (see FieldError.NamePath), in the order added.
+func (de *DefaultsError) ForNamePath(namePath string) []FieldError {
+	if de == nil {
+		return nil
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	var out []FieldError
+	for _, fe := range de.issues {
+		if fe.NamePath == namePath {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// ByField groups issues by dotted field path.
+func (de *DefaultsError) ByField() map[string][]FieldError {
+	m := make(map[string][]FieldError)
+	if de == nil {
+		return m
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	for _, fe := range de.issues {
+		m[fe.Path] = append(m[fe.Path], fe)
+	}
+	return m
+}
+
+// ByNamePath groups issues by NamePath.
+func (de *DefaultsError) ByNamePath() map[string][]FieldError {
+	m := make(map[string][]FieldError)
+	if de == nil {
+		return m
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	for _, fe := range de.issues {
+		m[fe.NamePath] = append(m[fe.NamePath], fe)
+	}
+	return m
+}
+
+// Fields returns the list of field paths that have issues, unique and in the
+// order of first occurrence.
+func (de *DefaultsError) Fields() []string {
+	if de == nil {
+		return nil
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	seen := make(map[string]struct{})
+	var out []string
+	for _, fe := range de.issues {
+		if _, ok := seen[fe.Path]; !ok {
+			seen[fe.Path] = struct{}{}
+			out = append(out, fe.Path)
+		}
+	}
+	return out
+}
+
+// MarshalJSON exports DefaultsError as a map of field path -> list of error messages.
+func (de *DefaultsError) MarshalJSON() ([]byte, error) {
+	if de == nil {
+		return []byte("null"), nil
+	}
+	de.mu.Lock()
+	defer de.mu.Unlock()
+	by := make(map[string][]string, len(de.issues))
+	for _, fe := range de.issues {
+		msg := ""
+		if fe.Err != nil {
+			msg = fe.Err.Error()
+		}
+		by[fe.Path] = append(by[fe.Path], msg)
+	}
+	return json.Marshal(by)
+}
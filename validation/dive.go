@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// validateDive recurses into fv's slice, array, or map elements, running
+// rules against each non-container leaf and indexing its FieldError path as
+// "path[i]" (slice/array) or "path[key]" (map). A leaf that is itself a
+// slice, array, or map is dived into again, so a single Dive call on a 2D
+// field (e.g. [][]string) reaches every innermost element. It returns ctx's
+// error if validation is cancelled mid-iteration, and stop=true once a rule
+// has failed under Cascade, so the caller can unwind without checking every
+// remaining element.
+func (v *Validator[T]) validateDive(
+	ctx context.Context, path string, fv reflect.Value, rules []ruleInvocation, ve *Error,
+) (stop bool, err error) {
+	cont := fv
+	for cont.Kind() == reflect.Ptr && !cont.IsNil() {
+		cont = cont.Elem()
+	}
+
+	switch cont.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < cont.Len(); i++ {
+			if ctx != nil {
+				if err := ctx.Err(); err != nil {
+					return false, err
+				}
+			}
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			if stop, err := v.diveElement(ctx, elemPath, cont.Index(i), rules, ve); err != nil || stop {
+				return stop, err
+			}
+		}
+	case reflect.Map:
+		for _, key := range cont.MapKeys() {
+			if ctx != nil {
+				if err := ctx.Err(); err != nil {
+					return false, err
+				}
+			}
+			elemPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+			if stop, err := v.diveElement(ctx, elemPath, cont.MapIndex(key), rules, ve); err != nil || stop {
+				return stop, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// diveElement either recurses via validateDive (elem is itself a nested
+// container, for a 2D dive) or runs rules against elem directly, recording
+// a FieldError at path on each failure.
+func (v *Validator[T]) diveElement(
+	ctx context.Context, path string, elem reflect.Value, rules []ruleInvocation, ve *Error,
+) (stop bool, err error) {
+	d := elem
+	for d.Kind() == reflect.Ptr && !d.IsNil() {
+		d = d.Elem()
+	}
+	switch d.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.validateDive(ctx, path, elem, rules, ve)
+	}
+
+	for _, ri := range rules {
+		resolved, err := v.registry.Get(ri.name, elem)
+		if err != nil {
+			ve.Add(FieldError{Path: path, Rule: ri.name, Params: ri.params, Err: err})
+			if v.failFast {
+				return true, nil
+			}
+			continue
+		}
+		if err := resolved.GetValidationFn()(elem, ri.params...); err != nil {
+			ve.Add(FieldError{Path: path, Rule: ri.name, Params: ri.params, Err: err})
+			if v.failFast {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+var errNonemptySlice = errors.New("slice must not be empty")
+
+type diveTags struct {
+	Names []string
+	Meta  map[string]string
+	Grid  [][]string
+}
+
+func TestValidator_Dive_SliceIndexedPaths(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	v := NewValidator[diveTags](reg).For("Names").Dive("email")
+
+	err := v.Validate(context.Background(), diveTags{Names: []string{"a@example.com", "not-an-email"}})
+	if err == nil {
+		t.Fatalf("Validate() should fail for an invalid element")
+	}
+	fe, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *Error", err)
+	}
+	if fe.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", fe.Len())
+	}
+	if fe.issues[0].Path != "Names[1]" {
+		t.Fatalf("Path = %q, want %q", fe.issues[0].Path, "Names[1]")
+	}
+}
+
+func TestValidator_Dive_MapKeyedPaths(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	v := NewValidator[diveTags](reg).For("Meta").Dive("email")
+
+	err := v.Validate(context.Background(), diveTags{Meta: map[string]string{"owner": "not-an-email"}})
+	if err == nil {
+		t.Fatalf("Validate() should fail for an invalid map value")
+	}
+	fe := err.(*Error)
+	if fe.issues[0].Path != "Meta[owner]" {
+		t.Fatalf("Path = %q, want %q", fe.issues[0].Path, "Meta[owner]")
+	}
+}
+
+func TestValidator_Dive_NestedSlice(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	v := NewValidator[diveTags](reg).For("Grid").Dive("email")
+
+	err := v.Validate(context.Background(), diveTags{Grid: [][]string{{"a@example.com"}, {"bad"}}})
+	if err == nil {
+		t.Fatalf("Validate() should fail for an invalid nested element")
+	}
+	fe := err.(*Error)
+	if fe.issues[0].Path != "Grid[1][0]" {
+		t.Fatalf("Path = %q, want %q", fe.issues[0].Path, "Grid[1][0]")
+	}
+}
+
+func TestValidator_Dive_ContainerRuleRunsAlongsideElementRules(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	nonemptySlice, err := NewRule[[]string]("nonempty", func(s []string, _ ...string) error {
+		if len(s) == 0 {
+			return errNonemptySlice
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	if err := reg.Add(nonemptySlice); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	v := NewValidator[diveTags](reg).
+		For("Names").Rules("nonempty").Dive("email")
+
+	if err := v.Validate(context.Background(), diveTags{Names: nil}); err == nil {
+		t.Fatalf("Validate() should fail when the slice itself is empty")
+	}
+}
+
+func TestValidator_Dive_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	v := NewValidator[diveTags](reg).For("Names").Dive("email")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := v.Validate(ctx, diveTags{Names: []string{"a@example.com", "b@example.com"}})
+	if err != context.Canceled {
+		t.Fatalf("Validate() error = %v, want context.Canceled", err)
+	}
+}
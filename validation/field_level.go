@@ -0,0 +1,33 @@
+package validation
+
+import "reflect"
+
+// FieldLevel gives a rule access to more than just the tagged field's own
+// value: the top-level struct passed to Validate/ValidateStruct, the struct
+// value immediately enclosing the field, and the field's namespaced dotted
+// path (matching FieldError.Path), so it can resolve a sibling field or
+// report against its own position without the caller threading extra
+// arguments through every rule signature. See FieldLevelRule.
+type FieldLevel interface {
+	Top() reflect.Value
+	Parent() reflect.Value
+	Field() reflect.Value
+	Path() string
+}
+
+// fieldLevel is FieldLevel's only implementation.
+type fieldLevel struct {
+	top, parent, field reflect.Value
+	path               string
+}
+
+// NewFieldLevel builds a FieldLevel for field, enclosed by parent, reached
+// while validating top, at the given dotted path.
+func NewFieldLevel(top, parent, field reflect.Value, path string) FieldLevel {
+	return &fieldLevel{top: top, parent: parent, field: field, path: path}
+}
+
+func (f *fieldLevel) Top() reflect.Value    { return f.top }
+func (f *fieldLevel) Parent() reflect.Value { return f.parent }
+func (f *fieldLevel) Field() reflect.Value  { return f.field }
+func (f *fieldLevel) Path() string          { return f.path }
@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldLevelRule is implemented by a Rule whose check needs more context
+// than the tagged field's own value — a sibling field resolved by name
+// (required_if, required_with, ...), or the field's own namespaced path for
+// reporting. It is probed via type assertion from the struct-tag walker,
+// mirroring CrossFieldRule, so existing Rule implementations are unaffected.
+type FieldLevelRule interface {
+	Rule
+	ValidateFieldLevel(fl FieldLevel, params ...string) error
+}
+
+// fieldLevelRule implements FieldLevelRule by embedding rule for the Rule
+// methods it shares with every other rule, and supplying its own check.
+// GetValidationFn is a stub, like crossFieldRule's: a FieldLevelRule is
+// never meant to be invoked through it directly.
+type fieldLevelRule struct {
+	rule
+	check func(fl FieldLevel, params ...string) error
+}
+
+func (r *fieldLevelRule) ValidateFieldLevel(fl FieldLevel, params ...string) error {
+	return r.check(fl, params...)
+}
+
+func newFieldLevelRule(name string, check func(fl FieldLevel, params ...string) error) Rule {
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+	return &fieldLevelRule{
+		rule: rule{
+			name:      name,
+			fieldType: anyType,
+			fn: func(reflect.Value, ...string) error {
+				return fmt.Errorf("validation: %s: field-level rule, must be invoked through a FieldLevel-aware walker", name)
+			},
+		},
+		check: check,
+	}
+}
@@ -0,0 +1,191 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ParamSpec describes one parameter accepted by a rule's validation
+// function, for introspection surfaces such as Describe and generated
+// documentation or IDE autocomplete data.
+type ParamSpec struct {
+	Name     string
+	Type     string
+	Required bool
+}
+
+// RuleOverload describes one type-specific implementation of a named rule.
+type RuleOverload struct {
+	FieldType    string
+	ParamsSchema []ParamSpec
+	Source       string // "builtin" or "custom"
+	Doc          string
+}
+
+// RuleDescription is the introspection snapshot for one rule name, as
+// returned by Describe.
+type RuleDescription struct {
+	Name      string
+	Overloads []RuleOverload
+}
+
+// Describe returns a sorted, serializable snapshot of every rule name known
+// to r — both custom (registered via Add) and built-in — for generating
+// documentation or IDE autocomplete data from a running program.
+func (r *rulesRegistry) Describe() []RuleDescription {
+	byName := make(map[string][]RuleOverload)
+
+	r.mu.RLock()
+	for name, overloads := range r.rules {
+		for _, o := range overloads {
+			byName[name] = append(byName[name], RuleOverload{
+				FieldType:    o.getFieldTypeName(),
+				ParamsSchema: o.ParamSchema(),
+				Source:       "custom",
+				Doc:          o.Doc(),
+			})
+		}
+	}
+	r.mu.RUnlock()
+
+	for _, o := range allBuiltins() {
+		byName[o.GetName()] = append(byName[o.GetName()], RuleOverload{
+			FieldType:    o.getFieldTypeName(),
+			ParamsSchema: o.ParamSchema(),
+			Source:       "builtin",
+			Doc:          o.Doc(),
+		})
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	descriptions := make([]RuleDescription, 0, len(names))
+	for _, name := range names {
+		overloads := byName[name]
+		sort.Slice(overloads, func(i, j int) bool { return overloads[i].FieldType < overloads[j].FieldType })
+		descriptions = append(descriptions, RuleDescription{Name: name, Overloads: overloads})
+	}
+	return descriptions
+}
+
+// MarshalJSON encodes r's introspection snapshot (see Describe) as JSON.
+func (r *rulesRegistry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Describe())
+}
+
+// Suggest proposes rule names close to name (by Levenshtein distance) and
+// their applicable field types, for use in an error message after Get
+// returns ErrRuleNotFound or ErrRuleOverloadNotFound. If v is valid,
+// suggestions are narrowed to overloads applicable to its type when any
+// such overload exists.
+func (r *rulesRegistry) Suggest(name string, v reflect.Value) []string {
+	const maxDistance = 3
+
+	typesByName := make(map[string][]string)
+
+	r.mu.RLock()
+	for ruleName, overloads := range r.rules {
+		for _, o := range overloads {
+			typesByName[ruleName] = append(typesByName[ruleName], o.getFieldTypeName())
+		}
+	}
+	r.mu.RUnlock()
+
+	for _, o := range allBuiltins() {
+		typesByName[o.GetName()] = append(typesByName[o.GetName()], o.getFieldTypeName())
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+		types    []string
+	}
+
+	var candidates []candidate
+	for ruleName, types := range typesByName {
+		if ruleName == name {
+			continue
+		}
+		d := levenshtein(name, ruleName)
+		if d > maxDistance {
+			continue
+		}
+		if v.IsValid() {
+			var applicable []string
+			for _, t := range types {
+				if t == v.Type().String() {
+					applicable = append(applicable, t)
+				}
+			}
+			if len(applicable) > 0 {
+				types = applicable
+			}
+		}
+		sorted := append([]string(nil), types...)
+		sort.Strings(sorted)
+		candidates = append(candidates, candidate{name: ruleName, distance: d, types: sorted})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	suggestions := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		suggestions = append(suggestions, fmt.Sprintf("%s (%s)", c.name, strings.Join(c.types, ", ")))
+	}
+	return suggestions
+}
+
+// allBuiltins returns every built-in rule, across all field types.
+func allBuiltins() []Rule {
+	ensureBuiltIns()
+	rules := make([]Rule, 0, len(builtInMap))
+	for _, r := range builtInMap {
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// levenshtein computes the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		cur := make([]int, len(br)+1)
+		cur[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(cur[j-1]+1, prev[j]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(br)]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRulesRegistry_Describe(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	custom, err := NewRule[string]("slug", func(string, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	custom = custom.WithDoc("must be a URL-safe slug").
+		WithParamSchema(ParamSpec{Name: "maxLen", Type: "int"})
+	if err := reg.Add(custom); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	descs := reg.Describe()
+
+	var slug, email *RuleDescription
+	for i := range descs {
+		switch descs[i].Name {
+		case "slug":
+			slug = &descs[i]
+		case "email":
+			email = &descs[i]
+		}
+	}
+
+	if slug == nil || len(slug.Overloads) != 1 {
+		t.Fatalf("Describe() missing custom rule %q: %+v", "slug", descs)
+	}
+	if slug.Overloads[0].Source != "custom" || slug.Overloads[0].Doc == "" || len(slug.Overloads[0].ParamsSchema) != 1 {
+		t.Fatalf("slug overload = %+v, want custom source, non-empty doc, one param", slug.Overloads[0])
+	}
+
+	if email == nil || len(email.Overloads) == 0 || email.Overloads[0].Source != "builtin" {
+		t.Fatalf("Describe() should include built-in %q as source=builtin: %+v", "email", email)
+	}
+
+	data, err := json.Marshal(reg)
+	if err != nil {
+		t.Fatalf("json.Marshal(reg): %v", err)
+	}
+	var roundTrip []RuleDescription
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("json.Unmarshal: %v, raw: %s", err, data)
+	}
+	if len(roundTrip) != len(descs) {
+		t.Fatalf("round-tripped %d rule names, want %d", len(roundTrip), len(descs))
+	}
+}
+
+func TestRulesRegistry_Suggest(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+
+	suggestions := reg.Suggest("emial", reflect.ValueOf(""))
+	if len(suggestions) == 0 {
+		t.Fatalf("Suggest(emial) = empty, want at least one close builtin name")
+	}
+	found := false
+	for _, s := range suggestions {
+		if s == "email (string)" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Suggest(emial) = %v, want to include %q", suggestions, "email (string)")
+	}
+}
+
+func TestRulesRegistry_Suggest_NoClose(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	suggestions := reg.Suggest("zzzzzzzzzzzzzzzzzzzz", reflect.Value{})
+	if len(suggestions) != 0 {
+		t.Fatalf("Suggest(far away name) = %v, want empty", suggestions)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"email", "emial", 2},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LoadPolicy reads a policy document from src in the given format and
+// registers the resulting rules into the registry directly (via Add),
+// unlike ParsePolicy, which only builds the Rules and leaves registration
+// to the caller. Each rule block's Type is looked up against the
+// constructors registered via AddConstructor, and its Params are decoded
+// the same way ParsePolicy's policyRule does; RuleConstructor already
+// receives a map[string]any, so per-parameter typed extraction (see
+// intParam / floatParam in builtin_constructors.go) stands in for a general
+// mapstructure-style decode, which this module does not depend on.
+//
+// Only "json" is implemented; "yaml"/"yml" and "hcl" report a clear
+// unsupported-format error rather than a half-working conversion, since
+// this module has no YAML/HCL parser dependency to convert through.
+//
+// Duplicate-overload and unknown-rule-type errors are wrapped with the
+// best-effort source line/column of the offending rule block (located by
+// its raw byte span within src), so ops teams editing the document by hand
+// get an actionable location instead of just an index.
+func (r *rulesRegistry) LoadPolicy(src io.Reader, format string) error {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return fmt.Errorf("validation: LoadPolicy: read: %w", err)
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+	case "yaml", "yml", "hcl":
+		return fmt.Errorf("validation: LoadPolicy: format %q is not yet supported, use \"json\"", format)
+	default:
+		return fmt.Errorf("validation: LoadPolicy: unknown format %q", format)
+	}
+
+	var doc struct {
+		Rules []json.RawMessage `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("validation: LoadPolicy: decode: %w", err)
+	}
+
+	for i, rawRule := range doc.Rules {
+		var pr policyRule
+		if err := json.Unmarshal(rawRule, &pr); err != nil {
+			return r.positionedError(data, rawRule, fmt.Errorf("validation: LoadPolicy: rule %d: decode: %w", i, err))
+		}
+
+		ctor, ok := r.Constructor(pr.Type)
+		if !ok {
+			return r.positionedError(data, rawRule, fmt.Errorf(
+				"validation: LoadPolicy: rule %d: unknown rule type %q, registered constructors: %s",
+				i, pr.Type, strings.Join(r.constructorNames(), ", "),
+			))
+		}
+
+		rule, err := ctor(pr.Params)
+		if err != nil {
+			return r.positionedError(data, rawRule, fmt.Errorf("validation: LoadPolicy: rule %d (%s): %w", i, pr.Type, err))
+		}
+
+		if err := r.Add(rule); err != nil {
+			return r.positionedError(data, rawRule, fmt.Errorf("validation: LoadPolicy: rule %d (%s): %w", i, pr.Type, err))
+		}
+	}
+	return nil
+}
+
+// positionedError appends the line/column of block within data to err's
+// message, found by locating block's exact bytes within data.
+func (r *rulesRegistry) positionedError(data, block []byte, err error) error {
+	offset := bytes.Index(data, block)
+	if offset < 0 {
+		return err
+	}
+	line, col := lineCol(data, offset)
+	return fmt.Errorf("%w (line %d, column %d)", err, line, col)
+}
+
+// lineCol converts a byte offset into data into a 1-based line/column pair.
+func lineCol(data []byte, offset int) (line, col int) {
+	if offset < 0 || offset > len(data) {
+		return 0, 0
+	}
+	line = 1
+	lastNewline := -1
+	for i := 0; i < offset; i++ {
+		if data[i] == '\n' {
+			line++
+			lastNewline = i
+		}
+	}
+	return line, offset - lastNewline
+}
@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRulesRegistry_LoadPolicy(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterBuiltinConstructors(reg); err != nil {
+		t.Fatalf("RegisterBuiltinConstructors: %v", err)
+	}
+
+	src := strings.NewReader(`{"rules":[
+		{"type":"nonempty"},
+		{"type":"length","params":{"min":3,"max":20}}
+	]}`)
+
+	if err := reg.LoadPolicy(src, "json"); err != nil {
+		t.Fatalf("LoadPolicy: %v", err)
+	}
+
+	if _, err := reg.Get("nonempty", reflect.ValueOf("x")); err != nil {
+		t.Fatalf("Get(nonempty): %v", err)
+	}
+	if _, err := reg.Get("length", reflect.ValueOf("x")); err != nil {
+		t.Fatalf("Get(length): %v", err)
+	}
+}
+
+func TestRulesRegistry_LoadPolicy_DuplicateOverload_HasPosition(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterBuiltinConstructors(reg); err != nil {
+		t.Fatalf("RegisterBuiltinConstructors: %v", err)
+	}
+
+	src := strings.NewReader("{\"rules\":[\n\t{\"type\":\"nonempty\"},\n\t{\"type\":\"nonempty\"}\n]}")
+
+	err := reg.LoadPolicy(src, "json")
+	if err == nil {
+		t.Fatalf("LoadPolicy should fail on a duplicate overload")
+	}
+	if !strings.Contains(err.Error(), "line") || !strings.Contains(err.Error(), "column") {
+		t.Fatalf("LoadPolicy error = %v, want it to carry a line/column position", err)
+	}
+}
+
+func TestRulesRegistry_LoadPolicy_UnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	err := reg.LoadPolicy(strings.NewReader("rules: []"), "yaml")
+	if err == nil || !strings.Contains(err.Error(), "not yet supported") {
+		t.Fatalf("LoadPolicy(yaml) error = %v, want not yet supported", err)
+	}
+}
+
+func TestRulesRegistry_LoadPolicy_UnknownRuleType(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	err := reg.LoadPolicy(strings.NewReader(`{"rules":[{"type":"mystery"}]}`), "json")
+	if err == nil || !strings.Contains(err.Error(), "unknown rule type") {
+		t.Fatalf("LoadPolicy error = %v, want unknown rule type", err)
+	}
+}
+
+func TestLineCol(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("abc\ndef\nghi")
+	tests := []struct {
+		offset   int
+		wantLine int
+		wantCol  int
+	}{
+		{0, 1, 1},
+		{3, 1, 4},
+		{4, 2, 1},
+		{8, 3, 1},
+	}
+	for _, tt := range tests {
+		line, col := lineCol(data, tt.offset)
+		if line != tt.wantLine || col != tt.wantCol {
+			t.Errorf("lineCol(%d) = (%d, %d), want (%d, %d)", tt.offset, line, col, tt.wantLine, tt.wantCol)
+		}
+	}
+}
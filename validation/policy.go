@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ygrebnov/errorc"
+
+	modelerrors "github.com/ygrebnov/model/errors"
+)
+
+// RuleConstructor builds a Rule from a decoded parameter bag, so a policy
+// document can instantiate a rule declaratively instead of calling NewRule
+// from Go code. params holds the document's per-rule configuration block
+// (e.g. {"min": 3, "max": 20}).
+type RuleConstructor func(params map[string]any) (Rule, error)
+
+// policyDocument is the canonical JSON shape ParsePolicy decodes. A rule's
+// Type names the registered RuleConstructor (e.g. "length"); its Params are
+// passed to that constructor verbatim.
+type policyDocument struct {
+	Rules []policyRule `json:"rules"`
+}
+
+type policyRule struct {
+	Type   string         `json:"type"`
+	Params map[string]any `json:"params"`
+}
+
+// AddConstructor registers ctor under name, so a policy document's
+// `{"type": name, ...}` entries can instantiate rules via ParsePolicy.
+func (r *rulesRegistry) AddConstructor(name string, ctor RuleConstructor) error {
+	if name == "" || ctor == nil {
+		return errorc.With(modelerrors.ErrInvalidRule, errorc.String(modelerrors.ErrorFieldRuleName, name))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.constructors == nil {
+		r.constructors = make(map[string]RuleConstructor)
+	}
+	r.constructors[name] = ctor
+	return nil
+}
+
+// Constructor returns the RuleConstructor registered under name, if any.
+func (r *rulesRegistry) Constructor(name string) (RuleConstructor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ctor, ok := r.constructors[name]
+	return ctor, ok
+}
+
+// ParsePolicy reads a policy document in format ("json" or "yaml") and
+// instantiates one Rule per entry by looking up its Type against the
+// constructors registered via AddConstructor. It does not register the
+// resulting rules; callers pass each one to Add.
+func (r *rulesRegistry) ParsePolicy(src []byte, format string) ([]Rule, error) {
+	var jsonSrc []byte
+	switch strings.ToLower(format) {
+	case "json":
+		jsonSrc = src
+	case "yaml", "yml", "hcl":
+		// Converting YAML/HCL to the canonical JSON form requires a parser
+		// this module does not currently depend on; only "json" is
+		// implemented for now.
+		return nil, fmt.Errorf("validation: ParsePolicy: format %q is not yet supported, use \"json\"", format)
+	default:
+		return nil, fmt.Errorf("validation: ParsePolicy: unknown format %q", format)
+	}
+
+	var doc policyDocument
+	if err := json.Unmarshal(jsonSrc, &doc); err != nil {
+		return nil, fmt.Errorf("validation: ParsePolicy: decode: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(doc.Rules))
+	for i, pr := range doc.Rules {
+		ctor, ok := r.Constructor(pr.Type)
+		if !ok {
+			return nil, fmt.Errorf(
+				"validation: ParsePolicy: rule %d: unknown rule type %q, registered constructors: %s",
+				i, pr.Type, strings.Join(r.constructorNames(), ", "),
+			)
+		}
+		rule, err := ctor(pr.Params)
+		if err != nil {
+			return nil, fmt.Errorf("validation: ParsePolicy: rule %d (%s): %w", i, pr.Type, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (r *rulesRegistry) constructorNames() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.constructors))
+	for name := range r.constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,113 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRulesRegistry_ParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterBuiltinConstructors(reg); err != nil {
+		t.Fatalf("RegisterBuiltinConstructors: %v", err)
+	}
+
+	src := []byte(`{"rules":[
+		{"type":"nonempty"},
+		{"type":"length","params":{"min":3,"max":20}},
+		{"type":"range","params":{"min":0,"max":100}}
+	]}`)
+
+	rules, err := reg.ParsePolicy(src, "json")
+	if err != nil {
+		t.Fatalf("ParsePolicy: %v", err)
+	}
+	if len(rules) != 3 {
+		t.Fatalf("len(rules) = %d, want 3", len(rules))
+	}
+
+	for _, r := range rules {
+		if err := reg.Add(r); err != nil {
+			t.Fatalf("Add(%s): %v", r.GetName(), err)
+		}
+	}
+
+	lengthRule, err := reg.Get("length", reflect.ValueOf("ok"))
+	if err != nil {
+		t.Fatalf("Get(length): %v", err)
+	}
+	if err := lengthRule.GetValidationFn()(reflect.ValueOf("ok")); err == nil {
+		t.Fatalf("length rule should reject a value shorter than min")
+	}
+}
+
+func TestRulesRegistry_ParsePolicy_UnknownType(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := RegisterBuiltinConstructors(reg); err != nil {
+		t.Fatalf("RegisterBuiltinConstructors: %v", err)
+	}
+
+	_, err := reg.ParsePolicy([]byte(`{"rules":[{"type":"unknown"}]}`), "json")
+	if err == nil || !strings.Contains(err.Error(), "unknown rule type") {
+		t.Fatalf("ParsePolicy error = %v, want unknown rule type", err)
+	}
+}
+
+func TestRulesRegistry_ParsePolicy_UnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+
+	_, err := reg.ParsePolicy([]byte(`rules: []`), "yaml")
+	if err == nil || !strings.Contains(err.Error(), "not yet supported") {
+		t.Fatalf("ParsePolicy(yaml) error = %v, want not yet supported", err)
+	}
+
+	_, err = reg.ParsePolicy(nil, "toml")
+	if err == nil || !strings.Contains(err.Error(), "unknown format") {
+		t.Fatalf("ParsePolicy(toml) error = %v, want unknown format", err)
+	}
+}
+
+func TestRulesRegistry_AddConstructor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if err := reg.AddConstructor("", lengthRuleConstructor); err == nil {
+		t.Fatalf("AddConstructor with empty name should fail")
+	}
+	if err := reg.AddConstructor("length", nil); err == nil {
+		t.Fatalf("AddConstructor with nil ctor should fail")
+	}
+}
+
+func TestLengthRuleConstructor(t *testing.T) {
+	t.Parallel()
+
+	rule, err := lengthRuleConstructor(map[string]any{"min": float64(3), "max": float64(5)})
+	if err != nil {
+		t.Fatalf("lengthRuleConstructor: %v", err)
+	}
+	fn := rule.GetValidationFn()
+	if err := fn(reflect.ValueOf("ab")); err == nil {
+		t.Fatalf("expected error for value shorter than min")
+	}
+	if err := fn(reflect.ValueOf("abcdef")); err == nil {
+		t.Fatalf("expected error for value longer than max")
+	}
+	if err := fn(reflect.ValueOf("abcd")); err != nil {
+		t.Fatalf("unexpected error for in-range value: %v", err)
+	}
+}
+
+func TestRangeRuleConstructor_BadParam(t *testing.T) {
+	t.Parallel()
+
+	if _, err := rangeRuleConstructor(map[string]any{"min": "low"}); err == nil {
+		t.Fatalf("expected error for non-numeric min")
+	}
+}
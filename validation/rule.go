@@ -12,6 +12,31 @@ type Rule interface {
 	GetName() string
 	GetValidationFn() func(v reflect.Value, params ...string) error
 
+	// WithMessage registers a message template for locale, later retrievable
+	// via Message and rendered with RenderMessageTemplate when this rule
+	// fails. It returns the receiver so it can be chained onto NewRule.
+	WithMessage(locale, template string) Rule
+
+	// Message returns the template registered for locale via WithMessage, if any.
+	Message(locale string) (template string, ok bool)
+
+	// WithDoc attaches a human-readable description, later retrievable via
+	// Doc. It returns the receiver so it can be chained onto NewRule.
+	WithDoc(doc string) Rule
+
+	// Doc returns the description set via WithDoc, for use by
+	// RulesRegistry.Describe. The default is "".
+	Doc() string
+
+	// WithParamSchema attaches the parameters this rule's function accepts,
+	// later retrievable via ParamSchema. It returns the receiver so it can
+	// be chained onto NewRule.
+	WithParamSchema(specs ...ParamSpec) Rule
+
+	// ParamSchema returns the parameters set via WithParamSchema, for use by
+	// RulesRegistry.Describe. The default is nil.
+	ParamSchema() []ParamSpec
+
 	getFieldTypeName() string
 	getFieldType() reflect.Type
 	isOfType(t reflect.Type) bool
@@ -23,6 +48,12 @@ type rule struct {
 	name      string
 	fieldType reflect.Type
 	fn        func(v reflect.Value, params ...string) error
+	messages  map[string]string // locale -> message template, set via WithMessage
+
+	doc         string      // set via WithDoc
+	paramSchema []ParamSpec // set via WithParamSchema
+
+	contributeFn func(node map[string]any, params []string) // set via WithSchemaContribution
 }
 
 func NewRule[FieldType any](name string, fn func(value FieldType, params ...string) error) (Rule, error) {
@@ -87,3 +118,57 @@ func (r *rule) isAssignableTo(t reflect.Type) bool {
 	}
 	return t.AssignableTo(r.fieldType)
 }
+
+// WithMessage registers a message template for locale, rendered via
+// RenderMessageTemplate when this rule fails. It returns the receiver so it
+// can be chained onto the result of NewRule.
+func (r *rule) WithMessage(locale, template string) Rule {
+	if r.messages == nil {
+		r.messages = make(map[string]string)
+	}
+	r.messages[locale] = template
+	return r
+}
+
+// Message returns the template registered for locale via WithMessage, if any.
+func (r *rule) Message(locale string) (string, bool) {
+	tpl, ok := r.messages[locale]
+	return tpl, ok
+}
+
+// WithDoc attaches a human-readable description to r, retrievable via Doc.
+// It returns the receiver so it can be chained onto the result of NewRule.
+func (r *rule) WithDoc(doc string) Rule {
+	r.doc = doc
+	return r
+}
+
+func (r *rule) Doc() string { return r.doc }
+
+// WithParamSchema attaches the parameters r's validation function accepts,
+// retrievable via ParamSchema. It returns the receiver so it can be chained
+// onto the result of NewRule.
+func (r *rule) WithParamSchema(specs ...ParamSpec) Rule {
+	r.paramSchema = append([]ParamSpec(nil), specs...)
+	return r
+}
+
+func (r *rule) ParamSchema() []ParamSpec { return r.paramSchema }
+
+// WithSchemaContribution registers fn to be called by ContributeSchema. It is
+// not part of the Rule interface (unlike WithMessage/WithDoc/WithParamSchema)
+// since most rules have nothing to contribute; set it through the
+// RuleSchemaContribution RuleOption rather than calling it directly.
+func (r *rule) WithSchemaContribution(fn func(node map[string]any, params []string)) {
+	r.contributeFn = fn
+}
+
+// ContributeSchema lets r satisfy package schema's SchemaContributor
+// interface once WithSchemaContribution has set contributeFn. The method
+// signature is kept structurally compatible with schema.SchemaContributor
+// without importing it, since package schema already imports validation.
+func (r *rule) ContributeSchema(node map[string]any, params []string) {
+	if r.contributeFn != nil {
+		r.contributeFn(node, params)
+	}
+}
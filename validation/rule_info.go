@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// RuleInfo is a read-only introspection snapshot for a single rule name,
+// returned by ListRules and DescribeRule. Builtin is true only when every
+// overload of the name comes from the built-in set, i.e. it has not been
+// extended or shadowed by a custom overload registered via Add.
+type RuleInfo struct {
+	Name    string
+	Types   []reflect.Type
+	Doc     string
+	Builtin bool
+}
+
+// MarshalJSON encodes ri with its reflect.Types rendered as their string form,
+// so a service can serve it directly from an HTTP handler (e.g. GET /rules).
+func (ri RuleInfo) MarshalJSON() ([]byte, error) {
+	types := make([]string, len(ri.Types))
+	for i, t := range ri.Types {
+		types[i] = t.String()
+	}
+	return json.Marshal(struct {
+		Name    string   `json:"name"`
+		Types   []string `json:"types"`
+		Doc     string   `json:"doc,omitempty"`
+		Builtin bool     `json:"builtin"`
+	}{Name: ri.Name, Types: types, Doc: ri.Doc, Builtin: ri.Builtin})
+}
+
+// ListRules returns every rule name known to r — both custom and built-in —
+// as a RuleInfo carrying every registered overload's field type. Unlike
+// Describe, which groups overloads with their full ParamSchema for
+// documentation generation, ListRules is a flatter, cheaper-to-serialize
+// catalog suited to SupportedTypes-style lookups.
+func (r *rulesRegistry) ListRules() []RuleInfo {
+	byName := make(map[string]*RuleInfo)
+	var order []string
+
+	addOverload := func(o Rule, builtin bool) {
+		name := o.GetName()
+		info := byName[name]
+		if info == nil {
+			info = &RuleInfo{Name: name, Builtin: true}
+			byName[name] = info
+			order = append(order, name)
+		}
+		info.Types = append(info.Types, o.getFieldType())
+		if info.Doc == "" {
+			info.Doc = o.Doc()
+		}
+		if !builtin {
+			info.Builtin = false
+		}
+	}
+
+	r.mu.RLock()
+	for _, overloads := range r.rules {
+		for _, o := range overloads {
+			addOverload(o, false)
+		}
+	}
+	r.mu.RUnlock()
+
+	for _, o := range allBuiltins() {
+		addOverload(o, true)
+	}
+
+	sort.Strings(order)
+	infos := make([]RuleInfo, 0, len(order))
+	for _, name := range order {
+		infos = append(infos, *byName[name])
+	}
+	return infos
+}
+
+// DescribeRule returns the RuleInfo for name, if any rule (custom or
+// built-in) is registered under it. It is named DescribeRule rather than
+// Describe to avoid colliding with the existing no-argument Describe, which
+// returns the full catalog as []RuleDescription for documentation generation.
+func (r *rulesRegistry) DescribeRule(name string) (RuleInfo, bool) {
+	for _, info := range r.ListRules() {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return RuleInfo{}, false
+}
+
+// SupportedTypes returns the field types every registered overload of name
+// accepts, or nil if name is not registered.
+func (r *rulesRegistry) SupportedTypes(name string) []reflect.Type {
+	info, ok := r.DescribeRule(name)
+	if !ok {
+		return nil
+	}
+	return info.Types
+}
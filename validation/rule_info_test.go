@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRulesRegistry_ListRules_IncludesBuiltinsAndCustom(t *testing.T) {
+	t.Parallel()
+
+	custom, err := NewRule[int]("divisibleByThree", func(v int, _ ...string) error {
+		if v%3 != 0 {
+			return errors.New("must be divisible by three")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	custom.WithDoc("value must be divisible by three")
+
+	reg := NewRulesRegistry()
+	if err := reg.Add(custom); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	infos := reg.ListRules()
+
+	var found bool
+	for _, info := range infos {
+		if info.Name != "divisibleByThree" {
+			continue
+		}
+		found = true
+		if info.Builtin {
+			t.Fatalf("divisibleByThree should not be reported as builtin")
+		}
+		if info.Doc == "" {
+			t.Fatalf("divisibleByThree should carry the Doc set via WithDoc")
+		}
+	}
+	if !found {
+		t.Fatalf("ListRules() should include the custom rule %q", "divisibleByThree")
+	}
+
+	var email bool
+	for _, info := range infos {
+		if info.Name == "email" {
+			email = true
+			if !info.Builtin {
+				t.Fatalf("email should be reported as builtin")
+			}
+		}
+	}
+	if !email {
+		t.Fatalf("ListRules() should include the built-in rule %q", "email")
+	}
+}
+
+func TestRulesRegistry_DescribeRule_NotFound(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if _, ok := reg.DescribeRule("mystery"); ok {
+		t.Fatalf("DescribeRule(mystery) should report not found")
+	}
+}
+
+func TestRulesRegistry_SupportedTypes(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	types := reg.SupportedTypes("email")
+	if len(types) == 0 {
+		t.Fatalf("SupportedTypes(email) should be non-empty")
+	}
+	if types[0] != reflect.TypeOf("") {
+		t.Fatalf("SupportedTypes(email) = %v, want it to include string", types)
+	}
+}
+
+func TestRuleInfo_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	info := RuleInfo{Name: "positive", Types: []reflect.Type{reflect.TypeOf(0)}, Doc: "must be positive"}
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded["name"] != "positive" {
+		t.Fatalf("decoded[name] = %v, want positive", decoded["name"])
+	}
+	types, _ := decoded["types"].([]any)
+	if len(types) != 1 || types[0] != "int" {
+		t.Fatalf("decoded[types] = %v, want [int]", decoded["types"])
+	}
+}
@@ -37,6 +37,15 @@ func NewMapping() Mapping {
 	}
 }
 
+// RulesMapping is an alias for Mapping, kept for callers that provision a
+// Service's parsed-rules cache by this name (e.g. core.NewService).
+type RulesMapping = Mapping
+
+// NewRulesMapping constructs the default RulesMapping implementation.
+func NewRulesMapping() RulesMapping {
+	return NewMapping()
+}
+
 func (c *mapping) Get(parent reflect.Type, fieldIndex int, tagName string) ([]RuleNameParams, bool) {
 	key := fieldRulesKey{parent: parent, index: fieldIndex, tagName: tagName}
 	if v, ok := c.c.Load(key); ok {
@@ -51,15 +60,35 @@ func (c *mapping) Add(parent reflect.Type, fieldIndex int, tagName string, parse
 	c.c.Store(key, parsed)
 }
 
-// RuleNameParams holds the Name and Params of a single validation rule.
+// RuleNameParams holds the Name and Params of a single validation rule, or —
+// when Alts is non-empty — an OR-group of alternatives ("email|url"): the
+// field passes if any one of Alts passes, and Name holds the pipe-joined
+// alternative names for error reporting; Params is unused on an OR-group,
+// since each alternative carries its own Params.
 type RuleNameParams struct {
 	Name   string
 	Params []string
+	Alts   []RuleNameParams
+
+	// Alias is the name the rule was referenced by in the original tag,
+	// before alias expansion replaced it with its underlying rule list (see
+	// Service.AddAlias / expandAliases). Empty when Name was not reached
+	// through an alias. FieldError carries both: Rule is always the
+	// underlying rule that actually ran (e.g. "len"), and Alias is set
+	// alongside it (e.g. "iso3166_alpha2") so callers can report or filter
+	// on either level.
+	Alias string
 }
 
 // ParseTag tokenizes a raw tag string (e.g., "required,min(5),max(10)") into rules.
 // Behavior:
 //   - Splits on top-level commas only (commas inside parentheses do not split tokens).
+//   - Within a comma-separated token, further splits on top-level "|" into an
+//     OR-group (see RuleNameParams.Alts); e.g. "email|url" or
+//     "oneof(a,b,c)|min(5)". A token with no "|" stays a plain rule.
+//   - A rule carries its parameters in parens ("name(p1,p2)", comma-separated)
+//     or after its first '=' ("name=p1 p2", space-separated); the
+//     parenthesized form takes precedence when a rule has both.
 //   - Trims whitespace around tokens and parameters.
 //   - Empty tokens (from leading/trailing commas) are skipped.
 //   - Parameters are split by commas; nested parentheses inside parameters are not parsed specially.
@@ -70,51 +99,95 @@ func ParseTag(tag string) []RuleNameParams {
 		return rules
 	}
 
-	var tokens []string
+	for _, tok := range splitTopLevel(tag, ',') {
+		tok = strings.TrimSpace(tok)
+		if tok == "" {
+			continue
+		}
+
+		alts := splitTopLevel(tok, '|')
+		if len(alts) == 1 {
+			if r, ok := parseSingleRule(alts[0]); ok {
+				rules = append(rules, r)
+			}
+			continue
+		}
+
+		var altRules []RuleNameParams
+		var names []string
+		for _, a := range alts {
+			if r, ok := parseSingleRule(a); ok {
+				altRules = append(altRules, r)
+				names = append(names, r.Name)
+			}
+		}
+		switch len(altRules) {
+		case 0:
+			continue
+		case 1:
+			rules = append(rules, altRules[0])
+		default:
+			rules = append(rules, RuleNameParams{Name: strings.Join(names, "|"), Alts: altRules})
+		}
+	}
+	return rules
+}
+
+// splitTopLevel splits s on every occurrence of sep that is not nested
+// inside parentheses, trimming whitespace from each piece. Used by ParseTag
+// for both its top-level "," split and, within each resulting token, the
+// "|" split into an OR-group.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
 	depth := 0
 	start := 0
-	for i, r := range tag {
-		switch r {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
 		case '(':
 			depth++
 		case ')':
 			if depth > 0 {
 				depth--
 			}
-		case ',':
-			if depth == 0 {
-				tokens = append(tokens, strings.TrimSpace(tag[start:i]))
+		default:
+			if s[i] == sep && depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
 				start = i + 1
 			}
 		}
 	}
-	// Append the last token
-	if start <= len(tag) {
-		tokens = append(tokens, strings.TrimSpace(tag[start:]))
-	}
+	parts = append(parts, strings.TrimSpace(s[start:]))
+	return parts
+}
 
-	for _, tok := range tokens {
-		if tok == "" {
-			continue
-		}
-		name := tok
-		var params []string
-		if idx := strings.IndexRune(tok, '('); idx != -1 && strings.HasSuffix(tok, ")") {
-			name = strings.TrimSpace(tok[:idx])
-			inner := strings.TrimSpace(tok[idx+1 : len(tok)-1])
-			if inner != "" {
-				parts := strings.Split(inner, ",")
-				for _, p := range parts {
-					p = strings.TrimSpace(p)
-					if p != "" {
-						params = append(params, p)
-					}
+// parseSingleRule parses one atomic rule token ("name" or "name(p1,p2)")
+// into a RuleNameParams, reporting false for an empty name.
+func parseSingleRule(tok string) (RuleNameParams, bool) {
+	if tok == "" {
+		return RuleNameParams{}, false
+	}
+	name := tok
+	var params []string
+	if idx := strings.IndexRune(tok, '('); idx != -1 && strings.HasSuffix(tok, ")") {
+		name = strings.TrimSpace(tok[:idx])
+		inner := strings.TrimSpace(tok[idx+1 : len(tok)-1])
+		if inner != "" {
+			for _, p := range strings.Split(inner, ",") {
+				p = strings.TrimSpace(p)
+				if p != "" {
+					params = append(params, p)
 				}
 			}
 		}
-		if name != "" {
-			rules = append(rules, RuleNameParams{Name: name, Params: params})
+	}
+	if name == tok {
+		if idx := strings.IndexRune(tok, '='); idx != -1 {
+			name = strings.TrimSpace(tok[:idx])
+			params = strings.Fields(tok[idx+1:])
 		}
 	}
-	return rules
+	if name == "" {
+		return RuleNameParams{}, false
+	}
+	return RuleNameParams{Name: name, Params: params}, true
 }
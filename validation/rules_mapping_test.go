@@ -42,6 +42,34 @@ func TestParseTag(t *testing.T) {
 			in:   "a,,b",
 			want: []RuleNameParams{{Name: "a"}, {Name: "b"}},
 		},
+		{
+			name: "pipe-separated alternatives become an OR-group",
+			in:   "email|url",
+			want: []RuleNameParams{{
+				Name: "email|url",
+				Alts: []RuleNameParams{{Name: "email"}, {Name: "url"}},
+			}},
+		},
+		{
+			name: "OR-group alternatives keep their own Params",
+			in:   "oneof(a,b,c)|min(5)",
+			want: []RuleNameParams{{
+				Name: "oneof|min",
+				Alts: []RuleNameParams{
+					{Name: "oneof", Params: []string{"a", "b", "c"}},
+					{Name: "min", Params: []string{"5"}},
+				},
+			}},
+		},
+		{
+			name: "an OR-group is only one alternative among other comma-separated rules",
+			in:   "required,email|url,max(10)",
+			want: []RuleNameParams{
+				{Name: "required"},
+				{Name: "email|url", Alts: []RuleNameParams{{Name: "email"}, {Name: "url"}}},
+				{Name: "max", Params: []string{"10"}},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1,8 +1,10 @@
 package validation
 
 import (
+	"io"
 	"reflect"
 	"slices"
+	"sort"
 	"strings"
 	"sync"
 
@@ -13,12 +15,41 @@ import (
 type RulesRegistry interface {
 	Add(r Rule) error
 	Get(name string, v reflect.Value) (Rule, error)
+
+	// AddConstructor, Constructor, and ParsePolicy support config-driven rule
+	// registration; see RuleConstructor and ParsePolicy.
+	AddConstructor(name string, ctor RuleConstructor) error
+	Constructor(name string) (RuleConstructor, bool)
+	ParsePolicy(src []byte, format string) ([]Rule, error)
+
+	// LoadPolicy is ParsePolicy's io.Reader-based counterpart: it decodes
+	// and registers the rules in one step. See its doc comment in
+	// load_policy.go.
+	LoadPolicy(src io.Reader, format string) error
+
+	// Describe and Suggest support introspecting the registry; see their
+	// doc comments in introspect.go.
+	Describe() []RuleDescription
+	Suggest(name string, v reflect.Value) []string
+
+	// Check statically pre-flights struct type t's tagged fields against the
+	// registry; see its doc comment in check.go.
+	Check(t reflect.Type) error
+
+	// ListRules, DescribeRule, and SupportedTypes expose a flatter,
+	// per-rule introspection surface geared towards serving a rule catalog
+	// (e.g. GET /rules); see their doc comments in rule_info.go.
+	ListRules() []RuleInfo
+	DescribeRule(name string) (RuleInfo, bool)
+	SupportedTypes(name string) []reflect.Type
 }
 
 // rulesRegistry is a registry of validation rules.
 type rulesRegistry struct {
 	mu    sync.RWMutex
 	rules map[string][]Rule // rule Name -> overloads by type
+
+	constructors map[string]RuleConstructor // rule type -> constructor, set via AddConstructor
 }
 
 func NewRulesRegistry() RulesRegistry {
@@ -54,13 +85,19 @@ func (r *rulesRegistry) Add(rule Rule) error {
 	return nil
 }
 
-// Get returns the best-matching overload of rule `Name` for the given field value.
-// Selection strategy:
-//  1. Prefer exact type match (v.Type() == fieldType).
-//  2. Otherwise accept AssignableTo matches (interfaces, named types), preferring the first declared.
-//  3. Otherwise, if no matches, fetch a built-in rule if available.
-//  4. If no matches, return a descriptive error listing available overload types.
-//  5. If multiple exact matches (shouldn't happen), return an ambiguity error.
+// overloadCandidate pairs a registered overload with its type distance from
+// the value being resolved; see typeDistance.
+type overloadCandidate struct {
+	rule     Rule
+	distance int
+}
+
+// Get returns the best-matching overload of rule `Name` for the given field
+// value. Each overload is scored by typeDistance against v's type, and the
+// minimum-distance candidate wins; ties at the same nonzero distance are
+// ambiguous (two exact matches can't tie, since Add rejects a duplicate
+// exact-type overload before it is ever registered). If no registered
+// overload matches at all, a built-in rule is used as a fallback.
 func (r *rulesRegistry) Get(name string, v reflect.Value) (Rule, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -73,36 +110,19 @@ func (r *rulesRegistry) Get(name string, v reflect.Value) (Rule, error) {
 	valueType := v.Type()
 	rules := r.rules[name]
 
-	var (
-		exacts  []Rule
-		assigns []Rule
-	)
+	var candidates []overloadCandidate
 	for _, rule := range rules {
 		if rule.getFieldType() == nil || rule.GetValidationFn() == nil {
 			continue // defensive, should not happen due to checks in NewRule
 		}
-		if rule.isOfType(valueType) {
-			exacts = append(exacts, rule)
+		d, ok := typeDistance(rule.getFieldType(), valueType)
+		if !ok {
 			continue
 		}
-		if rule.isAssignableTo(valueType) {
-			assigns = append(assigns, rule)
-		}
+		candidates = append(candidates, overloadCandidate{rule: rule, distance: d})
 	}
 
-	switch {
-	case len(exacts) == 1:
-		return exacts[0], nil
-	case len(exacts) > 1:
-		// defensive: should not happen due to add() checks
-		return nil, errorc.With(
-			errors.ErrAmbiguousRule,
-			errorc.String(errors.ErrorFieldRuleName, name),
-			errorc.String(errors.ErrorFieldValueType, valueType.String()),
-		)
-	case len(assigns) >= 1:
-		return assigns[0], nil
-	default:
+	if len(candidates) == 0 {
 		// No matches; check for built-in rule as fallback.
 		builtinRule, hasBuiltin := lookupBuiltin(name, valueType)
 		if hasBuiltin {
@@ -124,6 +144,55 @@ func (r *rulesRegistry) Get(name string, v reflect.Value) (Rule, error) {
 			errorc.String(errors.ErrorFieldAvailableTypes, strings.Join(getFieldTypesNames(rules), ", ")),
 		)
 	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	best := candidates[0]
+	if len(candidates) > 1 && candidates[1].distance == best.distance {
+		return nil, errorc.With(
+			errors.ErrAmbiguousRule,
+			errorc.String(errors.ErrorFieldRuleName, name),
+			errorc.String(errors.ErrorFieldValueType, valueType.String()),
+			errorc.String(errors.ErrorFieldFieldTypeA, best.rule.getFieldTypeName()),
+			errorc.String(errors.ErrorFieldFieldTypeB, candidates[1].rule.getFieldTypeName()),
+		)
+	}
+	return best.rule, nil
+}
+
+// typeDistance scores how well a value of valueType matches an overload
+// registered for fieldType, lower is better: 0 exact type equality, 1
+// pointer-to-exact (*T overload for a T value or vice versa), 2 valueType
+// implements a registered (non-empty) interface fieldType, 3 assignability
+// via AssignableTo, 4 convertibility via ConvertibleTo. A fieldType of
+// interface{} (any) is the lowest-priority catch-all, scored 5, so a
+// concrete or interface overload is always preferred over it. ok is false
+// when valueType does not match fieldType by any of these rules.
+func typeDistance(fieldType, valueType reflect.Type) (distance int, ok bool) {
+	if fieldType == valueType {
+		return 0, true
+	}
+	if fieldType.Kind() == reflect.Ptr && fieldType.Elem() == valueType {
+		return 1, true
+	}
+	if valueType.Kind() == reflect.Ptr && valueType.Elem() == fieldType {
+		return 1, true
+	}
+
+	isAny := fieldType.Kind() == reflect.Interface && fieldType.NumMethod() == 0
+	if isAny {
+		return 5, true
+	}
+
+	if fieldType.Kind() == reflect.Interface && valueType.Implements(fieldType) {
+		return 2, true
+	}
+	if valueType.AssignableTo(fieldType) {
+		return 3, true
+	}
+	if valueType.ConvertibleTo(fieldType) {
+		return 4, true
+	}
+	return 0, false
 }
 
 func getFieldTypesNames(rules []Rule) []string {
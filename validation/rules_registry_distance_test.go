@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	errorsPkg "github.com/ygrebnov/model/errors"
+)
+
+type fmtStringer interface{ String() string }
+
+type distanceFixture struct{ v string }
+
+func (d distanceFixture) String() string { return d.v }
+
+func TestTypeDistance(t *testing.T) {
+	t.Parallel()
+
+	stringType := reflect.TypeOf("")
+	ptrStringType := reflect.TypeOf((*string)(nil))
+	stringerType := reflect.TypeOf((*fmtStringer)(nil)).Elem()
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+	distanceFixtureType := reflect.TypeOf(distanceFixture{})
+	intType := reflect.TypeOf(0)
+
+	// namedStrings is assignable to []string because []string is an unnamed
+	// type; two defined types (e.g. a named string and string) are only
+	// convertible, not assignable.
+	type namedStrings []string
+	stringSliceType := reflect.TypeOf([]string(nil))
+	namedStringsType := reflect.TypeOf(namedStrings(nil))
+
+	tests := []struct {
+		name         string
+		fieldType    reflect.Type
+		valueType    reflect.Type
+		wantDistance int
+		wantOK       bool
+	}{
+		{"exact", stringType, stringType, 0, true},
+		{"pointer-to-exact (field is *T, value is T)", ptrStringType, stringType, 1, true},
+		{"pointer-to-exact (field is T, value is *T)", stringType, ptrStringType, 1, true},
+		{"interface implementation", stringerType, distanceFixtureType, 2, true},
+		{"assignable named type", stringSliceType, namedStringsType, 3, true},
+		{"any is lowest priority catch-all", anyType, stringType, 5, true},
+		// int is convertible to string in Go, so *string is the unrelated
+		// field type here.
+		{"no relation", ptrStringType, intType, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, ok := typeDistance(tt.fieldType, tt.valueType)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && d != tt.wantDistance {
+				t.Fatalf("distance = %d, want %d", d, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestTypeDistance_ConvertibleNotAssignable(t *testing.T) {
+	t.Parallel()
+
+	type celsius float64
+	d, ok := typeDistance(reflect.TypeOf(float64(0)), reflect.TypeOf(celsius(0)))
+	if !ok || d != 4 {
+		t.Fatalf("typeDistance(float64, celsius) = (%d, %v), want (4, true)", d, ok)
+	}
+}
+
+func TestRulesRegistry_Get_PrefersMinimumDistance(t *testing.T) {
+	t.Parallel()
+
+	exact, err := NewRule[string]("pick", func(string, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	stringerRule, err := NewRule[fmtStringer]("pick", func(fmtStringer, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+
+	reg := &rulesRegistry{rules: map[string][]Rule{"pick": {stringerRule, exact}}}
+
+	got, err := reg.Get("pick", reflect.ValueOf("hi"))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != exact {
+		t.Fatalf("Get() should prefer the exact-type overload over the interface overload")
+	}
+}
+
+func TestRulesRegistry_Get_AmbiguousOnTie(t *testing.T) {
+	t.Parallel()
+
+	// Two any-typed overloads both resolve at the lowest-priority catch-all
+	// distance (5) for a string value, so they tie.
+	ruleA, err := NewRule[any]("anytie", func(any, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	ruleB, err := NewRule[any]("anytie", func(any, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	reg := &rulesRegistry{rules: map[string][]Rule{"anytie": {ruleA, ruleB}}}
+
+	_, err = reg.Get("anytie", reflect.ValueOf("x"))
+	if !errors.Is(err, errorsPkg.ErrAmbiguousRule) {
+		t.Fatalf("Get() error = %v, want ErrAmbiguousRule", err)
+	}
+}
+
+func TestRulesRegistry_Get_PointerToExact(t *testing.T) {
+	t.Parallel()
+
+	ptrRule, err := NewRule[*string]("ptrOverload", func(*string, ...string) error { return nil })
+	if err != nil {
+		t.Fatalf("NewRule: %v", err)
+	}
+	reg := &rulesRegistry{rules: map[string][]Rule{"ptrOverload": {ptrRule}}}
+
+	s := "x"
+	got, err := reg.Get("ptrOverload", reflect.ValueOf(s))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != ptrRule {
+		t.Fatalf("Get() should resolve a *T overload for a T value via pointer-to-exact distance")
+	}
+}
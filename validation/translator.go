@@ -0,0 +1,29 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Translator renders a localized, human-readable message for a FieldError in
+// a given locale (e.g. "en", "fr").
+type Translator interface {
+	Translate(fe FieldError, locale string) string
+}
+
+// TranslatorFunc adapts a plain function to the Translator interface.
+type TranslatorFunc func(fe FieldError, locale string) string
+
+// Translate implements Translator.
+func (f TranslatorFunc) Translate(fe FieldError, locale string) string { return f(fe, locale) }
+
+// RenderMessageTemplate substitutes "{field}", "{value}", and "{param0}",
+// "{param1}", ... placeholders in tpl with values from fe and value.
+func RenderMessageTemplate(tpl string, fe FieldError, value string) string {
+	out := strings.ReplaceAll(tpl, "{field}", fe.Path)
+	out = strings.ReplaceAll(out, "{value}", value)
+	for i, p := range fe.Params {
+		out = strings.ReplaceAll(out, fmt.Sprintf("{param%d}", i), p)
+	}
+	return out
+}
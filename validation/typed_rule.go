@@ -0,0 +1,71 @@
+package validation
+
+// RuleOption configures a Rule built via RegisterRule, mirroring the
+// WithDoc / WithParamSchema / WithMessage chain methods available on any
+// Rule returned by NewRule.
+type RuleOption func(Rule)
+
+// RuleDoc sets the rule's Doc(), as WithDoc does.
+func RuleDoc(doc string) RuleOption {
+	return func(r Rule) { r.WithDoc(doc) }
+}
+
+// RuleParams sets the rule's ParamSchema(), as WithParamSchema does.
+func RuleParams(specs ...ParamSpec) RuleOption {
+	return func(r Rule) { r.WithParamSchema(specs...) }
+}
+
+// RuleMessage registers a message template for locale, as WithMessage does.
+func RuleMessage(locale, template string) RuleOption {
+	return func(r Rule) { r.WithMessage(locale, template) }
+}
+
+// RuleSchemaContribution lets a rule contribute extra JSON Schema keywords
+// when introspected through package schema's Build, by satisfying its
+// SchemaContributor interface. WithSchemaContribution is deliberately absent
+// from the Rule interface — adding it there would force every Rule
+// implementation (fieldLevelRule, crossFieldRule, the AllOf/AnyOf/Not/When
+// combinators) to grow a method most have no use for — so this option
+// reaches it through a type assertion instead; it is a no-op for a Rule that
+// does not implement it.
+func RuleSchemaContribution(fn func(node map[string]any, params []string)) RuleOption {
+	return func(r Rule) {
+		if c, ok := r.(interface {
+			WithSchemaContribution(func(node map[string]any, params []string))
+		}); ok {
+			c.WithSchemaContribution(fn)
+		}
+	}
+}
+
+// RegisterRule builds a type-safe Rule named name from check and adds it to
+// registry in one step — what NewRule + Add + manual option application
+// would otherwise take. check receives the already-asserted T value
+// directly, so callers never touch reflect.Value themselves; overload
+// resolution, ErrDuplicateOverloadRule, and Get's exact-over-assignable
+// matching all behave exactly as they do for a rule built by hand with
+// NewRule.
+//
+// Go does not allow a method to introduce a type parameter beyond its
+// receiver's, so the generic "registry.addTyped[T]" entrypoint this is
+// modeled on cannot exist as a method; addTyped is instead a package-level
+// function, and RegisterRule is the public wrapper that also performs the
+// registry.Add.
+func RegisterRule[T any](registry RulesRegistry, name string, check func(T) error, opts ...RuleOption) (Rule, error) {
+	return addTyped[T](registry, name, check, opts...)
+}
+
+// addTyped builds the Rule for RegisterRule and adds it to registry.
+func addTyped[T any](registry RulesRegistry, name string, check func(T) error, opts ...RuleOption) (Rule, error) {
+	r, err := NewRule[T](name, func(v T, _ ...string) error { return check(v) })
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if err := registry.Add(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRegisterRule_AddsAndResolves(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+
+	var gotT string
+	rule, err := RegisterRule[string](reg, "nonblank", func(s string) error {
+		gotT = s
+		if s == "" {
+			return errors.New("must not be blank")
+		}
+		return nil
+	}, RuleDoc("value must not be blank"), RuleParams(ParamSpec{Name: "value", Type: "string"}))
+	if err != nil {
+		t.Fatalf("RegisterRule: %v", err)
+	}
+
+	if rule.Doc() != "value must not be blank" {
+		t.Fatalf("Doc() = %q, want the RuleDoc option applied", rule.Doc())
+	}
+	if len(rule.ParamSchema()) != 1 {
+		t.Fatalf("ParamSchema() = %v, want one entry from RuleParams", rule.ParamSchema())
+	}
+
+	resolved, err := reg.Get("nonblank", reflect.ValueOf("hello"))
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if err := resolved.GetValidationFn()(reflect.ValueOf("hello")); err != nil {
+		t.Fatalf("validation fn returned error for a non-blank value: %v", err)
+	}
+	if gotT != "hello" {
+		t.Fatalf("check received %q, want the already-asserted T value %q", gotT, "hello")
+	}
+
+	if err := resolved.GetValidationFn()(reflect.ValueOf("")); err == nil {
+		t.Fatalf("expected an error for a blank value")
+	}
+}
+
+func TestRegisterRule_DuplicateOverload(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if _, err := RegisterRule[string](reg, "dup", func(string) error { return nil }); err != nil {
+		t.Fatalf("first RegisterRule: %v", err)
+	}
+	if _, err := RegisterRule[string](reg, "dup", func(string) error { return nil }); err == nil {
+		t.Fatalf("second RegisterRule with the same name/type should fail like Add does")
+	}
+}
+
+func TestRegisterRule_DistinctTypesCoexist(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRulesRegistry()
+	if _, err := RegisterRule[string](reg, "shared", func(string) error { return nil }); err != nil {
+		t.Fatalf("RegisterRule[string]: %v", err)
+	}
+	if _, err := RegisterRule[int](reg, "shared", func(int) error { return nil }); err != nil {
+		t.Fatalf("RegisterRule[int]: %v", err)
+	}
+
+	if _, err := reg.Get("shared", reflect.ValueOf("x")); err != nil {
+		t.Fatalf("Get(shared, string): %v", err)
+	}
+	if _, err := reg.Get("shared", reflect.ValueOf(1)); err != nil {
+		t.Fatalf("Get(shared, int): %v", err)
+	}
+}
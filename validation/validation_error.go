@@ -0,0 +1,240 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FieldError represents a single validation failure for a specific field and
+// rule. It implements error and unwraps to the underlying cause so callers
+// can use errors.Is/As.
+type FieldError struct {
+	Path     string   // dotted path to the field (e.g., Address.Street)
+	NamePath string   // Path re-expressed using each field's configured name tag (see Service.SetNameTag), e.g. json tag names — this is the dotted JSON/alt-name namespace path (e.g. "user.email_address") a REST API can return directly, without a caller-side translation pass from Go field names
+	Rule     string   // rule name that failed
+	Alias    string   // the alias the rule was referenced by in the validate tag, if any (see Service.AddAlias); empty when Rule was not reached through an alias
+	Params   []string // parameters provided to the rule via the validate tag
+	Err      error    // underlying error from the rule
+}
+
+func (e FieldError) Error() string {
+	msg := "<nil>"
+	if e.Err != nil {
+		msg = e.Err.Error()
+	}
+	switch {
+	case e.Alias != "":
+		return fmt.Sprintf("Field %q: %s (rule %q, alias %q)", e.Path, msg, e.Rule, e.Alias)
+	case e.Rule != "":
+		return fmt.Sprintf("Field %q: %s (rule %q)", e.Path, msg, e.Rule)
+	default:
+		return fmt.Sprintf("Field %q: %s", e.Path, msg)
+	}
+}
+
+func (e FieldError) Unwrap() error { return e.Err }
+
+// MarshalJSON exports FieldError as an object with path, rule, alias,
+// Params, and message fields. alias is omitted when empty.
+func (e FieldError) MarshalJSON() ([]byte, error) {
+	msg := ""
+	if e.Err != nil {
+		msg = e.Err.Error()
+	}
+	return json.Marshal(struct {
+		Path     string   `json:"path"`
+		NamePath string   `json:"namePath"`
+		Rule     string   `json:"rule"`
+		Alias    string   `json:"alias,omitempty"`
+		Params   []string `json:"Params,omitempty"`
+		Message  string   `json:"message"`
+	}{
+		Path:     e.Path,
+		NamePath: e.NamePath,
+		Rule:     e.Rule,
+		Alias:    e.Alias,
+		Params:   e.Params,
+		Message:  msg,
+	})
+}
+
+// Error accumulates multiple FieldError entries produced while validating a
+// single value. It implements error and unwraps to errors.Join of underlying
+// causes so errors.Is/As continue to work for callers.
+type Error struct {
+	mu     sync.Mutex
+	issues []FieldError
+}
+
+// Add appends a FieldError. It is a safe no-op on a nil receiver.
+func (ve *Error) Add(fe FieldError) {
+	if ve == nil {
+		return
+	}
+	ve.mu.Lock()
+	ve.issues = append(ve.issues, fe)
+	ve.mu.Unlock()
+}
+
+// Addf is a convenience to add a FieldError from its parts.
+func (ve *Error) Addf(path, rule string, err error) {
+	ve.Add(FieldError{Path: path, Rule: rule, Err: err})
+}
+
+// Len returns the number of accumulated issues.
+func (ve *Error) Len() int {
+	if ve == nil {
+		return 0
+	}
+	ve.mu.Lock()
+	n := len(ve.issues)
+	ve.mu.Unlock()
+	return n
+}
+
+// Empty reports whether there are no issues.
+func (ve *Error) Empty() bool { return ve.Len() == 0 }
+
+// Error returns a human-readable, multi-line description of all issues.
+func (ve *Error) Error() string {
+	if ve == nil {
+		return ""
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	switch len(ve.issues) {
+	case 0:
+		return ""
+	case 1:
+		return ve.issues[0].Error()
+	default:
+		var b strings.Builder
+		b.WriteString("validation failed (\n")
+		for i, fe := range ve.issues {
+			b.WriteString("  ")
+			b.WriteString(fe.Error())
+			if i < len(ve.issues)-1 {
+				b.WriteString("\n")
+			}
+		}
+		b.WriteString("\n)")
+		return b.String()
+	}
+}
+
+// Unwrap joins underlying causes so errors.Is/As keep working on the combined error.
+func (ve *Error) Unwrap() error {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	errs := make([]error, 0, len(ve.issues))
+	for _, fe := range ve.issues {
+		if fe.Err != nil {
+			errs = append(errs, fe.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ForField returns all issues for a given dotted field path, in the order added.
+func (ve *Error) ForField(path string) []FieldError {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	var out []FieldError
+	for _, fe := range ve.issues {
+		if fe.Path == path {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// ForNamePath returns all issues for a given NamePath (a dotted path composed
+// of each field's configured name tag, e.g. json tag names), in the order added.
+func (ve *Error) ForNamePath(namePath string) []FieldError {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	var out []FieldError
+	for _, fe := range ve.issues {
+		if fe.NamePath == namePath {
+			out = append(out, fe)
+		}
+	}
+	return out
+}
+
+// ByField groups issues by dotted field path.
+func (ve *Error) ByField() map[string][]FieldError {
+	m := make(map[string][]FieldError)
+	if ve == nil {
+		return m
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	for _, fe := range ve.issues {
+		m[fe.Path] = append(m[fe.Path], fe)
+	}
+	return m
+}
+
+// ByNamePath groups issues by NamePath (see ForNamePath).
+func (ve *Error) ByNamePath() map[string][]FieldError {
+	m := make(map[string][]FieldError)
+	if ve == nil {
+		return m
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	for _, fe := range ve.issues {
+		m[fe.NamePath] = append(m[fe.NamePath], fe)
+	}
+	return m
+}
+
+// Fields returns the list of field paths that have issues, unique and in the
+// order of first occurrence.
+func (ve *Error) Fields() []string {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	seen := make(map[string]struct{})
+	var out []string
+	for _, fe := range ve.issues {
+		if _, ok := seen[fe.Path]; !ok {
+			seen[fe.Path] = struct{}{}
+			out = append(out, fe.Path)
+		}
+	}
+	return out
+}
+
+// MarshalJSON exports Error as a map of field path -> list of error messages.
+func (ve *Error) MarshalJSON() ([]byte, error) {
+	if ve == nil {
+		return []byte("null"), nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	by := make(map[string][]string, len(ve.issues))
+	for _, fe := range ve.issues {
+		msg := ""
+		if fe.Err != nil {
+			msg = fe.Err.Error()
+		}
+		by[fe.Path] = append(by[fe.Path], msg)
+	}
+	return json.Marshal(by)
+}
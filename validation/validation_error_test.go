@@ -170,6 +170,41 @@ func TestValidationError_ForField_and_ByField_and_Fields(t *testing.T) {
 	}
 }
 
+func TestValidationError_ForNamePath_and_ByNamePath(t *testing.T) {
+	t.Parallel()
+
+	// nil receiver
+	var veNil *Error
+	if got := veNil.ForNamePath("x"); got != nil {
+		t.Fatalf("nil ve ForNamePath returned %v, want nil", got)
+	}
+	if m := veNil.ByNamePath(); len(m) != 0 {
+		t.Fatalf("nil ve ByNamePath non-empty map: %+v", m)
+	}
+
+	ve := &Error{}
+	ve.Add(FieldError{Path: "A", NamePath: "a", Rule: "r1"})
+	ve.Add(FieldError{Path: "B", NamePath: "b", Rule: "r2"})
+	ve.Add(FieldError{Path: "B", NamePath: "b", Rule: "r3"})
+
+	a := ve.ForNamePath("a")
+	if len(a) != 1 || a[0].Rule != "r1" {
+		t.Fatalf("ForNamePath(a) wrong: %+v", a)
+	}
+	b := ve.ForNamePath("b")
+	if len(b) != 2 || b[0].Rule != "r2" || b[1].Rule != "r3" {
+		t.Fatalf("ForNamePath(b) wrong: %+v", b)
+	}
+	if c := ve.ForNamePath("c"); len(c) != 0 {
+		t.Fatalf("ForNamePath(c) should be empty, got %+v", c)
+	}
+
+	m := ve.ByNamePath()
+	if len(m) != 2 || len(m["a"]) != 1 || len(m["b"]) != 2 {
+		t.Fatalf("ByNamePath grouping wrong: %+v", m)
+	}
+}
+
 func TestValidationError_MarshalJSON(t *testing.T) {
 	t.Parallel()
 
@@ -301,12 +336,13 @@ func TestFieldError_MarshalJSON(t *testing.T) {
 		{
 			name: "full fields with Params and message",
 			fe: FieldError{
-				Path:   "User.Email",
-				Rule:   "nonempty",
-				Params: []string{"p1", "p2"},
-				Err:    errors.New("must not be empty"),
+				Path:     "User.Email",
+				NamePath: "user.email",
+				Rule:     "nonempty",
+				Params:   []string{"p1", "p2"},
+				Err:      errors.New("must not be empty"),
 			},
-			wantHas:    []string{`"path":"User.Email"`, `"rule":"nonempty"`, `"Params":["p1","p2"]`, `"message":"must not be empty"`},
+			wantHas:    []string{`"path":"User.Email"`, `"namePath":"user.email"`, `"rule":"nonempty"`, `"Params":["p1","p2"]`, `"message":"must not be empty"`},
 			wantNotHas: []string{}, // all present
 		},
 		{
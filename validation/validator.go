@@ -0,0 +1,341 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Validator is an immutable, lazily-evaluated validation pipeline over T,
+// built on top of the existing Rule/RulesRegistry machinery without going
+// through the struct-tag-driven flow. Every chained call (For, Rules,
+// WithParams, When, Cascade, All) returns a new Validator rather than
+// mutating the receiver, so a partially-built pipeline can be shared and
+// extended from multiple call sites without the branches interfering with
+// each other. Rule names are resolved against the registry lazily, inside
+// Validate, so a Validator may be assembled — even at package init — before
+// every rule it names has been registered.
+type Validator[T any] struct {
+	registry RulesRegistry
+	steps    []fieldStep
+	failFast bool // set by Cascade; All (the default) resets it.
+}
+
+// fieldStep describes the rules to run for one field path, and an optional
+// predicate gating whether the field is checked at all.
+type fieldStep struct {
+	path      string
+	rules     []ruleInvocation
+	diveRules []ruleInvocation // set via Dive; run per-element instead of against the field itself
+	when      func(any) bool
+}
+
+type ruleInvocation struct {
+	name   string
+	params []string
+}
+
+// NewValidator creates an empty Validator[T] that resolves rule names
+// against registry. Chain For/Rules/WithParams/When to describe what to
+// check, then call Validate.
+func NewValidator[T any](registry RulesRegistry) *Validator[T] {
+	return &Validator[T]{registry: registry}
+}
+
+func (v *Validator[T]) clone() *Validator[T] {
+	cp := *v
+	cp.steps = append([]fieldStep(nil), v.steps...)
+	return &cp
+}
+
+// For opens a new field step for fieldPath (a dotted path into T, e.g.
+// "Address.City"). Subsequent Rules, WithParams, and When calls apply to
+// this step until the next call to For.
+func (v *Validator[T]) For(fieldPath string) *Validator[T] {
+	cp := v.clone()
+	cp.steps = append(cp.steps, fieldStep{path: fieldPath})
+	return cp
+}
+
+// Rules attaches the named rules, in order, to the field most recently
+// opened with For. Calling Rules with no prior For is a no-op.
+func (v *Validator[T]) Rules(names ...string) *Validator[T] {
+	cp := v.clone()
+	if len(cp.steps) == 0 {
+		return cp
+	}
+	last := &cp.steps[len(cp.steps)-1]
+	for _, name := range names {
+		last.rules = append(last.rules, ruleInvocation{name: name})
+	}
+	return cp
+}
+
+// WithParams attaches params to the rule most recently added via Rules on
+// the field opened with For. Calling WithParams before any Rules call on
+// that field is a no-op.
+func (v *Validator[T]) WithParams(params ...string) *Validator[T] {
+	cp := v.clone()
+	if len(cp.steps) == 0 {
+		return cp
+	}
+	last := &cp.steps[len(cp.steps)-1]
+	if len(last.rules) == 0 {
+		return cp
+	}
+	last.rules[len(last.rules)-1].params = append([]string(nil), params...)
+	return cp
+}
+
+// Dive attaches element-level rules to the field opened with For: when the
+// field resolves to a slice, array, or map, each rule runs against every
+// element instead of the container itself, recursing through any further
+// nested slice/array/map layers so a 2D field only needs one Dive call.
+// Failures are recorded with indexed paths ("Names[0]", "Meta[foo]"). Rules
+// attached before Dive still run against the container as a whole (e.g.
+// For("Names").Rules("min").WithParams("1").Dive("nonempty") checks the
+// slice has at least one element, then that every element is non-empty).
+// Calling Dive with no prior For is a no-op.
+func (v *Validator[T]) Dive(names ...string) *Validator[T] {
+	cp := v.clone()
+	if len(cp.steps) == 0 {
+		return cp
+	}
+	last := &cp.steps[len(cp.steps)-1]
+	for _, name := range names {
+		last.diveRules = append(last.diveRules, ruleInvocation{name: name})
+	}
+	return cp
+}
+
+// WithDiveParams attaches params to the element-level rule most recently
+// added via Dive on the field opened with For. Calling it before any Dive
+// call on that field is a no-op.
+func (v *Validator[T]) WithDiveParams(params ...string) *Validator[T] {
+	cp := v.clone()
+	if len(cp.steps) == 0 {
+		return cp
+	}
+	last := &cp.steps[len(cp.steps)-1]
+	if len(last.diveRules) == 0 {
+		return cp
+	}
+	last.diveRules[len(last.diveRules)-1].params = append([]string(nil), params...)
+	return cp
+}
+
+// When restricts the field opened with For to be checked only when pred
+// returns true for the value passed to Validate.
+func (v *Validator[T]) When(pred func(T) bool) *Validator[T] {
+	cp := v.clone()
+	if len(cp.steps) == 0 {
+		return cp
+	}
+	cp.steps[len(cp.steps)-1].when = func(obj any) bool { return pred(obj.(T)) }
+	return cp
+}
+
+// Cascade makes Validate stop at the first failing rule. By default a
+// Validator collects every failure; see All.
+func (v *Validator[T]) Cascade() *Validator[T] {
+	cp := v.clone()
+	cp.failFast = true
+	return cp
+}
+
+// All makes Validate run every declared rule and collect every failure
+// into the returned Error. This is the default; it only needs to be called
+// to undo a prior Cascade.
+func (v *Validator[T]) All() *Validator[T] {
+	cp := v.clone()
+	cp.failFast = false
+	return cp
+}
+
+// PlannedRule describes one resolved rule invocation for a field, as
+// returned by Plan.
+type PlannedRule struct {
+	Path   string
+	Rule   string
+	Params []string
+}
+
+// Plan returns the rule chain this Validator would run per field, in
+// declaration order, without evaluating anything or touching the registry.
+// Only rules attached via Rules are listed; element rules attached via Dive
+// are not. It is meant for debugging a Validator assembled elsewhere.
+func (v *Validator[T]) Plan() []PlannedRule {
+	var plan []PlannedRule
+	for _, step := range v.steps {
+		for _, ri := range step.rules {
+			plan = append(plan, PlannedRule{Path: step.path, Rule: ri.name, Params: ri.params})
+		}
+	}
+	return plan
+}
+
+// Validate runs every declared field/rule pair against obj. Rule lookups
+// against the registry happen here, not at build time. On failure it
+// returns an *Error aggregating one FieldError per failed rule; Cascade
+// makes it return as soon as the first one fails, All (the default)
+// collects them all. It returns nil if every rule passes.
+func (v *Validator[T]) Validate(ctx context.Context, obj T) error {
+	ve := &Error{}
+	rv := reflect.ValueOf(obj)
+
+	for _, step := range v.steps {
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if step.when != nil && !step.when(obj) {
+			continue
+		}
+
+		fv, err := fieldByPath(rv, step.path)
+		if err != nil {
+			ve.Add(FieldError{Path: step.path, Err: err})
+			if v.failFast {
+				return ve
+			}
+			continue
+		}
+
+		for _, ri := range step.rules {
+			resolved, err := v.registry.Get(ri.name, fv)
+			if err != nil {
+				ve.Add(FieldError{Path: step.path, Rule: ri.name, Params: ri.params, Err: err})
+				if v.failFast {
+					return ve
+				}
+				continue
+			}
+
+			if cfr, ok := resolved.(CrossFieldRule); ok {
+				if err := v.validateCrossField(cfr, rv, step.path, fv, ri, ve); err != nil && v.failFast {
+					return ve
+				}
+				continue
+			}
+
+			if flr, ok := resolved.(FieldLevelRule); ok {
+				fl := NewFieldLevel(rv, parentValue(rv, step.path), fv, step.path)
+				if err := flr.ValidateFieldLevel(fl, ri.params...); err != nil {
+					ve.Add(FieldError{Path: step.path, Rule: ri.name, Params: ri.params, Err: err})
+					if v.failFast {
+						return ve
+					}
+				}
+				continue
+			}
+
+			if err := resolved.GetValidationFn()(fv, ri.params...); err != nil {
+				ve.Add(FieldError{Path: step.path, Rule: ri.name, Params: ri.params, Err: err})
+				if v.failFast {
+					return ve
+				}
+			}
+		}
+
+		if len(step.diveRules) > 0 {
+			stop, err := v.validateDive(ctx, step.path, fv, step.diveRules, ve)
+			if err != nil {
+				return err
+			}
+			if stop {
+				return ve
+			}
+		}
+	}
+
+	if ve.Empty() {
+		return nil
+	}
+	return ve
+}
+
+// validateCrossField resolves the sibling field a CrossFieldRule invocation
+// names in its first param — relative to the field's immediate parent for
+// an eqfield-style rule, or relative to root (the top-level struct passed
+// to Validate) for an eqcsfield-style one (cfr.CrossStruct()) — then runs
+// the comparison and records a FieldError on failure. It returns the error,
+// if any, so the caller can decide whether to stop on Cascade.
+func (v *Validator[T]) validateCrossField(
+	cfr CrossFieldRule, root reflect.Value, path string, fv reflect.Value, ri ruleInvocation, ve *Error,
+) error {
+	if len(ri.params) == 0 {
+		err := fmt.Errorf("validation: rule %q requires a sibling field path parameter", ri.name)
+		ve.Add(FieldError{Path: path, Rule: ri.name, Params: ri.params, Err: err})
+		return err
+	}
+
+	otherPath := ri.params[0]
+	base := root
+	if !cfr.CrossStruct() {
+		if parent := parentPath(path); parent != "" {
+			otherPath = parent + "." + otherPath
+		}
+	}
+
+	other, err := fieldByPath(base, otherPath)
+	if err != nil {
+		ve.Add(FieldError{Path: path, Rule: ri.name, Params: ri.params, Err: err})
+		return err
+	}
+
+	if err := cfr.ValidateCrossField(fv, other, otherPath); err != nil {
+		ve.Add(FieldError{Path: path, Rule: ri.name, Params: ri.params, Err: err})
+		return err
+	}
+	return nil
+}
+
+// parentPath returns path with its last dotted segment removed (e.g.
+// "Address.City" -> "Address"), or "" if path has no dot.
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// parentValue resolves the struct value enclosing path within rv (e.g. rv
+// itself for a top-level path, or rv.Address for "Address.City"), falling
+// back to rv when the parent path can't be resolved.
+func parentValue(rv reflect.Value, path string) reflect.Value {
+	parent := parentPath(path)
+	if parent == "" {
+		return rv
+	}
+	pv, err := fieldByPath(rv, parent)
+	if err != nil {
+		return rv
+	}
+	return pv
+}
+
+// fieldByPath navigates a dotted field path ("Address.City") from a struct
+// value, dereferencing pointers along the way, and resolves only exported
+// fields.
+func fieldByPath(rv reflect.Value, path string) (reflect.Value, error) {
+	cur := rv
+	for _, name := range strings.Split(path, ".") {
+		for cur.Kind() == reflect.Ptr {
+			if cur.IsNil() {
+				return reflect.Value{}, fmt.Errorf("validation: field %q: nil pointer before %q", path, name)
+			}
+			cur = cur.Elem()
+		}
+		if cur.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("validation: field %q: %q is not a struct field", path, name)
+		}
+		field := cur.FieldByName(name)
+		if !field.IsValid() {
+			return reflect.Value{}, fmt.Errorf("validation: field %q: no such field %q", path, name)
+		}
+		cur = field
+	}
+	return cur, nil
+}
@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type validatorAddress struct {
+	City string
+}
+
+type validatorPerson struct {
+	Name    string
+	Age     int
+	Address validatorAddress
+}
+
+func newTestRegistry(t *testing.T) RulesRegistry {
+	t.Helper()
+	reg := NewRulesRegistry()
+
+	nonempty, err := NewRule[string]("nonempty", func(s string, _ ...string) error {
+		if s == "" {
+			return errors.New("must not be empty")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule(nonempty): %v", err)
+	}
+	if err := reg.Add(nonempty); err != nil {
+		t.Fatalf("Add(nonempty): %v", err)
+	}
+
+	adult, err := NewRule[int]("adult", func(n int, _ ...string) error {
+		if n < 18 {
+			return errors.New("must be at least 18")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("NewRule(adult): %v", err)
+	}
+	if err := reg.Add(adult); err != nil {
+		t.Fatalf("Add(adult): %v", err)
+	}
+
+	return reg
+}
+
+func TestValidator_ImmutableChain(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	base := NewValidator[validatorPerson](reg)
+	withName := base.For("Name").Rules("nonempty")
+	withBoth := withName.For("Age").Rules("adult")
+
+	if len(base.Plan()) != 0 {
+		t.Fatalf("base.Plan() should be unaffected by later chaining, got %v", base.Plan())
+	}
+	if len(withName.Plan()) != 1 {
+		t.Fatalf("withName.Plan() = %v, want 1 entry", withName.Plan())
+	}
+	if len(withBoth.Plan()) != 2 {
+		t.Fatalf("withBoth.Plan() = %v, want 2 entries", withBoth.Plan())
+	}
+}
+
+func TestValidator_Validate_AllMode(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).
+		For("Name").Rules("nonempty").
+		For("Age").Rules("adult")
+
+	err := validator.Validate(context.Background(), validatorPerson{})
+	ve, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *Error", err)
+	}
+	if ve.Len() != 2 {
+		t.Fatalf("ve.Len() = %d, want 2 (both fields should fail)", ve.Len())
+	}
+}
+
+func TestValidator_Validate_Cascade(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).
+		For("Name").Rules("nonempty").
+		For("Age").Rules("adult").
+		Cascade()
+
+	err := validator.Validate(context.Background(), validatorPerson{})
+	ve, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *Error", err)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("ve.Len() = %d, want 1 (cascade should stop after the first failure)", ve.Len())
+	}
+}
+
+func TestValidator_Validate_When(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).
+		For("Age").Rules("adult").When(func(p validatorPerson) bool { return p.Name != "" })
+
+	if err := validator.Validate(context.Background(), validatorPerson{}); err != nil {
+		t.Fatalf("Validate() = %v, want nil because When() should have skipped the Age rule", err)
+	}
+
+	err := validator.Validate(context.Background(), validatorPerson{Name: "Alice"})
+	if err == nil {
+		t.Fatalf("Validate() = nil, want an error once When()'s predicate is satisfied")
+	}
+}
+
+func TestValidator_Validate_NestedField(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).For("Address.City").Rules("nonempty")
+
+	if err := validator.Validate(context.Background(), validatorPerson{}); err == nil {
+		t.Fatalf("Validate() = nil, want an error for an empty nested City")
+	}
+	if err := validator.Validate(context.Background(), validatorPerson{Address: validatorAddress{City: "Paris"}}); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+}
+
+func TestValidator_Validate_UnknownRule(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).For("Name").Rules("missing")
+
+	err := validator.Validate(context.Background(), validatorPerson{Name: "Alice"})
+	if err == nil {
+		t.Fatalf("Validate() = nil, want an error for an unregistered rule name")
+	}
+}
+
+func TestValidator_Plan(t *testing.T) {
+	t.Parallel()
+
+	reg := newTestRegistry(t)
+	validator := NewValidator[validatorPerson](reg).
+		For("Name").Rules("nonempty").
+		For("Age").Rules("adult").WithParams("18")
+
+	plan := validator.Plan()
+	if len(plan) != 2 {
+		t.Fatalf("len(plan) = %d, want 2", len(plan))
+	}
+	if plan[0].Path != "Name" || plan[0].Rule != "nonempty" {
+		t.Fatalf("plan[0] = %+v, want Name/nonempty", plan[0])
+	}
+	if plan[1].Path != "Age" || plan[1].Rule != "adult" || len(plan[1].Params) != 1 || plan[1].Params[0] != "18" {
+		t.Fatalf("plan[1] = %+v, want Age/adult with params [18]", plan[1])
+	}
+}
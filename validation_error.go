@@ -13,6 +13,27 @@ import (
 type ValidationError struct {
 	mu     sync.Mutex
 	issues []FieldError
+
+	// translator, when set (by Model.validate, from m.translator), switches
+	// MarshalJSON from its default {field: [message, ...]} shape to
+	// {field: [{rule, message, params}, ...]}; see MarshalJSON.
+	translator Translator
+
+	// modeOverride, when non-nil, is the ValidationMode typeBinding.record
+	// uses for this ValidationError instead of the binding's own configured
+	// validationMode. Set by Model.ValidateAll to force ModeCollectAll for
+	// that one call without mutating the shared *typeBinding (which would
+	// race against a concurrent Validate/ValidateAll call on the same
+	// Model). nil (the default) means "use the binding's configured mode",
+	// matching every pre-existing caller that builds a bare &ValidationError{}.
+	modeOverride *ValidationMode
+
+	// pathFilter, when set, restricts typeBinding.validateStructOpts (and the
+	// element-dive helpers in core_validate.go) to the subset of dotted field
+	// paths selected by Model.ValidatePartial/ValidateExcept. nil (the
+	// default) means "validate everything", matching every pre-existing
+	// caller that builds a bare &ValidationError{}.
+	pathFilter *fieldPathFilter
 }
 
 // Add appends a FieldError.
@@ -135,19 +156,107 @@ func (ve *ValidationError) Fields() []string {
 	return out
 }
 
-// MarshalJSON exports ValidationError as a map of field path -> list of error messages.
-// Example:
+// Details returns the accumulated issues as DetailedIssues using each
+// FieldError's own Error() message and Code, with no translation applied.
+// Use Model.LocalizeAll instead when a Translator or WithRuleMessage template
+// should supply the message/code.
+func (ve *ValidationError) Details() []DetailedIssue {
+	if ve == nil {
+		return nil
+	}
+	ve.mu.Lock()
+	defer ve.mu.Unlock()
+	out := make([]DetailedIssue, 0, len(ve.issues))
+	for _, fe := range ve.issues {
+		out = append(out, DetailedIssue{
+			Path:    fe.Path,
+			Rule:    fe.Rule,
+			Params:  fe.Params,
+			Message: fe.Error(),
+			Code:    fe.Code,
+		})
+	}
+	return out
+}
+
+// Localized renders all accumulated issues using NewLocaleTranslator(locale),
+// joined into a single multi-line string in the same style as Error(). It is
+// a convenience for callers that just need a human-readable message in a
+// given language and don't need per-issue DetailedIssues; use Model.LocalizeAll
+// instead for a JSON-friendly, per-issue result or to use a custom Translator.
+func (ve *ValidationError) Localized(locale string) string {
+	if ve == nil {
+		return ""
+	}
+	ve.mu.Lock()
+	issues := make([]FieldError, len(ve.issues))
+	copy(issues, ve.issues)
+	ve.mu.Unlock()
+
+	t := NewLocaleTranslator(locale)
+	switch len(issues) {
+	case 0:
+		return ""
+	case 1:
+		return t.Translate(issues[0])
+	default:
+		var b strings.Builder
+		b.WriteString("validation failed (\n")
+		for i, fe := range issues {
+			b.WriteString("  ")
+			b.WriteString(t.Translate(fe))
+			if i < len(issues)-1 {
+				b.WriteString("\n")
+			}
+		}
+		b.WriteString("\n)")
+		return b.String()
+	}
+}
+
+// translatedIssue is one element of the per-field array MarshalJSON emits
+// when a Translator is attached (see MarshalJSON).
+type translatedIssue struct {
+	Rule    string   `json:"rule"`
+	Message string   `json:"message"`
+	Params  []string `json:"params,omitempty"`
+}
+
+// MarshalJSON exports ValidationError as a map of field path -> list of error
+// messages. Example:
 //
 //	{
 //	  "Name": ["must not be empty"],
 //	  "Age":  ["must be > 0", "must not be zero"]
 //	}
+//
+// When a Translator has been attached (set internally by Model.validate from
+// WithTranslator/m.translator), each message is instead rendered through it
+// and the per-field array holds {rule, message, params} objects instead of
+// bare strings:
+//
+//	{
+//	  "Name": [{"rule": "nonempty", "message": "Name must not be empty"}]
+//	}
 func (ve *ValidationError) MarshalJSON() ([]byte, error) {
 	if ve == nil {
 		return []byte("null"), nil
 	}
 	ve.mu.Lock()
 	defer ve.mu.Unlock()
+
+	if ve.translator != nil {
+		by := make(map[string][]translatedIssue, len(ve.issues))
+		for _, fe := range ve.issues {
+			by[fe.Path] = append(by[fe.Path], translatedIssue{
+				Rule:    fe.Rule,
+				Message: ve.translator.Translate(fe),
+				Params:  fe.Params,
+			})
+		}
+		return json.Marshal(by)
+	}
+
 	by := make(map[string][]string, len(ve.issues))
 	for _, fe := range ve.issues {
 		msg := ""
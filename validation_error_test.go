@@ -207,3 +207,29 @@ func TestValidationError_MarshalJSON(t *testing.T) {
 		t.Fatalf("B wrong: %#v", got)
 	}
 }
+
+func TestValidationError_MarshalJSON_WithTranslator(t *testing.T) {
+	t.Parallel()
+
+	ve := &ValidationError{translator: NewLocaleTranslator("fr")}
+	ve.Add(FieldError{Path: "Name", Rule: "nonempty"})
+
+	data, err := ve.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON error: %v", err)
+	}
+	var m map[string][]translatedIssue
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("unmarshal: %v; raw=%s", err, string(data))
+	}
+	issues := m["Name"]
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Rule != "nonempty" {
+		t.Fatalf("Rule = %q, want nonempty", issues[0].Rule)
+	}
+	if issues[0].Message != "Name ne doit pas être vide" {
+		t.Fatalf("unexpected translated message: %q", issues[0].Message)
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import "errors"
+
+// ValidationMode controls how Model.Validate reacts to a failing rule.
+type ValidationMode int
+
+const (
+	// ModeCollectAll runs every declared rule against every field, struct,
+	// and element, accumulating every failure into the returned
+	// *ValidationError. This is the default.
+	ModeCollectAll ValidationMode = iota
+	// ModeFailFast stops at the first failing rule, returning a
+	// *ValidationError holding that single FieldError.
+	ModeFailFast
+)
+
+// WithValidationMode sets the ValidationMode used by Validate/New's
+// validate-on-construction pass. If not specified, ModeCollectAll is used.
+func WithValidationMode[TObject any](mode ValidationMode) Option[TObject] {
+	return func(m *Model[TObject]) error {
+		m.validationMode = mode
+		return nil
+	}
+}
+
+// errFailFastStop is an internal sentinel returned by typeBinding.record to
+// unwind validateStructOpts/validateElements/validateMapKeysAndValues as
+// soon as a rule fails under ModeFailFast. It is never exposed to callers:
+// Model.validate recognizes it and returns ve (already holding the single
+// recorded FieldError) instead of propagating it as a real error.
+var errFailFastStop = errors.New("model: fail-fast stop")
+
+// record adds fe to ve and, under ModeFailFast, returns errFailFastStop so
+// the caller unwinds immediately instead of evaluating further rules,
+// fields, or elements. Under ModeCollectAll (the default) it always returns
+// nil, matching the historical behavior of a bare ve.Add. The effective mode
+// is ve.modeOverride when set (see Model.ValidateAll), otherwise tb's own
+// configured validationMode.
+func (tb *typeBinding) record(ve *ValidationError, fe FieldError) error {
+	ve.Add(fe)
+	mode := tb.validationMode
+	if ve.modeOverride != nil {
+		mode = *ve.modeOverride
+	}
+	if mode == ModeFailFast {
+		return errFailFastStop
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"testing"
+)
+
+type validationModeDoc struct {
+	Name string `validate:"nonempty"`
+	Age  int    `validate:"positive"`
+}
+
+func TestValidate_ModeCollectAll_Default(t *testing.T) {
+	t.Parallel()
+
+	obj := validationModeDoc{}
+	m, err := New(&obj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *ValidationError", err)
+	}
+	if ve.Len() != 2 {
+		t.Fatalf("expected both fields to fail under ModeCollectAll, got %d issue(s)", ve.Len())
+	}
+}
+
+func TestValidate_ModeFailFast_StopsAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	obj := validationModeDoc{}
+	m, err := New(&obj, WithValidationMode[validationModeDoc](ModeFailFast))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	err = m.Validate(context.Background())
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *ValidationError", err)
+	}
+	if ve.Len() != 1 {
+		t.Fatalf("expected exactly 1 issue under ModeFailFast, got %d", ve.Len())
+	}
+	if ve.Fields()[0] != "Name" {
+		t.Fatalf("expected the first-declared field to fail, got %q", ve.Fields()[0])
+	}
+}
+
+func TestValidate_ModeFailFast_PassesWhenValid(t *testing.T) {
+	t.Parallel()
+
+	obj := validationModeDoc{Name: "ok", Age: 1}
+	m, err := New(&obj, WithValidationMode[validationModeDoc](ModeFailFast))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := m.Validate(context.Background()); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
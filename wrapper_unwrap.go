@@ -0,0 +1,36 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"reflect"
+)
+
+// unwrapKnownWrapper extracts the underlying value behind a well-known
+// wrapper type — database/sql/driver.Valuer (sql.NullString, sql.NullInt64,
+// sql.NullFloat64, sql.NullTime, ...) or encoding.TextMarshaler — so validate
+// rules and the "omitempty" tag see the wrapped value rather than the wrapper
+// struct. ok is false when fv's type is neither. isAbsent is true when a
+// driver.Valuer reports a nil value (e.g. sql.NullString{Valid: false}) or a
+// TextMarshaler returns an error, in which case callers should treat the
+// field as empty/absent rather than use v.
+//
+// See RegisterCustomTypeFunc for an explicit, per-type override of this
+// default behavior.
+func unwrapKnownWrapper(fv reflect.Value) (v reflect.Value, isAbsent bool, ok bool) {
+	if valuer, isValuer := fv.Interface().(driver.Valuer); isValuer {
+		val, err := valuer.Value()
+		if err != nil || val == nil {
+			return reflect.Value{}, true, true
+		}
+		return reflect.ValueOf(val), false, true
+	}
+	if tm, isTextMarshaler := fv.Interface().(encoding.TextMarshaler); isTextMarshaler {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return reflect.Value{}, true, true
+		}
+		return reflect.ValueOf(string(text)), false, true
+	}
+	return reflect.Value{}, false, false
+}